@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// export-validators writes the constraints of the validators of the arguments of the resources and
+// the data sources as JSON, for the IDE plugins and the policy tools which check the
+// configurations outside of Terraform.
+//
+//	go run ./cmd/export-validators -o validators.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func main() {
+	output := flag.String("o", "", "the file to write the constraints to, they are written to stdout by default")
+	flag.Parse()
+
+	// building the provider records the validators which it invokes
+	provider.Provider()
+	if err := validate.CheckValidators(); err != nil {
+		log.Fatal(err)
+	}
+	constraints, err := json.MarshalIndent(validate.ExportConstraints(provider.Validator()), "", "  ")
+	if err != nil {
+		log.Fatalf("[ERROR] Error encoding the constraints: %s", err)
+	}
+	constraints = append(constraints, '\n')
+	if *output == "" {
+		_, err = os.Stdout.Write(constraints)
+	} else {
+		err = ioutil.WriteFile(*output, constraints, 0644)
+	}
+	if err != nil {
+		log.Fatalf("[ERROR] Error writing the constraints: %s", err)
+	}
+}
@@ -65,6 +65,11 @@ var InstanceProfileNameUpdate string
 var DedicatedHostProfileName string
 var DedicatedHostGroupID string
 var InstanceDiskProfileName string
+var IsBareMetalServerProfileName string
+var IsBareMetalServerImage string
+var IsShareProfileName string
+var IsVPNServerCertificateCRN string
+var IsVPNServerClientCaCRN string
 var DedicatedHostGroupFamily string
 var DedicatedHostGroupClass string
 var VolumeProfileName string
@@ -473,6 +478,34 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_DEDICATED_HOST_GROUP_FAMILY for testing ibm_is_instance resource else it is set to default value 'balanced'")
 	}
 
+	IsBareMetalServerProfileName = os.Getenv("IS_BARE_METAL_SERVER_PROFILE")
+	if IsBareMetalServerProfileName == "" {
+		IsBareMetalServerProfileName = "bx2-metal-192x768" // for next gen infrastructure
+		fmt.Println("[INFO] Set the environment variable IS_BARE_METAL_SERVER_PROFILE for testing ibm_is_bare_metal_server resource else it is set to default value 'bx2-metal-192x768'")
+	}
+
+	IsBareMetalServerImage = os.Getenv("IS_BARE_METAL_SERVER_IMAGE")
+	if IsBareMetalServerImage == "" {
+		IsBareMetalServerImage = IsImage
+		fmt.Println("[INFO] Set the environment variable IS_BARE_METAL_SERVER_IMAGE for testing ibm_is_bare_metal_server resource else it is set to the value of IS_IMAGE")
+	}
+
+	IsShareProfileName = os.Getenv("IS_SHARE_PROFILE")
+	if IsShareProfileName == "" {
+		IsShareProfileName = "dp2" // for next gen infrastructure
+		fmt.Println("[INFO] Set the environment variable IS_SHARE_PROFILE for testing ibm_is_share resource else it is set to default value 'dp2'")
+	}
+
+	IsVPNServerCertificateCRN = os.Getenv("IS_VPN_SERVER_CERTIFICATE_CRN")
+	if IsVPNServerCertificateCRN == "" {
+		fmt.Println("[INFO] Set the environment variable IS_VPN_SERVER_CERTIFICATE_CRN for testing ibm_is_vpn_server resource")
+	}
+
+	IsVPNServerClientCaCRN = os.Getenv("IS_VPN_SERVER_CLIENT_CA_CRN")
+	if IsVPNServerClientCaCRN == "" {
+		fmt.Println("[INFO] Set the environment variable IS_VPN_SERVER_CLIENT_CA_CRN for testing ibm_is_vpn_server resource")
+	}
+
 	InstanceDiskProfileName = os.Getenv("IS_INSTANCE_DISK_PROFILE")
 	if InstanceDiskProfileName == "" {
 		//InstanceProfileName = "bc1-2x8" // for classic infrastructure
@@ -952,3 +985,13 @@ func TestAccPreCheckEncryptedImage(t *testing.T) {
 		t.Fatal("IS_IMAGE_ENCRYPTION_KEY must be set for acceptance tests")
 	}
 }
+
+func TestAccPreCheckVPNServer(t *testing.T) {
+	TestAccPreCheck(t)
+	if IsVPNServerCertificateCRN == "" {
+		t.Fatal("IS_VPN_SERVER_CERTIFICATE_CRN must be set for acceptance tests")
+	}
+	if IsVPNServerClientCaCRN == "" {
+		t.Fatal("IS_VPN_SERVER_CLIENT_CA_CRN must be set for acceptance tests")
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// RecordEnvVar records the cassettes of the replay tests against the live endpoints when set
+	RecordEnvVar = "TF_ACC_RECORD"
+
+	replayAPIKey = "replay-api-key"
+)
+
+// CassetteDir is the directory of the cassettes, relative to the directory of the package of the
+// test
+var CassetteDir = filepath.Join("..", "..", "test-fixtures", "cassettes")
+
+// LiveEndpoints are the live endpoints of the services recorded by default, the endpoints of the
+// other services are given to the replay test
+var LiveEndpoints = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT": "https://iam.cloud.ibm.com",
+	"IBMCLOUD_UAA_ENDPOINT":     "https://iam.cloud.ibm.com/cloudfoundry/login/us-south",
+}
+
+// replayHeaders are the response headers which are not recorded
+var replayHeaders = map[string]bool{
+	"Connection":                true,
+	"Content-Length":            true,
+	"Date":                      true,
+	"Set-Cookie":                true,
+	"Strict-Transport-Security": true,
+}
+
+// Cassette holds the HTTP interactions of the provider with the services, recorded by a replay
+// test against the live endpoints and replayed offline
+type Cassette struct {
+	// Account is the account of the IAM token of the recording, the replayed IAM tokens belong
+	// to it so that the requests with the account ID match the recording
+	Account      string        `json:"account"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request of the provider to a service, and the response of the service
+type Interaction struct {
+	// Service is the endpoint variable of the service, such as IBMCLOUD_IS_NG_API_ENDPOINT
+	Service string `json:"service"`
+	Method  string `json:"method"`
+	// URL is the path and query of the request relative to the endpoint of the service
+	URL             string            `json:"url"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	Status          int               `json:"status"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+}
+
+// Replay serves the interactions of a cassette on the endpoints of the services, or records them
+// from the live endpoints of the services
+type Replay struct {
+	t        *testing.T
+	filename string
+	record   bool
+	live     map[string]string
+
+	mu         sync.Mutex
+	cassette   Cassette
+	used       []bool
+	unexpected []string
+}
+
+// ReplayTest runs the test case with resource.UnitTest against the interactions recorded in the
+// cassette name, see StartReplay. The test is skipped when the Terraform CLI is not installed.
+func ReplayTest(t *testing.T, name string, live map[string]string, c resource.TestCase) {
+	t.Helper()
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Replay tests need the Terraform CLI, install it or set TF_ACC_TERRAFORM_PATH")
+		}
+	}
+	StartReplay(t, name, live)
+	if c.Providers == nil && c.ProviderFactories == nil {
+		c.Providers = TestAccProviders
+	}
+	resource.UnitTest(t, c)
+}
+
+// StartReplay points the endpoint variables of every service to a local server which replays
+// the interactions of the cassette name until the end of the test. The IAM tokens are replaced
+// with tokens of the account of the cassette, and a request without a recorded interaction fails
+// the test.
+//
+// With TF_ACC_RECORD set, the requests are forwarded to the live endpoints of the services, given
+// by endpoint variable in live or LiveEndpoints, with the credentials of the environment, and
+// the interactions are written to the cassette when the test passes. The requests of the IAM
+// tokens are not recorded.
+func StartReplay(t *testing.T, name string, live map[string]string) *Replay {
+	t.Helper()
+	r := &Replay{
+		t:        t,
+		filename: filepath.Join(CassetteDir, name+".json"),
+		record:   os.Getenv(RecordEnvVar) != "",
+		live:     map[string]string{},
+	}
+	for service, endpoint := range LiveEndpoints {
+		r.live[service] = endpoint
+	}
+	for service, endpoint := range live {
+		r.live[service] = endpoint
+	}
+
+	if !r.record {
+		data, err := ioutil.ReadFile(r.filename)
+		if err != nil {
+			t.Fatalf("Unable to read the cassette, record it with %s=1: %s", RecordEnvVar, err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			t.Fatalf("Invalid cassette %s: %s", r.filename, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+		setenv(t, "IC_API_KEY", replayAPIKey)
+	}
+
+	services := map[string]bool{"IBMCLOUD_COS_ENDPOINT": true}
+	for _, service := range conns.EndpointsFileKeys {
+		services[service] = true
+	}
+	for service := range live {
+		services[service] = true
+	}
+	for service := range services {
+		if os.Getenv(service) != "" && r.record && r.live[service] == "" {
+			// a service of a custom endpoint is recorded from it
+			r.live[service] = os.Getenv(service)
+		}
+		server := httptest.NewServer(r.handler(service))
+		t.Cleanup(server.Close)
+		setenv(t, service, server.URL)
+	}
+	setenv(t, "IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	setenv(t, "IC_ENDPOINTS_FILE_PATH", "")
+	t.Cleanup(r.finish)
+	return r
+}
+
+// setenv sets the environment variable until the end of the test
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func (r *Replay) finish() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, request := range r.unexpected {
+		r.t.Errorf("No recorded interaction for %s", request)
+	}
+	if !r.record || r.t.Failed() {
+		return
+	}
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		r.t.Errorf("Unable to encode the cassette: %s", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0755); err != nil {
+		r.t.Errorf("Unable to write the cassette: %s", err)
+		return
+	}
+	if err := ioutil.WriteFile(r.filename, append(data, '\n'), 0644); err != nil {
+		r.t.Errorf("Unable to write the cassette: %s", err)
+	}
+}
+
+func (r *Replay) handler(service string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		isToken := strings.HasSuffix(req.URL.Path, "/identity/token") || strings.HasSuffix(req.URL.Path, "/oauth/token")
+		var interaction *Interaction
+		switch {
+		case r.record:
+			interaction = r.forward(service, req, body, isToken)
+		case isToken:
+			interaction = r.token()
+		default:
+			interaction = r.lookup(service, req, body)
+		}
+		for k, v := range interaction.ResponseHeaders {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(interaction.Status)
+		w.Write([]byte(interaction.ResponseBody))
+	})
+}
+
+// lookup returns the first unused interaction of the request, preferring one with the same body,
+// then the last used one so that the extra refreshes of a run are answered like the recording.
+// A read does not go past a change which is not replayed yet, so that it is answered with the
+// state of the recording before the change.
+func (r *Replay) lookup(service string, req *http.Request, body []byte) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	requestURL := req.URL.RequestURI()
+	barrier := len(r.cassette.Interactions)
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		for i, interaction := range r.cassette.Interactions {
+			if !r.used[i] && interaction.Method != http.MethodGet && interaction.Method != http.MethodHead {
+				barrier = i
+				break
+			}
+		}
+	}
+	unused, sameBody, last, next := -1, -1, -1, -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Service != service || interaction.Method != req.Method || interaction.URL != requestURL {
+			continue
+		}
+		switch {
+		case i >= barrier:
+			if next < 0 && !r.used[i] {
+				next = i
+			}
+		case r.used[i]:
+			last = i
+		default:
+			if unused < 0 {
+				unused = i
+			}
+			if sameBody < 0 && equalBodies(interaction.RequestBody, string(body)) {
+				sameBody = i
+			}
+		}
+	}
+	i := sameBody
+	for _, candidate := range []int{unused, last, next} {
+		if i < 0 {
+			i = candidate
+		}
+	}
+	if i < 0 {
+		r.unexpected = append(r.unexpected, fmt.Sprintf("%s %s%s %s", req.Method, service, requestURL, body))
+		return &Interaction{
+			Status:          http.StatusNotImplemented,
+			ResponseHeaders: map[string]string{"Content-Type": "application/json"},
+			ResponseBody:    fmt.Sprintf(`{"errors":[{"code":"not_recorded","message":"No recorded interaction for %s %s"}]}`, req.Method, requestURL),
+		}
+	}
+	r.used[i] = true
+	return &r.cassette.Interactions[i]
+}
+
+// token returns an IAM token of the account of the cassette
+func (r *Replay) token() *Interaction {
+	claims := jwt.MapClaims{
+		"id":      "iam-ServiceId-replay",
+		"iam_id":  "iam-ServiceId-replay",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": r.cassette.Account},
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("replay"))
+	if err != nil {
+		r.t.Errorf("Unable to sign the replayed IAM token: %s", err)
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"access_token":  token,
+		"refresh_token": "replay-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    time.Now().Add(time.Hour).Unix(),
+	})
+	return &Interaction{
+		Status:          http.StatusOK,
+		ResponseHeaders: map[string]string{"Content-Type": "application/json"},
+		ResponseBody:    string(body),
+	}
+}
+
+// forward sends the request to the live endpoint of the service and records the interaction,
+// the IAM tokens are not recorded but the account of the first one is
+func (r *Replay) forward(service string, req *http.Request, body []byte, isToken bool) *Interaction {
+	failed := func(format string, a ...interface{}) *Interaction {
+		message := fmt.Sprintf(format, a...)
+		r.t.Errorf("Unable to record %s %s%s: %s", req.Method, service, req.URL.RequestURI(), message)
+		return &Interaction{Status: http.StatusBadGateway, ResponseBody: message}
+	}
+	endpoint, ok := r.live[service]
+	if !ok {
+		return failed("no live endpoint for %s, add it to the live endpoints of the replay test", service)
+	}
+	target, err := url.Parse(strings.TrimSuffix(endpoint, "/") + req.URL.RequestURI())
+	if err != nil {
+		return failed("%s", err)
+	}
+	liveReq, err := http.NewRequest(req.Method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return failed("%s", err)
+	}
+	liveReq.Header = req.Header.Clone()
+	resp, err := http.DefaultClient.Do(liveReq)
+	if err != nil {
+		return failed("%s", err)
+	}
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return failed("%s", err)
+	}
+	interaction := Interaction{
+		Service:         service,
+		Method:          req.Method,
+		URL:             req.URL.RequestURI(),
+		RequestBody:     string(body),
+		Status:          resp.StatusCode,
+		ResponseHeaders: map[string]string{},
+		ResponseBody:    string(responseBody),
+	}
+	for k := range resp.Header {
+		if !replayHeaders[k] {
+			interaction.ResponseHeaders[k] = resp.Header.Get(k)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if isToken {
+		if r.cassette.Account == "" {
+			r.cassette.Account = tokenAccount(responseBody)
+		}
+		return &interaction
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return &interaction
+}
+
+func tokenAccount(body []byte) string {
+	var response struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(response.AccessToken, claims); err != nil {
+		return ""
+	}
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		bss, _ := account["bss"].(string)
+		return bss
+	}
+	return ""
+}
+
+// equalBodies compares the JSON bodies regardless of the order of their fields
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var x, y interface{}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return false
+	}
+	xs, _ := json.Marshal(x)
+	ys, _ := json.Marshal(y)
+	return bytes.Equal(xs, ys)
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+const testReplayAccount = "4ea1882a2d3401ed1e459979941966ea"
+
+// newTestLiveServer is a fake live IAM and VPC endpoint
+func newTestLiveServer(t *testing.T) *httptest.Server {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "iam-ServiceId-live",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": testReplayAccount},
+	}).SignedString([]byte("live"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Date", time.Now().Format(http.TimeFormat))
+		switch {
+		case r.URL.Path == "/identity/token":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  token,
+				"refresh_token": "live-refresh-token",
+				"token_type":    "Bearer",
+				"expires_in":    3600,
+				"expiration":    time.Now().Add(time.Hour).Unix(),
+			})
+		case r.URL.Path == "/v1/vpcs" && r.Method == http.MethodGet:
+			if r.Header.Get("Authorization") != "Bearer "+token {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Etag", `W/"vpc-etag"`)
+			w.Write([]byte(`{"vpcs":[{"id":"r006-vpc","name":"replay-vpc"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testListVPCs(t *testing.T) string {
+	c := &conns.Config{
+		BluemixAPIKey: os.Getenv("IC_API_KEY"),
+		Region:        "us-south",
+		Visibility:    "public",
+	}
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	vpcAPI, err := sess.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	vpcs, response, err := vpcAPI.ListVpcs(vpcAPI.NewListVpcsOptions())
+	if err != nil {
+		t.Fatalf("unexpected error: %s %s", err, response)
+	}
+	if etag := response.GetHeaders().Get("Etag"); etag != `W/"vpc-etag"` {
+		t.Errorf("expected the response headers to be replayed, got %q", etag)
+	}
+	if len(vpcs.Vpcs) != 1 {
+		t.Fatalf("expected a VPC, got %d", len(vpcs.Vpcs))
+	}
+	return *vpcs.Vpcs[0].Name
+}
+
+func TestReplayRecordAndReplay(t *testing.T) {
+	defaultDir := CassetteDir
+	CassetteDir = t.TempDir()
+	defer func() { CassetteDir = defaultDir }()
+	live := newTestLiveServer(t)
+
+	t.Run("record", func(t *testing.T) {
+		setenv(t, RecordEnvVar, "1")
+		setenv(t, "IC_API_KEY", "live-api-key")
+		StartReplay(t, "vpcs", map[string]string{
+			"IBMCLOUD_IAM_API_ENDPOINT":   live.URL,
+			"IBMCLOUD_UAA_ENDPOINT":       live.URL,
+			"IBMCLOUD_IS_NG_API_ENDPOINT": live.URL + "/v1",
+		})
+		if name := testListVPCs(t); name != "replay-vpc" {
+			t.Fatalf("unexpected VPC %s", name)
+		}
+	})
+
+	data, err := ioutil.ReadFile(CassetteDir + "/vpcs.json")
+	if err != nil {
+		t.Fatalf("expected the cassette to be written: %s", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		t.Fatal(err)
+	}
+	if cassette.Account != testReplayAccount {
+		t.Errorf("expected the account of the IAM token to be recorded, got %q", cassette.Account)
+	}
+	if strings.Contains(string(data), "live-refresh-token") || strings.Contains(string(data), "Authorization") {
+		t.Errorf("expected the credentials not to be recorded:\n%s", data)
+	}
+	if len(cassette.Interactions) != 1 || !strings.HasPrefix(cassette.Interactions[0].URL, "/vpcs?") {
+		t.Fatalf("unexpected interactions %+v", cassette.Interactions)
+	}
+	if _, ok := cassette.Interactions[0].ResponseHeaders["Date"]; ok {
+		t.Errorf("expected the Date header not to be recorded")
+	}
+
+	live.Close()
+	t.Run("replay", func(t *testing.T) {
+		StartReplay(t, "vpcs", nil)
+		if name := testListVPCs(t); name != "replay-vpc" {
+			t.Fatalf("unexpected VPC %s", name)
+		}
+		sess, err := (&conns.Config{BluemixAPIKey: os.Getenv("IC_API_KEY"), Region: "us-south", Visibility: "public"}).ClientSession()
+		if err != nil {
+			t.Fatal(err)
+		}
+		userDetails, err := sess.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userDetails.UserAccount != testReplayAccount {
+			t.Fatalf("expected the replayed IAM token to belong to the recorded account, got %s", userDetails.UserAccount)
+		}
+	})
+}
+
+func TestReplayLookup(t *testing.T) {
+	r := &Replay{cassette: Cassette{Interactions: []Interaction{
+		{Service: "S", Method: "GET", URL: "/vpcs/1", Status: 200, ResponseBody: "pending"},
+		{Service: "S", Method: "PATCH", URL: "/vpcs/1", RequestBody: `{"name":"a","tags":[]}`, Status: 200, ResponseBody: "a"},
+		{Service: "S", Method: "PATCH", URL: "/vpcs/1", RequestBody: `{"name":"b"}`, Status: 200, ResponseBody: "b"},
+		{Service: "S", Method: "GET", URL: "/vpcs/1", Status: 200, ResponseBody: "available"},
+	}}}
+	r.used = make([]bool, len(r.cassette.Interactions))
+
+	for _, c := range []struct {
+		method, body, expected string
+	}{
+		{"GET", "", "pending"},
+		// a read does not go past the changes which are not replayed yet
+		{"GET", "", "pending"},
+		{"PATCH", `{"name":"b"}`, "b"},
+		{"PATCH", `{"tags":[],"name":"a"}`, "a"},
+		{"GET", "", "available"},
+		// the interactions are used in order, then the last one is replayed again
+		{"GET", "", "available"},
+	} {
+		req := httptest.NewRequest(c.method, "/vpcs/1", strings.NewReader(c.body))
+		if interaction := r.lookup("S", req, []byte(c.body)); interaction.ResponseBody != c.expected {
+			t.Errorf("%s %s: expected %s, got %s", c.method, c.body, c.expected, interaction.ResponseBody)
+		}
+	}
+	req := httptest.NewRequest("DELETE", "/vpcs/1", nil)
+	if interaction := r.lookup("S", req, nil); interaction.Status != http.StatusNotImplemented || len(r.unexpected) != 1 {
+		t.Errorf("expected a request without interaction to be reported, got %d %v", interaction.Status, r.unexpected)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// assumeTrustedProfileAuthenticator returns the authenticator of the trusted profile assumed with
+// the IAM token of base, or of the API key or IAM token of the provider when base is nil
+func (c *Config) assumeTrustedProfileAuthenticator(iamURL string, base tokenAuthenticator) (tokenAuthenticator, error) {
+	profile := c.AssumeTrustedProfile
+	if profile.ProfileID == "" && profile.ProfileName == "" {
+		return nil, fmt.Errorf("[ERROR] profile_id or profile_name must be provided to assume a trusted profile")
+	}
+	if profile.ProfileID != "" && profile.ProfileName != "" {
+		return nil, fmt.Errorf("[ERROR] profile_id conflicts with profile_name in assume_trusted_profile")
+	}
+	if profile.ProfileName != "" && profile.AccountID == "" {
+		return nil, fmt.Errorf("[ERROR] account_id must be provided to assume the trusted profile %s", profile.ProfileName)
+	}
+
+	iamEndpoint := c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL)
+	client := c.RetryPolicy().Client(nil)
+	authenticator := &assumeAuthenticator{
+		profile: *profile,
+		url:     strings.TrimSuffix(iamEndpoint, "/identity/token") + "/identity/token",
+		client:  client,
+	}
+	switch {
+	case base != nil:
+		authenticator.baseToken = base.GetToken
+	case c.BluemixAPIKey != "":
+		iam := &core.IamAuthenticator{ApiKey: c.BluemixAPIKey, URL: iamEndpoint, Client: client}
+		authenticator.baseToken = iam.GetToken
+	case c.IAMToken != "":
+		token := strings.TrimPrefix(c.IAMToken, "Bearer ")
+		authenticator.baseToken = func() (string, error) { return token, nil }
+	default:
+		return nil, fmt.Errorf("[ERROR] ibmcloud_api_key, iam_token or a compute resource must be provided to assume a trusted profile")
+	}
+	log.Printf("[INFO] Configuring IBM Cloud Session with the trusted profile %s%s of the account %s", profile.ProfileID, profile.ProfileName, profile.AccountID)
+	return authenticator, nil
+}
+
+// assumeAuthenticator requests IAM tokens of a trusted profile with the IAM token of the base
+// credentials, the token is requested again before it expires
+type assumeAuthenticator struct {
+	profile   AssumeTrustedProfileConfig
+	url       string
+	client    *gohttp.Client
+	baseToken func() (string, error)
+
+	mu          sync.Mutex
+	token       string
+	refreshTime int64
+}
+
+func (a *assumeAuthenticator) AuthenticationType() string {
+	return "assume"
+}
+
+func (a *assumeAuthenticator) Validate() error {
+	return nil
+}
+
+func (a *assumeAuthenticator) Authenticate(req *gohttp.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns the IAM token of the trusted profile, requesting a new one when the current
+// token reaches 80% of its lifetime
+func (a *assumeAuthenticator) GetToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token != "" && time.Now().Unix() < a.refreshTime {
+		return a.token, nil
+	}
+	baseToken, err := a.baseToken()
+	if err != nil {
+		return "", fmt.Errorf("Error occured while requesting the IAM token of the base credentials: %s", err)
+	}
+	response, err := a.requestToken(baseToken)
+	if err != nil {
+		return "", err
+	}
+	if err := a.checkAccount(response.AccessToken); err != nil {
+		return "", err
+	}
+	a.token = response.AccessToken
+	a.refreshTime = response.Expiration - int64(float64(response.ExpiresIn)*0.2)
+	return a.token, nil
+}
+
+func (a *assumeAuthenticator) requestToken(baseToken string) (*core.IamTokenServerResponse, error) {
+	form := url.Values{
+		"grant_type":   {"urn:ibm:params:oauth:grant-type:assume"},
+		"access_token": {baseToken},
+	}
+	if a.profile.ProfileID != "" {
+		form.Set("profile_id", a.profile.ProfileID)
+	} else {
+		form.Set("profile_name", a.profile.ProfileName)
+		form.Set("account", a.profile.AccountID)
+	}
+	req, err := gohttp.NewRequest(gohttp.MethodPost, a.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("Error occured while assuming the trusted profile %s%s: %s %s", a.profile.ProfileID, a.profile.ProfileName, resp.Status, body)
+	}
+	response := &core.IamTokenServerResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, fmt.Errorf("Error occured while decoding the IAM token of the trusted profile: %s", err)
+	}
+	return response, nil
+}
+
+// checkAccount verifies that the trusted profile belongs to the account_id of the configuration,
+// so that a profile_id of another account is not silently assumed
+func (a *assumeAuthenticator) checkAccount(token string) error {
+	if a.profile.AccountID == "" {
+		return nil
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return fmt.Errorf("Error occured while decoding the IAM token of the trusted profile: %s", err)
+	}
+	var account string
+	if accountClaim, ok := claims["account"].(map[string]interface{}); ok {
+		account, _ = accountClaim["bss"].(string)
+	}
+	if account != a.profile.AccountID {
+		return fmt.Errorf("The trusted profile %s%s belongs to the account %q, not to the account %s", a.profile.ProfileID, a.profile.ProfileName, account, a.profile.AccountID)
+	}
+	return nil
+}
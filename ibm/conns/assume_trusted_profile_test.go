@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testBaseAccount    = "4ea1882a2d3401ed1e459979941966ea"
+	testAssumedAccount = "b2d1ce5ba5a84f1bb5ac6a7e15a8b9f3"
+)
+
+// testAssumeServer is a fake IAM token endpoint which issues a token of the base account for the
+// API key, and a token of the assumed account for the token of the base account
+type testAssumeServer struct {
+	*httptest.Server
+	baseToken, assumedToken string
+
+	mu           sync.Mutex
+	accessTokens []string
+	profiles     []string
+}
+
+func newTestAssumeServer(t *testing.T, assumedAccount string) *testAssumeServer {
+	s := &testAssumeServer{baseToken: testAccountToken(t, testBaseAccount), assumedToken: testAccountToken(t, assumedAccount)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		var token string
+		switch r.FormValue("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			token = s.baseToken
+		case "urn:ibm:params:oauth:grant-type:assume":
+			s.accessTokens = append(s.accessTokens, r.FormValue("access_token"))
+			s.profiles = append(s.profiles, r.FormValue("profile_id")+r.FormValue("profile_name")+"/"+r.FormValue("account"))
+			token = s.assumedToken
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  token,
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"expiration":    time.Now().Unix() + 3600,
+		})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func testAssumeConfig(server *testAssumeServer, profile *AssumeTrustedProfileConfig) *Config {
+	return &Config{
+		BluemixAPIKey:        "base-api-key",
+		AssumeTrustedProfile: profile,
+		Endpoints:            map[string]string{"IBMCLOUD_IAM_API_ENDPOINT": server.URL},
+		Region:               "us-south",
+		Visibility:           "public",
+	}
+}
+
+func TestAssumeTrustedProfileValidation(t *testing.T) {
+	for _, profile := range []*AssumeTrustedProfileConfig{
+		{},
+		{ProfileID: "Profile-123", ProfileName: "ci"},
+		{ProfileName: "ci"},
+	} {
+		c := &Config{BluemixAPIKey: "key", AssumeTrustedProfile: profile}
+		if _, err := c.tokenAuthenticator("https://iam.cloud.ibm.com"); err == nil {
+			t.Errorf("expected an error for %+v", profile)
+		}
+	}
+	c := &Config{AssumeTrustedProfile: &AssumeTrustedProfileConfig{ProfileID: "Profile-123"}}
+	if _, err := c.tokenAuthenticator("https://iam.cloud.ibm.com"); err == nil {
+		t.Error("expected an error without base credentials")
+	}
+}
+
+func TestClientSessionAssumeTrustedProfile(t *testing.T) {
+	server := newTestAssumeServer(t, testAssumedAccount)
+	c := testAssumeConfig(server, &AssumeTrustedProfileConfig{ProfileID: "Profile-123", AccountID: testAssumedAccount})
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(server.accessTokens) != 1 || server.accessTokens[0] != server.baseToken || server.profiles[0] != "Profile-123/" {
+		t.Fatalf("expected the token of the API key to be exchanged for the trusted profile, got %v", server.profiles)
+	}
+
+	session := sess.(ClientSession)
+	userDetails, err := session.BluemixUserDetails()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if userDetails.UserAccount != testAssumedAccount {
+		t.Fatalf("expected the session to act as the assumed account, got %s", userDetails.UserAccount)
+	}
+	vpcAPI, err := session.VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if authType := vpcAPI.Service.Options.Authenticator.AuthenticationType(); authType != "assume" {
+		t.Fatalf("expected the clients to use the assume authenticator, got %s", authType)
+	}
+}
+
+func TestClientSessionAssumeTrustedProfileByName(t *testing.T) {
+	server := newTestAssumeServer(t, testAssumedAccount)
+	c := testAssumeConfig(server, &AssumeTrustedProfileConfig{ProfileName: "ci", AccountID: testAssumedAccount})
+	if _, err := c.ClientSession(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(server.profiles) != 1 || server.profiles[0] != "ci/"+testAssumedAccount {
+		t.Fatalf("expected the trusted profile to be assumed by name in the account, got %v", server.profiles)
+	}
+}
+
+func TestClientSessionAssumeTrustedProfileAccountMismatch(t *testing.T) {
+	server := newTestAssumeServer(t, testBaseAccount)
+	c := testAssumeConfig(server, &AssumeTrustedProfileConfig{ProfileID: "Profile-123", AccountID: testAssumedAccount})
+	_, err := c.ClientSession()
+	if err == nil || !strings.Contains(err.Error(), testAssumedAccount) {
+		t.Fatalf("expected an error for a trusted profile of another account, got %v", err)
+	}
+}
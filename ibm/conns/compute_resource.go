@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// ComputeResourceContainer exchanges the service account token projected in the pod, read from
+	// the cr_token_filename file, for IAM tokens of the trusted profile
+	ComputeResourceContainer = "container"
+	// ComputeResourceVpcInstance exchanges the instance identity token of the metadata service of
+	// the virtual server instance for IAM tokens of the trusted profile
+	ComputeResourceVpcInstance = "vpc_instance"
+)
+
+// computeResourceAuthenticator returns the authenticator of the compute resource of the
+// configuration, or nil when the provider does not authenticate as a compute resource
+func (c *Config) computeResourceAuthenticator(iamURL string) (tokenAuthenticator, error) {
+	var authenticator tokenAuthenticator
+	switch c.ComputeResource {
+	case "":
+		return nil, nil
+	case ComputeResourceContainer:
+		authenticator = &core.ContainerAuthenticator{
+			CRTokenFilename: c.CRTokenFilename,
+			IAMProfileID:    c.IAMTrustedProfileID,
+			IAMProfileName:  c.IAMProfileName,
+			URL:             c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL),
+			Client:          c.RetryPolicy().Client(nil),
+		}
+	case ComputeResourceVpcInstance:
+		if c.CRTokenFilename != "" {
+			return nil, fmt.Errorf("[ERROR] cr_token_filename is not supported by the %s compute resource", c.ComputeResource)
+		}
+		if c.IAMProfileName != "" {
+			return nil, fmt.Errorf("[ERROR] iam_profile_name is not supported by the %s compute resource, use iam_profile_id", c.ComputeResource)
+		}
+		authenticator = &core.VpcInstanceAuthenticator{
+			IAMProfileID: c.IAMTrustedProfileID,
+			Client:       c.RetryPolicy().Client(nil),
+		}
+	default:
+		return nil, fmt.Errorf("[ERROR] Unknown compute resource %q, expected %s or %s", c.ComputeResource, ComputeResourceContainer, ComputeResourceVpcInstance)
+	}
+	if c.BluemixAPIKey != "" || c.IAMToken != "" {
+		return nil, fmt.Errorf("[ERROR] The %s compute resource authentication conflicts with ibmcloud_api_key and iam_token", c.ComputeResource)
+	}
+	if c.IAMTrustedProfileID == "" && c.IAMProfileName == "" {
+		return nil, fmt.Errorf("[ERROR] iam_profile_id or iam_profile_name must be provided to authenticate as the %s compute resource", c.ComputeResource)
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring the %s compute resource authentication: %s", c.ComputeResource, err)
+	}
+	log.Printf("[INFO] Configuring IBM Cloud Session with the %s compute resource token", c.ComputeResource)
+	return authenticator, nil
+}
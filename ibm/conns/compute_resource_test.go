@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testIAMServer is a fake IAM token endpoint which exchanges compute resource tokens for an
+// access token which expires at once, so that every use of the token exchanges it again
+type testIAMServer struct {
+	*httptest.Server
+	accessToken string
+
+	mu            sync.Mutex
+	crTokens      []string
+	profileIDs    []string
+	authorization string
+}
+
+func newTestIAMServer(t *testing.T) *testIAMServer {
+	s := &testIAMServer{accessToken: testAccessToken(t)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.URL.Path != "/identity/token" {
+			s.authorization = r.Header.Get("Authorization")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.FormValue("grant_type") != "urn:ibm:params:oauth:grant-type:cr-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.crTokens = append(s.crTokens, r.FormValue("cr_token"))
+		s.profileIDs = append(s.profileIDs, r.FormValue("profile_id"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": s.accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"expiration":   time.Now().Unix(),
+		})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestComputeResourceAuthenticatorValidation(t *testing.T) {
+	if a, err := (&Config{}).computeResourceAuthenticator("https://iam.cloud.ibm.com"); a != nil || err != nil {
+		t.Fatalf("expected no authenticator without a compute resource, got %v, %v", a, err)
+	}
+	for _, c := range []*Config{
+		{ComputeResource: "lambda", IAMTrustedProfileID: "Profile-123"},
+		{ComputeResource: ComputeResourceContainer},
+		{ComputeResource: ComputeResourceContainer, IAMTrustedProfileID: "Profile-123", BluemixAPIKey: "key"},
+		{ComputeResource: ComputeResourceVpcInstance, IAMProfileName: "ci"},
+		{ComputeResource: ComputeResourceVpcInstance, IAMTrustedProfileID: "Profile-123", CRTokenFilename: "/tmp/token"},
+	} {
+		if _, err := c.computeResourceAuthenticator("https://iam.cloud.ibm.com"); err == nil {
+			t.Errorf("expected an error for %+v", c)
+		}
+	}
+	a, err := (&Config{ComputeResource: ComputeResourceVpcInstance, IAMTrustedProfileID: "Profile-123"}).computeResourceAuthenticator("https://iam.cloud.ibm.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a.AuthenticationType() != "vpc" {
+		t.Fatalf("expected the vpc instance authenticator, got %s", a.AuthenticationType())
+	}
+}
+
+func TestClientSessionComputeResourceContainer(t *testing.T) {
+	server := newTestIAMServer(t)
+	filename := filepath.Join(t.TempDir(), "vault-token")
+	if err := ioutil.WriteFile(filename, []byte("cr-token-1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		ComputeResource:     ComputeResourceContainer,
+		CRTokenFilename:     filename,
+		IAMTrustedProfileID: "Profile-123",
+		Endpoints:           map[string]string{"IBMCLOUD_IAM_API_ENDPOINT": server.URL},
+		Region:              "us-south",
+		Visibility:          "public",
+	}
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(server.crTokens) != 1 || server.crTokens[0] != "cr-token-1" || server.profileIDs[0] != "Profile-123" {
+		t.Fatalf("expected the compute resource token to be exchanged for the trusted profile, got %v %v", server.crTokens, server.profileIDs)
+	}
+
+	session := sess.(ClientSession)
+	vpcAPI, err := session.VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if authType := vpcAPI.Service.Options.Authenticator.AuthenticationType(); authType != "container" {
+		t.Fatalf("expected the clients to use the container authenticator, got %s", authType)
+	}
+
+	// the bluemix-go clients send the token of the session, which is replaced by a new token
+	// exchanged from the current content of the token file
+	if err := ioutil.WriteFile(filename, []byte("cr-token-2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	bmxSession, err := session.BluemixSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v2/resource_instances", nil)
+	req.Header.Set("Authorization", bmxSession.Config.IAMAccessToken)
+	resp, err := bmxSession.Config.HTTPClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if len(server.crTokens) != 2 || server.crTokens[1] != "cr-token-2" {
+		t.Fatalf("expected the token file to be exchanged again, got %v", server.crTokens)
+	}
+	if server.authorization != "Bearer "+server.accessToken {
+		t.Fatalf("unexpected authorization %s", server.authorization)
+	}
+}
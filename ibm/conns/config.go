@@ -5,15 +5,13 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -74,6 +72,7 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt"
 	slsession "github.com/softlayer/softlayer-go/session"
 
@@ -96,7 +95,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -110,6 +108,11 @@ import (
 // RetryAPIDelay - retry api delay
 const RetryAPIDelay = 5 * time.Second
 
+// vpcAPIVersion is the version of the VPC API of the ibm_is_* resources and data sources. It is
+// the version of vpc-go-sdk v0.14, newer SDKs send a newer one by default which changes the
+// responses of the API.
+const vpcAPIVersion = "2021-11-23"
+
 //BluemixRegion ...
 var BluemixRegion string
 
@@ -127,6 +130,25 @@ type UserConfig struct {
 	generation  int    `default:"2"`
 }
 
+// IgnoreTagsConfig holds the tags that are managed outside of Terraform
+type IgnoreTagsConfig struct {
+	// Keys of the tags to ignore, the key of a tag is the part before the colon
+	Keys []string
+	// KeyPrefixes of the tags to ignore
+	KeyPrefixes []string
+}
+
+// AssumeTrustedProfileConfig holds the trusted profile assumed by the provider, which may be in
+// another account than the credentials of the provider
+type AssumeTrustedProfileConfig struct {
+	// ProfileID of the trusted profile
+	ProfileID string
+	// ProfileName of the trusted profile, which requires AccountID
+	ProfileName string
+	// AccountID of the trusted profile
+	AccountID string
+}
+
 //Config stores user provider input
 type Config struct {
 	//BluemixAPIKey is the Bluemix api key
@@ -151,12 +173,15 @@ type Config struct {
 	SoftLayerAPIKey string
 
 	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	//Constant Retry Delay for API calls
+	//Base delay of the exponential backoff between retries of API calls
 	RetryDelay time.Duration
+	//Maximum delay between retries of API calls, including the delay asked by the API with Retry-After
+	RetryMaxDelay time.Duration
+	//HTTP status codes of the API calls to retry
+	RetryableStatusCodes []int
+	//Maximum number of requests per second sent to each service, keyed by the names of RateLimitServices
+	RateLimits map[string]float64
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -173,6 +198,20 @@ type Config struct {
 	//TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	// IAMProfileName is the name of the trusted profile, an alternative to IAMTrustedProfileID
+	IAMProfileName string
+
+	// ComputeResource is the compute resource whose token is exchanged for IAM tokens of the
+	// trusted profile, ComputeResourceContainer or ComputeResourceVpcInstance
+	ComputeResource string
+
+	// CRTokenFilename is the file of the compute resource token of the container
+	CRTokenFilename string
+
+	// AssumeTrustedProfile is the trusted profile whose IAM tokens are requested with the IAM
+	// token of the credentials of the provider
+	AssumeTrustedProfile *AssumeTrustedProfileConfig
+
 	//IAM Refresh Token
 	IAMRefreshToken string
 
@@ -183,6 +222,17 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+	// Endpoints are the endpoints set in the endpoints block of the provider, keyed by endpoint variable
+	Endpoints map[string]string
+
+	// DefaultTags are attached to every taggable resource
+	DefaultTags []string
+
+	// IgnoreTags are filtered out on read and never detached on update
+	IgnoreTags *IgnoreTagsConfig
+
+	// PlanTimeValidation validates the resources against the catalog of the services in CustomizeDiff
+	PlanTimeValidation bool
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -275,11 +325,27 @@ type ClientSession interface {
 	PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error)
 	ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error)
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
+	DefaultTags() []string
+	IgnoreTags() *IgnoreTagsConfig
+	PlanTimeValidation() *PlanTimeCache
 }
 
 type clientSession struct {
 	session *Session
 
+	defaultTags        []string
+	ignoreTags         *IgnoreTagsConfig
+	planTimeValidation *PlanTimeCache
+	retryPolicy        *RetryPolicy
+	rateLimiters       rateLimiters
+
+	// shared by the clients configured on first use
+	config        *Config
+	endpointsFile EndpointsFile
+	iamURL        string
+	authenticator core.Authenticator
+	tokenAuth     tokenAuthenticator
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -552,229 +618,321 @@ type clientSession struct {
 	// context Based Restrictions (CBR)
 	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
 	contextBasedRestrictionsClientErr error
+
+	// the clients of each service are configured on first use, see load
+	accountV1Once                sync.Once
+	accountOnce                  sync.Once
+	cfOnce                       sync.Once
+	csOnce                       sync.Once
+	csv2Once                     sync.Once
+	hpcsEndpointOnce             sync.Once
+	kpOnce                       sync.Once
+	kmsOnce                      sync.Once
+	appidOnce                    sync.Once
+	contextBasedRestrictionsOnce sync.Once
+	catalogManagementOnce        sync.Once
+	atrackerOnce                 sync.Once
+	findingsOnce                 sync.Once
+	adminServiceApiOnce          sync.Once
+	schematicsOnce               sync.Once
+	vpcOnce                      sync.Once
+	pushServiceOnce              sync.Once
+	eventNotificationsApiOnce    sync.Once
+	appConfigurationOnce         sync.Once
+	containerRegistryOnce        sync.Once
+	cosConfigOnce                sync.Once
+	globalSearchOnce             sync.Once
+	globalTaggingOnce            sync.Once
+	globalTaggingV1Once          sync.Once
+	icdOnce                      sync.Once
+	resourceCatalogOnce          sync.Once
+	resourceManagementV2Once     sync.Once
+	resourceControllerV1Once     sync.Once
+	resourceControllerV2Once     sync.Once
+	userManagementOnce           sync.Once
+	certManagementOnce           sync.Once
+	functionIAMNamespaceOnce     sync.Once
+	apigatewayOnce               sync.Once
+	ibmpiOnce                    sync.Once
+	pDNSOnce                     sync.Once
+	directlinkOnce               sync.Once
+	dlProviderOnce               sync.Once
+	transitgatewayOnce           sync.Once
+	cisOnce                      sync.Once
+	iamIdentityOnce              sync.Once
+	iamPolicyManagementOnce      sync.Once
+	iamAccessGroupsOnce          sync.Once
+	resourceManagerOnce          sync.Once
+	ibmCloudShellOnce            sync.Once
+	enterpriseManagementOnce     sync.Once
+	resourceControllerOnce       sync.Once
+	secretsManagerOnce           sync.Once
+	satelliteOnce                sync.Once
+	satelliteLinkOnce            sync.Once
+	esSchemaRegistryOnce         sync.Once
+	postureManagementOnce        sync.Once
+	postureManagementV2Once      sync.Once
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.load(&session.appidOnce, session.configureAppIDAPI)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.load(&session.catalogManagementOnce, session.configureCatalogManagementV1)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.load(&sess.accountOnce, sess.configureBluemixAccountAPI)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.load(&sess.accountV1Once, sess.configureBluemixAccountv1API)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.load(&sess.csOnce, sess.configureContainerAPI)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.load(&sess.csv2Once, sess.configureVpcContainerAPI)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.load(&session.containerRegistryOnce, session.configureContainerRegistryV1)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.load(&sess.schematicsOnce, sess.configureSchematicsV1)
 	return sess.schematicsClient, sess.schematicsClientErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.load(&sess.globalSearchOnce, sess.configureGlobalSearchAPI)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.load(&sess.globalTaggingOnce, sess.configureGlobalTaggingAPI)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.load(&sess.globalTaggingV1Once, sess.configureGlobalTaggingAPIv1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.load(&sess.hpcsEndpointOnce, sess.configureHpcsEndpointAPI)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.load(&sess.userManagementOnce, sess.configureUserManagementAPI)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.load(&sess.iamPolicyManagementOnce, sess.configureIAMPolicyManagementV1API)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.load(&sess.iamAccessGroupsOnce, sess.configureIAMAccessGroupsV2)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.load(&session.ibmCloudShellOnce, session.configureIBMCloudShellV1)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.load(&sess.icdOnce, sess.configureICDAPI)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.load(&sess.cfOnce, sess.configureMccpAPI)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.load(&sess.resourceCatalogOnce, sess.configureResourceCatalogAPI)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.load(&sess.resourceManagementV2Once, sess.configureResourceManagementAPIv2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.load(&sess.resourceControllerV1Once, sess.configureResourceControllerAPI)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.load(&sess.resourceControllerV2Once, sess.configureResourceControllerAPIV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.load(&sess.certManagementOnce, sess.configureCertificateManagerAPI)
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 //apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.load(&sess.apigatewayOnce, sess.configureAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.load(&session.pushServiceOnce, session.configurePushServiceV1)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.load(&session.eventNotificationsApiOnce, session.configureEventNotificationsApiV1)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.load(&session.appConfigurationOnce, session.configureAppConfigurationV1)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.load(&sess.kpOnce, sess.configureKeyProtectAPI)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.load(&sess.kmsOnce, sess.configureKeyManagementAPI)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:  sess.config.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", sess.kmsAPI.Config.BaseURL),
 				APIKey:   sess.kmsAPI.Config.APIKey, //pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
 				TokenURL: sess.kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.config.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", sess.kmsAPI.Config.BaseURL),
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, //pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
 				TokenURL:      sess.kmsAPI.Config.TokenURL,
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, kp.DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, tokenRoundTripper(sess.tokenAuth, sess.serviceTransport("kms", kp.DefaultTransport())))
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return kpClient, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.load(&sess.vpcOnce, sess.configureVpcV1API)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.load(&sess.directlinkOnce, sess.configureDirectlinkV1API)
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.load(&sess.dlProviderOnce, sess.configureDirectlinkProviderV2API)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.load(&sess.cosConfigOnce, sess.configureCosConfigV1API)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.load(&sess.transitgatewayOnce, sess.configureTransitGatewayV1API)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.load(&sess.ibmpiOnce, sess.configureIBMPISession)
 	return sess.ibmpiSession, sess.powerConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.load(&sess.pDNSOnce, sess.configurePrivateDNSClientSession)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.load(&sess.functionIAMNamespaceOnce, sess.configureFunctionIAMNamespaceAPI)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -782,7 +940,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -790,7 +949,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -798,7 +958,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -806,7 +967,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -814,7 +976,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -822,7 +985,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -830,7 +994,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -838,7 +1003,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -846,7 +1012,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -854,7 +1021,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -862,7 +1030,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -870,7 +1039,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -878,7 +1048,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -886,7 +1057,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -894,7 +1066,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -902,7 +1075,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -910,7 +1084,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -918,7 +1093,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -926,7 +1102,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -934,7 +1111,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -942,7 +1120,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -950,43 +1129,51 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.load(&sess.iamIdentityOnce, sess.configureIAMIdentityV1API)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.load(&sess.resourceManagerOnce, sess.configureResourceManagerV2API)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.load(&session.enterpriseManagementOnce, session.configureEnterpriseManagementV1)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.load(&sess.resourceControllerOnce, sess.configureResourceControllerV2API)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.load(&session.secretsManagerOnce, session.configureSecretsManagerV1)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.load(&session.satelliteLinkOnce, session.configureSatellitLinkClientSession)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.load(&sess.satelliteOnce, sess.configureSatelliteClientSession)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -994,7 +1181,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.load(&sess.cisOnce, sess.configureCIS)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1002,16 +1190,19 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+func (session *clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+	session.load(&session.atrackerOnce, session.configureAtrackerV1)
 	return session.atrackerClient, session.atrackerClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.load(&session.esSchemaRegistryOnce, session.configureESschemaRegistrySession)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 // Security and Compliance center Findings API
-func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+func (session *clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+	session.load(&session.findingsOnce, session.configureFindingsV1)
 	if session.findingsClientErr != nil {
 		return session.findingsClient, session.findingsClientErr
 	}
@@ -1019,12 +1210,14 @@ func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
 }
 
 //Security and Compliance center Admin API
-func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+func (session *clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+	session.load(&session.adminServiceApiOnce, session.configureAdminServiceApiV1)
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
 }
 
 // Security and Compliance center Posture Management
-func (session clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+func (session *clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+	session.load(&session.postureManagementOnce, session.configurePostureManagementV1)
 	if session.postureManagementClientErr != nil {
 		return session.postureManagementClient, session.postureManagementClientErr
 	}
@@ -1032,7 +1225,8 @@ func (session clientSession) PostureManagementV1() (*posturemanagementv1.Posture
 }
 
 //Security and Compliance center Posture Management v2
-func (session clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+func (session *clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+	session.load(&session.postureManagementV2Once, session.configurePostureManagementV2)
 	if session.postureManagementClientErrv2 != nil {
 		return session.postureManagementClientv2, session.postureManagementClientErrv2
 	}
@@ -1040,19 +1234,90 @@ func (session clientSession) PostureManagementV2() (*posturemanagementv2.Posture
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.load(&session.contextBasedRestrictionsOnce, session.configureContextBasedRestrictionsV1)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// DefaultTags provides the tags configured in the provider default_tags block
+func (session *clientSession) DefaultTags() []string {
+	return session.defaultTags
+}
+
+// IgnoreTags provides the tags configured in the provider ignore_tags block
+func (session *clientSession) IgnoreTags() *IgnoreTagsConfig {
+	return session.ignoreTags
+}
+
+// PlanTimeValidation provides the cache of the plan time validations, nil when the provider
+// plan_time_validation is disabled
+func (session *clientSession) PlanTimeValidation() *PlanTimeCache {
+	return session.planTimeValidation
+}
+
+// load configures the clients of a service on first use. The clients are left unset when the
+// provider is configured without IBM Cloud credentials, the accessors then return the error
+// set by ClientSession.
+func (session *clientSession) load(once *sync.Once, configure func()) {
+	once.Do(func() {
+		if session.session.BluemixSession != nil {
+			configure()
+		}
+	})
+}
+
+// bluemixServiceSession returns the Bluemix session for the clients of the service. The clients
+// of a limited service get a copy of the session whose HTTP client shares the rate limit of the
+// service, the other clients share the HTTP client of the session.
+func (session *clientSession) bluemixServiceSession(service string) *bxsession.Session {
+	bmxSession := session.session.BluemixSession
+	if session.rateLimiters[service] == nil {
+		return bmxSession
+	}
+	config := bmxSession.Config.Copy()
+	client := http.NewHTTPClient(config)
+	client.Transport = session.rateLimiters.Transport(service, client.Transport)
+	config.HTTPClient = tokenClient(session.tokenAuth, session.retryPolicy.Client(instrumentedClient("bluemix", client)))
+	return &bxsession.Session{Config: config}
+}
+
+// ClientSession configures the IBM Cloud sessions and returns a ClientSession whose service
+// clients are configured on first use
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, err := newSession(c)
+	endpointsFile, err := LoadEndpointsFile(EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile))
+	if err != nil {
+		return nil, err
+	}
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	iamURL = endpointsFile.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", c.Visibility, c.Region, iamURL)
+	tokenAuth, err := c.tokenAuthenticator(iamURL)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := newSession(c, endpointsFile, tokenAuth)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:       sess,
+		config:        c,
+		endpointsFile: endpointsFile,
+		tokenAuth:     tokenAuth,
+		defaultTags:   c.DefaultTags,
+		ignoreTags:    c.IgnoreTags,
+		retryPolicy:   c.RetryPolicy(),
+		rateLimiters:  c.rateLimiters(),
+	}
+	if c.PlanTimeValidation {
+		session.planTimeValidation = &PlanTimeCache{}
 	}
 
 	if sess.BluemixSession == nil {
@@ -1136,53 +1401,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
+		// The HTTP client of the session retries the authentication with the retry policy
 		err = authenticateAPIKey(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-				session.powerConfigErr = fmt.Errorf("[ERROR] Error occured while fetching the auth key for power iaas: %q", err)
-				session.ibmpiConfigErr = fmt.Errorf("[ERROR] Error occured while fetching the auth key for power iaas: %q", err)
-			}
+			session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
+			session.powerConfigErr = fmt.Errorf("[ERROR] Error occured while fetching the auth key for power iaas: %q", err)
+			session.ibmpiConfigErr = fmt.Errorf("[ERROR] Error occured while fetching the auth key for power iaas: %q", err)
 		}
 		err = authenticateCF(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if c.IAMTrustedProfileID == "" && tokenAuth == nil && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
-			}
-			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
-			}
+			return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
 		}
 
 	}
@@ -1200,192 +1436,199 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
 
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+	// Key Protect retries are handled by the retry policy of the transport
+	kp.RetryMax = 0
+
+	session.iamURL = iamURL
+
+	var authenticator core.Authenticator
+
+	if tokenAuth != nil {
+		authenticator = tokenAuth
+	} else if c.BluemixAPIKey != "" {
+		authenticator = &core.IamAuthenticator{
+			ApiKey: c.BluemixAPIKey,
+			URL:    c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL) + "/identity/token",
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 		}
 	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
+	session.authenticator = authenticator
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+	redactSDKLogs()
+	return session, nil
+}
+
+func (session *clientSession) configureBluemixAccountv1API() {
+	accv1API, err := accountv1.New(session.bluemixServiceSession("account_management"))
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
+func (session *clientSession) configureBluemixAccountAPI() {
+	accAPI, err := accountv2.New(session.bluemixServiceSession("account_management"))
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
+func (session *clientSession) configureMccpAPI() {
+	cfAPI, err := mccpv2.New(session.bluemixServiceSession("mccp"))
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
+func (session *clientSession) configureContainerAPI() {
+	clusterAPI, err := containerv1.New(session.bluemixServiceSession("container"))
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+func (session *clientSession) configureVpcContainerAPI() {
+	v2clusterAPI, err := containerv2.New(session.bluemixServiceSession("container"))
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
+func (session *clientSession) configureHpcsEndpointAPI() {
+	hpcsAPI, err := hpcs.New(session.bluemixServiceSession("hpcs"))
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+// KEY PROTECT Service
+func (session *clientSession) configureKeyProtectAPI() {
+	c, endpointsFile := session.config, session.endpointsFile
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
+	kpurl = endpointsFile.Endpoint("IBMCLOUD_KP_API_ENDPOINT", c.Visibility, c.Region, kpurl)
 	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
+	if c.BluemixAPIKey != "" && session.tokenAuth == nil {
 		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+			BaseURL: c.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kpurl),
+			APIKey:  session.session.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			BaseURL:       c.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kpurl),
+			Authorization: session.session.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, kp.DefaultTransport())
+	kpAPIclient, err := kp.New(options, tokenRoundTripper(session.tokenAuth, session.serviceTransport("kms", kp.DefaultTransport())))
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
-	// KEY MANAGEMENT Service
+// KEY MANAGEMENT Service
+func (session *clientSession) configureKeyManagementAPI() {
+	c, endpointsFile := session.config, session.endpointsFile
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
+	kmsurl = endpointsFile.Endpoint("IBMCLOUD_KP_API_ENDPOINT", c.Visibility, c.Region, kmsurl)
 	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
+	if c.BluemixAPIKey != "" && session.tokenAuth == nil {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+			BaseURL: c.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
+			APIKey:  session.session.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", session.iamURL) + "/identity/token",
 		}
 
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			BaseURL:       c.EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
+			Authorization: session.session.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", session.iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, tokenRoundTripper(session.tokenAuth, session.serviceTransport("kms", DefaultTransport())))
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" {
-		authenticator = &core.IamAuthenticator{
-			ApiKey: c.BluemixAPIKey,
-			URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
-	// APPID Service
+// APPID Service
+func (session *clientSession) configureAppIDAPI() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
+	appIDEndpoint = endpointsFile.Endpoint("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Visibility, c.Region, appIDEndpoint)
 	appIDClientOptions := &appid.AppIDManagementV4Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		URL:           c.EndpointFallBack("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint),
 	}
 	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
 	if err != nil {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		appIDClient.Service.SetHTTPClient(session.serviceClient("appid"))
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
+// CONTEXT BASED RESTRICTIONS Service
+func (session *clientSession) configureContextBasedRestrictionsV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
+	cbrURL = endpointsFile.Endpoint("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Visibility, c.Region, cbrURL)
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL),
 	}
 
 	// Construct the service client.
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.contextBasedRestrictionsClient.Service.SetHTTPClient(session.serviceClient("context_based_restrictions"))
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1393,17 +1636,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
-	// CATALOG MANAGEMENT Service
+// CATALOG MANAGEMENT Service
+func (session *clientSession) configureCatalogManagementV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
+	catalogManagementURL = endpointsFile.Endpoint("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Visibility, c.Region, catalogManagementURL)
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL),
 		Authenticator: authenticator,
 	}
 	// Construct the service client.
@@ -1413,14 +1658,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.catalogManagementClient.Service.SetHTTPClient(session.serviceClient("catalog_management"))
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ATRACKER Service
+// ATRACKER Service
+func (session *clientSession) configureAtrackerV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	var atrackerClientURL string
 	atrackerClientURL, err = atrackerv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1435,12 +1684,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 			}
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
+	atrackerClientURL = endpointsFile.Endpoint("IBMCLOUD_ATRACKER_API_ENDPOINT", c.Visibility, c.Region, atrackerClientURL)
 	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientURL),
 	}
 	// Construct the service client.
 	session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
@@ -1449,14 +1696,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.atrackerClient.Service.SetHTTPClient(session.serviceClient("atracker"))
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SCC FINDINGS Service
+// SCC FINDINGS Service
+func (session *clientSession) configureFindingsV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	var findingsClientURL string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
 		findingsClientURL, err = findingsv1.GetServiceURLForRegion(c.Region)
@@ -1466,13 +1717,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.findingsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		findingsClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", c.Region, findingsClientURL)
-	}
+	findingsClientURL = endpointsFile.Endpoint("IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", c.Visibility, c.Region, findingsClientURL)
 	findingsClientOptions := &findingsv1.FindingsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_FINDINGS_API_ENDPOINT"}, findingsClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		URL:           c.EndpointFallBack("IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", findingsClientURL),
+		AccountID:     core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 	// Construct the service client.
 	session.findingsClient, err = findingsv1.NewFindingsV1(findingsClientOptions)
@@ -1481,14 +1730,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.findingsClient != nil && session.findingsClient.Service != nil {
 		// Enable retries for API calls
-		session.findingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.findingsClient.Service.SetHTTPClient(session.serviceClient("scc"))
 		// Add custom header for analytics
 		session.findingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SCC ADMIN Service
+// SCC ADMIN Service
+func (session *clientSession) configureAdminServiceApiV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	var adminServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
@@ -1501,16 +1754,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		adminServiceApiClientURL = adminserviceapiv1.DefaultServiceURL
 	}
+	adminServiceApiClientURL = endpointsFile.Endpoint("IBMCLOUD_SCC_ADMIN_API_ENDPOINT", c.Visibility, c.Region, adminServiceApiClientURL)
 	adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_SCC_ADMIN_API_ENDPOINT", adminServiceApiClientURL),
 	}
 
 	// Construct the service client.
 	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.adminServiceApiClient.Service.SetHTTPClient(session.serviceClient("scc"))
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1518,8 +1772,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
 	}
+}
 
-	// SCHEMATICS Service
+// SCHEMATICS Service
+func (session *clientSession) configureSchematicsV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	schematicsEndpoint := "https://schematics.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1530,12 +1787,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 			schematicsEndpoint = "https://schematics.cloud.ibm.com"
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
+	schematicsEndpoint = endpointsFile.Endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Visibility, c.Region, schematicsEndpoint)
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		URL:           c.EndpointFallBack("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint),
 	}
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
@@ -1544,14 +1799,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		schematicsClient.Service.SetHTTPClient(session.serviceClient("schematics"))
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
-	// VPC Service
+// VPC Service
+func (session *clientSession) configureVpcV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1566,35 +1824,35 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+	vpcurl = endpointsFile.Endpoint("IBMCLOUD_IS_NG_API_ENDPOINT", c.Visibility, c.Region, vpcurl)
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+		URL:           c.EndpointFallBack("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl),
 		Authenticator: authenticator,
+		Version:       core.StringPtr(vpcAPIVersion),
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
 	if err != nil {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		vpcclient.Service.SetHTTPClient(session.serviceClient("vpc"))
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
 
-	// PUSH NOTIFICATIONS Service
+// PUSH NOTIFICATIONS Service
+func (session *clientSession) configurePushServiceV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
+	pnurl = endpointsFile.Endpoint("IBMCLOUD_PUSH_API_ENDPOINT", c.Visibility, c.Region, pnurl)
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+		URL:           c.EndpointFallBack("IBMCLOUD_PUSH_API_ENDPOINT", pnurl),
 		Authenticator: authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
@@ -1603,23 +1861,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		pnclient.Service.SetHTTPClient(session.serviceClient("push_notifications"))
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
-	// event notifications
+}
+
+// event notifications
+func (session *clientSession) configureEventNotificationsApiV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
+	enurl = endpointsFile.Endpoint("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Visibility, c.Region, enurl)
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		URL:           c.EndpointFallBack("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl),
 	}
 	// Construct the service client.
 	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
@@ -1629,13 +1890,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.eventNotificationsApiClient.Service.SetHTTPClient(session.serviceClient("event_notifications"))
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// APP CONFIGURATION Service
+// APP CONFIGURATION Service
+func (session *clientSession) configureAppConfigurationV1() {
+	c, authenticator := session.config, session.authenticator
 	if c.Visibility == "private" {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] App Configuration Service API doesnot support private endpoints")
 	}
@@ -1645,13 +1909,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		appConfigClient.Service.SetHTTPClient(session.serviceClient("app_configuration"))
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
-	// CONTAINER REGISTRY Service
+// CONTAINER REGISTRY Service
+func (session *clientSession) configureContainerRegistryV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	// Construct an "options" struct for creating the service client.
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1663,13 +1930,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
+	containerRegistryClientURL = endpointsFile.Endpoint("IBMCLOUD_CR_API_ENDPOINT", c.Visibility, c.Region, containerRegistryClientURL)
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
+		URL:           c.EndpointFallBack("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL),
+		Account:       core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 	// Construct the service client.
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
@@ -1678,41 +1943,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.containerRegistryClient.Service.SetHTTPClient(session.serviceClient("container_registry"))
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// OBJECT STORAGE Service
+// OBJECT STORAGE Service
+func (session *clientSession) configureCosConfigV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
+	cosconfigurl = endpointsFile.Endpoint("IBMCLOUD_COS_CONFIG_ENDPOINT", c.Visibility, c.Region, cosconfigurl)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		URL:           c.EndpointFallBack("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil && cosconfigclient.Service != nil {
+		cosconfigclient.Service.SetHTTPClient(session.serviceClient("cos"))
+	}
 	session.cosConfigAPI = cosconfigclient
+}
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+func (session *clientSession) configureGlobalSearchAPI() {
+	globalSearchAPI, err := globalsearchv2.New(session.bluemixServiceSession("global_search"))
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+}
+
+// Global Tagging Bluemix-go
+func (session *clientSession) configureGlobalTaggingAPI() {
+	globalTaggingAPI, err := globaltaggingv3.New(session.bluemixServiceSession("global_tagging"))
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
-	// GLOBAL TAGGING Service
+// GLOBAL TAGGING Service
+func (session *clientSession) configureGlobalTaggingAPIv1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1723,11 +2000,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
+	globalTaggingEndpoint = endpointsFile.Endpoint("IBMCLOUD_GT_API_ENDPOINT", c.Visibility, c.Region, globalTaggingEndpoint)
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+		URL:           c.EndpointFallBack("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint),
 		Authenticator: authenticator,
 	}
 	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
@@ -1736,96 +2011,126 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.globalTaggingServiceAPIV1.Service.SetHTTPClient(session.serviceClient("global_tagging"))
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
+func (session *clientSession) configureICDAPI() {
+	icdAPI, err := icdv4.New(session.bluemixServiceSession("icd"))
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+func (session *clientSession) configureResourceCatalogAPI() {
+	resourceCatalogAPI, err := catalog.New(session.bluemixServiceSession("resource_catalog"))
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+func (session *clientSession) configureResourceManagementAPIv2() {
+	resourceManagementAPIv2, err := managementv2.New(session.bluemixServiceSession("resource_manager"))
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
+func (session *clientSession) configureResourceControllerAPI() {
+	resourceControllerAPI, err := controller.New(session.bluemixServiceSession("resource_controller"))
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+func (session *clientSession) configureResourceControllerAPIV2() {
+	ResourceControllerAPIv2, err := controllerv2.New(session.bluemixServiceSession("resource_controller"))
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+func (session *clientSession) configureUserManagementAPI() {
+	userManagementAPI, err := usermanagementv2.New(session.bluemixServiceSession("user_management"))
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
+func (session *clientSession) configureCertificateManagerAPI() {
+	certManagementAPI, err := certificatemanager.New(session.bluemixServiceSession("certificate_manager"))
 	if err != nil {
 		session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
 	}
 	session.certManagementAPI = certManagementAPI
+}
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
+func (session *clientSession) configureFunctionIAMNamespaceAPI() {
+	namespaceFunction, err := functions.New(session.bluemixServiceSession("functions"))
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
-	//  API GATEWAY service
+// API GATEWAY service
+func (session *clientSession) configureAPIGateway() {
+	c, endpointsFile := session.config, session.endpointsFile
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
+	apicurl = endpointsFile.Endpoint("IBMCLOUD_API_GATEWAY_ENDPOINT", c.Visibility, c.Region, apicurl)
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           c.EndpointFallBack("IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil && apigatewayAPI.Service != nil {
+		apigatewayAPI.Service.SetHTTPClient(session.serviceClient("api_gateway"))
+	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
-	// POWER SYSTEMS Service
-	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, session.bmxUserDetails.UserAccount, c.Zone)
+// POWER SYSTEMS Service
+func (session *clientSession) configureIBMPISession() {
+	c := session.config
+	ibmpisession, err := ibmpisession.New(session.session.BluemixSession.Config.IAMAccessToken, c.Region, false, session.bmxUserDetails.UserAccount, c.Zone)
 	if err != nil {
 		session.ibmpiConfigErr = err
-		return nil, err
+		if session.powerConfigErr == nil {
+			session.powerConfigErr = err
+		}
+		return
+	}
+	if rt, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+		rt.Transport = session.serviceTransport("power", rt.Transport)
 	}
 	session.ibmpiSession = ibmpisession
+}
 
-	// PRIVATE DNS Service
+// PRIVATE DNS Service
+func (session *clientSession) configurePrivateDNSClientSession() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
+	pdnsURL = endpointsFile.Endpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Visibility, c.Region, pdnsURL)
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL),
 		Authenticator: authenticator,
 	}
 	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
@@ -1833,23 +2138,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.pDNSClient.Service.SetHTTPClient(session.serviceClient("private_dns"))
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK Service
+// DIRECT LINK Service
+func (session *clientSession) configureDirectlinkV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
+	dlURL = endpointsFile.Endpoint("IBMCLOUD_DL_API_ENDPOINT", c.Visibility, c.Region, dlURL)
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_DL_API_ENDPOINT", dlURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1858,22 +2164,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.directlinkAPI.Service.SetHTTPClient(session.serviceClient("directlink"))
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK PROVIDER Service
+// DIRECT LINK PROVIDER Service
+func (session *clientSession) configureDirectlinkProviderV2API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
+	dlproviderURL = endpointsFile.Endpoint("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Visibility, c.Region, dlproviderURL)
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1882,22 +2190,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.dlProviderAPI.Service.SetHTTPClient(session.serviceClient("directlink"))
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// TRANSIT GATEWAY Service
+// TRANSIT GATEWAY Service
+func (session *clientSession) configureTransitGatewayV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
+	tgURL = endpointsFile.Endpoint("IBMCLOUD_TG_API_ENDPOINT", c.Visibility, c.Region, tgURL)
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_TG_API_ENDPOINT", tgURL),
 		Authenticator: authenticator,
 		Version:       CreateVersionDate(),
 	}
@@ -1906,13 +2215,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.transitgatewayAPI.Service.SetHTTPClient(session.serviceClient("transit_gateway"))
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
+// CIS Service
+func (session *clientSession) configureCIS() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
 	if c.Visibility == "private" {
 		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
@@ -1939,10 +2251,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	cisURL = endpointsFile.Endpoint("IBMCLOUD_CIS_API_ENDPOINT", c.Visibility, c.Region, cisURL)
+	cisEndPoint := c.EndpointFallBack("IBMCLOUD_CIS_API_ENDPOINT", cisURL)
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
@@ -1957,7 +2267,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisZonesV1Client.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1975,7 +2285,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisDNSRecordsClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -1995,7 +2305,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisDNSRecordBulkClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2015,7 +2325,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisGLBPoolClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2035,7 +2345,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisGLBClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2055,7 +2365,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisGLBHealthCheckClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2072,7 +2382,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisIPClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2092,7 +2402,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisRLClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2112,7 +2422,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisPageRuleClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2133,7 +2443,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisEdgeFunctionClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2154,7 +2464,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisSSLClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2175,7 +2485,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisWAFPackageClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2196,7 +2506,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisDomainSettingsClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2217,7 +2527,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisRoutingClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2238,7 +2548,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisWAFGroupClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2259,7 +2569,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisCacheClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2281,7 +2591,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisCustomPageClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2302,7 +2612,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisAccessRuleClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2323,7 +2633,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisUARuleClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2344,7 +2654,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisLockdownClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2365,7 +2675,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisRangeAppClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2386,7 +2696,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisWAFRuleClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2404,7 +2714,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisFiltersClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2422,14 +2732,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.cisFirewallRulesClient.Service.SetHTTPClient(session.serviceClient("cis"))
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+// IAM IDENTITY Service
+// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+func (session *clientSession) configureIAMIdentityV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2438,26 +2751,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
+	iamIdenityURL = endpointsFile.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", c.Visibility, c.Region, iamIdenityURL)
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		iamIdentityClient.Service.SetHTTPClient(session.serviceClient("iam"))
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
-	// IAM POLICY MANAGEMENT Service
+// IAM POLICY MANAGEMENT Service
+func (session *clientSession) configureIAMPolicyManagementV1API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2466,26 +2780,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
+	iamPolicyManagementURL = endpointsFile.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", c.Visibility, c.Region, iamPolicyManagementURL)
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		iamPolicyManagementClient.Service.SetHTTPClient(session.serviceClient("iam"))
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
-	// IAM ACCESS GROUP
+// IAM ACCESS GROUP
+func (session *clientSession) configureIAMAccessGroupsV2() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2494,26 +2809,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
+	iamAccessGroupsURL = endpointsFile.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", c.Visibility, c.Region, iamAccessGroupsURL)
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL),
 	}
 	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
 	if err != nil {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		iamAccessGroupsClient.Service.SetHTTPClient(session.serviceClient("iam"))
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
-	// RESOURCE MANAGEMENT Service
+// RESOURCE MANAGEMENT Service
+func (session *clientSession) configureResourceManagerV2API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2530,46 +2846,49 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
+	rmURL = endpointsFile.Endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Visibility, c.Region, rmURL)
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		resourceManagerClient.Service.SetHTTPClient(session.serviceClient("resource_manager"))
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
-	//CLOUD SHELL Service
+// CLOUD SHELL Service
+func (session *clientSession) configureIBMCloudShellV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
+	cloudShellUrl = endpointsFile.Endpoint("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Visibility, c.Region, cloudShellUrl)
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		URL:           c.EndpointFallBack("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl),
 	}
 	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
 	if err != nil {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.ibmCloudShellClient.Service.SetHTTPClient(session.serviceClient("cloud_shell"))
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ENTERPRISE Service
+// ENTERPRISE Service
+func (session *clientSession) configureEnterpriseManagementV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -2587,26 +2906,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
+	enterpriseURL = endpointsFile.Endpoint("IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Visibility, c.Region, enterpriseURL)
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err != nil {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enterpriseManagementClient.Service.SetHTTPClient(session.serviceClient("enterprise"))
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
-	// RESOURCE CONTROLLER Service
+// RESOURCE CONTROLLER Service
+func (session *clientSession) configureResourceControllerV2API() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2623,26 +2943,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
+	rcURL = endpointsFile.Endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Visibility, c.Region, rcURL)
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		URL:           c.EndpointFallBack("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		resourceControllerClient.Service.SetHTTPClient(session.serviceClient("resource_controller"))
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
-	// SECRETS MANAGER Service
+// SECRETS MANAGER Service
+func (session *clientSession) configureSecretsManagerV1() {
+	authenticator := session.authenticator
+	var err error
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
 		Authenticator: authenticator,
 	}
@@ -2653,23 +2975,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.secretsManagerClient.Service.SetHTTPClient(session.serviceClient("secrets_manager"))
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE Service
+// SATELLITE Service
+func (session *clientSession) configureSatelliteClientSession() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
+	containerEndpoint = endpointsFile.Endpoint("IBMCLOUD_SATELLITE_API_ENDPOINT", c.Visibility, c.Region, containerEndpoint)
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+		URL:           c.EndpointFallBack("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint),
 		Authenticator: authenticator,
 	}
 	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
@@ -2679,23 +3003,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.satelliteClient.Service.SetHTTPClient(session.serviceClient("satellite"))
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE LINK Service
+// SATELLITE LINK Service
+func (session *clientSession) configureSatellitLinkClientSession() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	// Construct an "options" struct for creating the service client.
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
+	satelliteLinkEndpoint = endpointsFile.Endpoint("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Visibility, c.Region, satelliteLinkEndpoint)
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+		URL:           c.EndpointFallBack("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint),
 		Authenticator: authenticator,
 	}
 	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
@@ -2704,13 +3030,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.satelliteLinkClient.Service.SetHTTPClient(session.serviceClient("satellite"))
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+// EVENT STREAMS SCHEMA REGISTRY Service
+func (session *clientSession) configureESschemaRegistrySession() {
+	authenticator := session.authenticator
+	var err error
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
 	}
@@ -2719,13 +3050,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.esSchemaRegistryClient.Service.SetHTTPClient(session.serviceClient("event_streams"))
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	//COMPLIANCE Service
+// COMPLIANCE Service
+func (session *clientSession) configurePostureManagementV1() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURL string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -2736,13 +3071,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
+	postureManagementClientURL = endpointsFile.Endpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Visibility, c.Region, postureManagementClientURL)
 	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		URL:           c.EndpointFallBack("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURL),
+		AccountID:     core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 
 	// Construct the service client.
@@ -2752,14 +3085,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.postureManagementClient.Service.SetHTTPClient(session.serviceClient("scc"))
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	//COMPLIANCE Service v2 version
+// COMPLIANCE Service v2 version
+func (session *clientSession) configurePostureManagementV2() {
+	c, endpointsFile, authenticator := session.config, session.endpointsFile, session.authenticator
+	var err error
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURLv2 string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -2770,12 +3107,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
+	postureManagementClientURLv2 = endpointsFile.Endpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Visibility, c.Region, postureManagementClientURLv2)
 	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
+		URL:           c.EndpointFallBack("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURLv2),
 	}
 
 	// Construct the service client.
@@ -2785,19 +3120,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.postureManagementClientv2.Service.SetHTTPClient(session.serviceClient("scc"))
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -2806,23 +3134,28 @@ func CreateVersionDate() *string {
 	return &version
 }
 
-func newSession(c *Config) (*Session, error) {
+func newSession(c *Config, endpointsFile EndpointsFile, tokenAuth tokenAuthenticator) (*Session, error) {
 	ibmSession := &Session{}
+	retryPolicy := c.RetryPolicy()
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		HTTPClient: retryPolicy.Client(instrumentedClient("softlayer", nil)),
 	}
 
 	if c.IAMToken != "" {
 		log.Println("Configuring SoftLayer Session with token")
 		softlayerSession.IAMToken = c.IAMToken
 		softlayerSession.IAMRefreshToken = c.IAMRefreshToken
+		// The SoftLayer session refreshes an expired token only when it retries on its own. Its
+		// Retries counts the attempts rather than the retries, and the session doesn't retry below
+		// 2: allow the first attempt and a single retry on top of the retry policy for the refresh.
+		softlayerSession.Retries = 2
+		softlayerSession.RetryWait = retryPolicy.MinDelay
 	}
 	if c.SoftLayerAPIKey != "" && c.SoftLayerUserName != "" {
 		log.Println("Configuring SoftLayer Session with API key")
@@ -2835,49 +3168,62 @@ func newSession(c *Config) (*Session, error) {
 	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
-	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
+	if c.IAMTrustedProfileID != "" && c.IAMToken == "" && tokenAuth == nil {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
+	noRetries := 0
 
-	if c.IAMToken != "" {
+	iamToken, iamRefreshToken := c.IAMToken, c.IAMRefreshToken
+	if tokenAuth != nil {
+		token, err := tokenAuth.GetToken()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error occured while requesting the IAM token: %s", err)
+		}
+		// the token is refreshed by the authenticator, not with the refresh token of the
+		// credentials of the provider
+		iamToken, iamRefreshToken = "Bearer "+token, ""
+	}
+
+	if iamToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
 		bmxConfig := &bluemix.Config{
-			IAMAccessToken:  c.IAMToken,
-			IAMRefreshToken: c.IAMRefreshToken,
+			IAMAccessToken:  iamToken,
+			IAMRefreshToken: iamRefreshToken,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      &noRetries, // retried by the HTTP client of the session
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: c.endpointLocator(endpointsFile),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = tokenClient(tokenAuth, retryPolicy.Client(instrumentedClient("bluemix", http.NewHTTPClient(sess.Config))))
 		ibmSession.BluemixSession = sess
 	}
 
-	if c.BluemixAPIKey != "" {
+	if c.BluemixAPIKey != "" && tokenAuth == nil {
 		log.Println("Configuring IBM Cloud Session with API key")
 		var sess *bxsession.Session
 		bmxConfig := &bluemix.Config{
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      &noRetries, // retried by the HTTP client of the session
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: c.endpointLocator(endpointsFile),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 
 			//PowerServiceInstance: c.PowerServiceInstance,
 		}
@@ -2885,6 +3231,7 @@ func newSession(c *Config) (*Session, error) {
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = retryPolicy.Client(instrumentedClient("bluemix", http.NewHTTPClient(sess.Config)))
 		ibmSession.BluemixSession = sess
 	}
 
@@ -2898,6 +3245,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -2912,6 +3260,7 @@ func authenticateCF(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -2970,6 +3319,7 @@ func RefreshToken(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -2986,16 +3336,6 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	}
 	return defaultValue
 }
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
-	}
-	return defaultValue
-}
 
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
@@ -3010,29 +3350,6 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
-func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
-}
-
 func ContructEndpoint(subdomain, domain string) string {
 	endpoint := fmt.Sprintf("https://%s.%s", subdomain, domain)
 	return endpoint
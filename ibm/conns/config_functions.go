@@ -27,7 +27,7 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(c.HTTPClient, &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -54,7 +54,7 @@ func getBaseURL(region string) string {
  */
 func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(sess.Config.HTTPClient, &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -84,16 +84,7 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 
 				err := RefreshToken(sess)
 				if err != nil {
-					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
-							break
-						}
-						err = RefreshToken(sess)
-					}
-					if err != nil {
-						return nil, err
-					}
-
+					return nil, err
 				}
 				additionalHeaders.Add("Authorization", sess.Config.IAMAccessToken)
 				additionalHeaders.Add("X-Namespace-Id", n.GetID())
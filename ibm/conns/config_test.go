@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// testAccessToken returns an IAM access token of the test account, signed with a test key
+func testAccessToken(t testing.TB) string {
+	return testAccountToken(t, "4ea1882a2d3401ed1e459979941966ea")
+}
+
+func testAccountToken(t testing.TB, account string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-123",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": account},
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func testTokenConfig(t testing.TB) *Config {
+	return &Config{
+		IAMToken:            "Bearer " + testAccessToken(t),
+		IAMTrustedProfileID: "Profile-123",
+		Region:              "us-south",
+		Zone:                "dal12",
+		Visibility:          "public",
+	}
+}
+
+// callClients calls every method of the ClientSession which returns a client and an error
+func callClients(session ClientSession) {
+	v := reflect.ValueOf(session)
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	for i := 0; i < v.NumMethod(); i++ {
+		m := v.Method(i)
+		if m.Type().NumIn() != 0 || m.Type().NumOut() != 2 || m.Type().Out(1) != errorType {
+			continue
+		}
+		m.Call(nil)
+	}
+}
+
+func TestClientSessionConfiguresClientsOnFirstUse(t *testing.T) {
+	sess, err := testTokenConfig(t).ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	session := sess.(*clientSession)
+	if session.vpcAPI != nil || session.iamPolicyManagementAPI != nil || session.cisZonesV1Client != nil {
+		t.Fatal("expected the service clients to be configured on first use")
+	}
+
+	vpcAPI, err := session.VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url := vpcAPI.Service.GetServiceURL(); url != "https://us-south.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("unexpected VPC endpoint %s", url)
+	}
+	if again, _ := session.VpcV1API(); again != vpcAPI {
+		t.Fatal("expected the VPC client to be configured once")
+	}
+	if session.iamPolicyManagementAPI != nil || session.cisZonesV1Client != nil {
+		t.Fatal("expected only the VPC client to be configured")
+	}
+}
+
+func TestClientSessionConcurrentFirstUse(t *testing.T) {
+	sess, err := testTokenConfig(t).ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			callClients(sess.(ClientSession))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	sess, err := (&Config{Region: "us-south"}).ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	session := sess.(ClientSession)
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected the empty credentials error, got %v", err)
+	}
+	if _, err := session.CisZonesV1ClientSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected the empty credentials error, got %v", err)
+	}
+}
+
+// BenchmarkClientSession measures the configure time of the provider, the service clients are
+// configured on first use
+func BenchmarkClientSession(b *testing.B) {
+	c := testTokenConfig(b)
+	for i := 0; i < b.N; i++ {
+		if _, err := c.ClientSession(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkClientSessionSingleService measures the configure time of the provider for a
+// configuration which only uses the VPC service
+func BenchmarkClientSessionSingleService(b *testing.B) {
+	c := testTokenConfig(b)
+	for i := 0; i < b.N; i++ {
+		sess, err := c.ClientSession()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := sess.(ClientSession).VpcV1API(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkClientSessionAllServices measures the configure time of the provider when every
+// service client is configured, as ClientSession used to do before the clients were configured
+// on first use
+func BenchmarkClientSessionAllServices(b *testing.B) {
+	c := testTokenConfig(b)
+	for i := 0; i < b.N; i++ {
+		sess, err := c.ClientSession()
+		if err != nil {
+			b.Fatal(err)
+		}
+		callClients(sess.(ClientSession))
+	}
+}
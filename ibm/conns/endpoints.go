@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// EndpointServices maps the arguments of the endpoints block of the provider to the endpoint
+// variables of the services, see EndpointsFileKeys
+var EndpointServices = map[string]string{
+	"account_management":         "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"api_gateway":                "IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"certificate_manager":        "IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"compliance":                 "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"container":                  "IBMCLOUD_CS_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"cse":                        "IBMCLOUD_CSE_ENDPOINT",
+	"directlink":                 "IBMCLOUD_DL_API_ENDPOINT",
+	"directlink_provider":        "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"functions":                  "IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"hpcs":                       "IBMCLOUD_HPCS_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"iam_pap":                    "IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"icd":                        "IBMCLOUD_ICD_API_ENDPOINT",
+	"kms":                        "IBMCLOUD_KP_API_ENDPOINT",
+	"mccp":                       "IBMCLOUD_MCCP_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"push_notifications":         "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_catalog":           "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"satellite_v1":               "IBMCLOUD_SAT_API_ENDPOINT",
+	"scc_admin":                  "IBMCLOUD_SCC_ADMIN_API_ENDPOINT",
+	"scc_findings":               "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"uaa":                        "IBMCLOUD_UAA_ENDPOINT",
+	"user_management":            "IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// EndpointFallBack returns the endpoint of the service set in the endpoints block of the provider,
+// then in the environment variable of the service, or defaultValue
+func (c *Config) EndpointFallBack(key, defaultValue string) string {
+	if endpoint := c.Endpoints[key]; endpoint != "" {
+		return endpoint
+	}
+	return EnvFallBack([]string{key}, defaultValue)
+}
+
+// endpointLocator returns the endpoint locator of the bluemix-go clients, which looks up the
+// endpoints block of the provider and the endpoints file before the endpoints of bluemix-go.
+// A nil locator lets bluemix-go configure its own.
+func (c *Config) endpointLocator(file EndpointsFile) endpoints.EndpointLocator {
+	if file == nil && len(c.Endpoints) == 0 {
+		return nil
+	}
+	return &endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(c.Region, c.Visibility, c.EndpointsFile),
+		config:          c,
+		file:            file,
+	}
+}
+
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	config *Config
+	file   EndpointsFile
+}
+
+// endpoint keeps the precedence of the endpoints block, then the environment variable of the
+// service, over the endpoints file
+func (l *endpointLocator) endpoint(key string, fallback func() (string, error)) (string, error) {
+	if endpoint := l.config.Endpoints[key]; endpoint != "" {
+		return endpoint, nil
+	}
+	if os.Getenv(key) == "" {
+		if endpoint := l.file.Endpoint(key, l.config.Visibility, l.config.Region, ""); endpoint != "" {
+			return endpoint, nil
+		}
+	}
+	return fallback()
+}
+
+func (l *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointLocator) ContainerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointLocator) CisEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointLocator) IAMEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointLocator) ICDEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointLocator) UAAEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointLocator) CseEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *endpointLocator) SchematicsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointLocator) UserManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointLocator) HpcsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *endpointLocator) SatelliteEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SAT_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
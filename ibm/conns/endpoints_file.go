@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// EndpointsFileKeys are the services whose endpoints can be set in the endpoints file
+var EndpointsFileKeys = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_CSE_ENDPOINT",
+	"IBMCLOUD_CS_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_HPCS_API_ENDPOINT",
+	"IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_ICD_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SAT_API_ENDPOINT",
+	"IBMCLOUD_SCC_ADMIN_API_ENDPOINT",
+	"IBMCLOUD_SCC_FINDINGS_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_UAA_ENDPOINT",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+}
+
+// EndpointsFile is the content of the endpoints file of the provider. The endpoints of a service
+// are grouped by visibility and keyed by region, for example
+//
+//	{
+//	  "IBMCLOUD_IS_NG_API_ENDPOINT": {
+//	    "public":  {"us-south": "https://us-south.iaas.cloud.ibm.com/v1", "eu-*": "https://eu.example.com/v1"},
+//	    "private": {"*": "https://private.example.com/v1"}
+//	  }
+//	}
+//
+// A region key is either a region or a pattern, such as "eu-*" or "*", matching several regions.
+type EndpointsFile map[string]ServiceEndpoints
+
+// ServiceEndpoints are the endpoints of a service by region, for each visibility of the provider
+type ServiceEndpoints struct {
+	Public           map[string]string `json:"public,omitempty"`
+	Private          map[string]string `json:"private,omitempty"`
+	PublicAndPrivate map[string]string `json:"public-and-private,omitempty"`
+}
+
+// LoadEndpointsFile reads and validates the endpoints file, a nil file is returned when filename
+// is empty
+func LoadEndpointsFile(filename string) (EndpointsFile, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read the endpoints file %s: %s", filename, err)
+	}
+	file, err := ParseEndpointsFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s", filename, err)
+	}
+	return file, nil
+}
+
+// ParseEndpointsFile decodes and validates the content of an endpoints file. Every unknown
+// service or visibility, malformed region pattern and malformed URL is reported in the error. An
+// empty URL leaves the endpoint of the region unset.
+func ParseEndpointsFile(data []byte) (EndpointsFile, error) {
+	var content map[string]map[string]map[string]string
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	file := make(EndpointsFile, len(content))
+	for _, key := range sortedKeys(content) {
+		if i := sort.SearchStrings(EndpointsFileKeys, key); i == len(EndpointsFileKeys) || EndpointsFileKeys[i] != key {
+			problems = append(problems, fmt.Sprintf("unknown service %q", key))
+			continue
+		}
+		var service ServiceEndpoints
+		for _, visibility := range sortedKeys(content[key]) {
+			regions := content[key][visibility]
+			switch visibility {
+			case "public":
+				service.Public = regions
+			case "private":
+				service.Private = regions
+			case "public-and-private":
+				service.PublicAndPrivate = regions
+			default:
+				problems = append(problems, fmt.Sprintf("%s: unknown visibility %q, expected one of public, private or public-and-private", key, visibility))
+				continue
+			}
+			for _, region := range sortedKeys(regions) {
+				if _, err := path.Match(region, ""); err != nil || region == "" {
+					problems = append(problems, fmt.Sprintf("%s.%s: malformed region %q", key, visibility, region))
+				}
+				if regions[region] == "" {
+					// an empty endpoint leaves the region unset, as in the files of the older releases
+					delete(regions, region)
+					continue
+				}
+				if err := validateEndpointURL(regions[region]); err != nil {
+					problems = append(problems, fmt.Sprintf("%s.%s.%s: %s", key, visibility, region, err))
+				}
+			}
+		}
+		file[key] = service
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return file, nil
+}
+
+func validateEndpointURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("malformed URL %q", endpoint)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" || u.Host == "" {
+		return fmt.Errorf("malformed URL %q, expected an absolute http or https URL", endpoint)
+	}
+	return nil
+}
+
+// Endpoint returns the endpoint of the service for the visibility and region of the provider, or
+// defaultValue when the file does not set one. With the public-and-private visibility the
+// public-and-private endpoints are preferred over the private ones, then the public ones.
+func (f EndpointsFile) Endpoint(key, visibility, region, defaultValue string) string {
+	service, ok := f[key]
+	if !ok {
+		return defaultValue
+	}
+	var candidates []map[string]string
+	switch visibility {
+	case "public":
+		candidates = []map[string]string{service.Public}
+	case "private":
+		candidates = []map[string]string{service.Private}
+	case "public-and-private":
+		candidates = []map[string]string{service.PublicAndPrivate, service.Private, service.Public}
+	}
+	for _, regions := range candidates {
+		if endpoint, ok := regionEndpoint(regions, region); ok {
+			return endpoint
+		}
+	}
+	return defaultValue
+}
+
+// regionEndpoint returns the endpoint of the region. The region itself wins over the patterns
+// matching it, and a pattern with more literal characters wins over a broader one, so that
+// "eu-*" is preferred over "*".
+func regionEndpoint(regions map[string]string, region string) (string, bool) {
+	if endpoint, ok := regions[region]; ok {
+		return endpoint, true
+	}
+	best, bestLiterals := "", -1
+	for _, pattern := range sortedKeys(regions) {
+		if ok, _ := path.Match(pattern, region); !ok {
+			continue
+		}
+		if literals := len(pattern) - strings.Count(pattern, "*") - strings.Count(pattern, "?"); literals > bestLiterals {
+			best, bestLiterals = pattern, literals
+		}
+	}
+	if bestLiterals < 0 {
+		return "", false
+	}
+	return regions[best], true
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]map[string]map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
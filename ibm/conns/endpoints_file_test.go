@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const testEndpointsFile = `{
+	"IBMCLOUD_IS_NG_API_ENDPOINT": {
+		"public": {
+			"us-south": "https://us-south.vpc.example.com/v1",
+			"us-*": "https://us.vpc.example.com/v1",
+			"*": "https://vpc.example.com/v1"
+		},
+		"private": {
+			"*": "https://private.vpc.example.com/v1"
+		},
+		"public-and-private": {
+			"eu-de": "https://eu-de.direct.vpc.example.com/v1"
+		}
+	},
+	"IBMCLOUD_IAM_API_ENDPOINT": {
+		"public": {
+			"*": "https://iam.example.com"
+		}
+	}
+}`
+
+func TestEndpointsFileKeysSorted(t *testing.T) {
+	if !sort.StringsAreSorted(EndpointsFileKeys) {
+		t.Fatal("expected EndpointsFileKeys to be sorted")
+	}
+}
+
+func TestEndpointsFileEndpoint(t *testing.T) {
+	file, err := ParseEndpointsFile([]byte(testEndpointsFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	cases := []struct {
+		key, visibility, region, expected string
+	}{
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-south", "https://us-south.vpc.example.com/v1"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-east", "https://us.vpc.example.com/v1"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public", "jp-tok", "https://vpc.example.com/v1"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "private", "us-south", "https://private.vpc.example.com/v1"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public-and-private", "eu-de", "https://eu-de.direct.vpc.example.com/v1"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public-and-private", "us-south", "https://private.vpc.example.com/v1"},
+		{"IBMCLOUD_IAM_API_ENDPOINT", "public-and-private", "us-south", "https://iam.example.com"},
+		{"IBMCLOUD_IAM_API_ENDPOINT", "private", "us-south", "default"},
+		{"IBMCLOUD_TG_API_ENDPOINT", "public", "us-south", "default"},
+	}
+	for _, c := range cases {
+		if endpoint := file.Endpoint(c.key, c.visibility, c.region, "default"); endpoint != c.expected {
+			t.Errorf("%s %s %s: expected %s, got %s", c.key, c.visibility, c.region, c.expected, endpoint)
+		}
+	}
+
+	var none EndpointsFile
+	if endpoint := none.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", "public", "us-south", "default"); endpoint != "default" {
+		t.Fatalf("expected the default endpoint without an endpoints file, got %s", endpoint)
+	}
+}
+
+func TestParseEndpointsFileEmptyEndpoint(t *testing.T) {
+	file, err := ParseEndpointsFile([]byte(`{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "", "*": "https://vpc.example.com/v1"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"private": {"us-south": ""}}
+	}`))
+	if err != nil {
+		t.Fatalf("expected an empty endpoint to be accepted: %s", err)
+	}
+	if endpoint := file.Endpoint("IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-south", "default"); endpoint != "https://vpc.example.com/v1" {
+		t.Errorf("expected the region with an empty endpoint to match the other patterns, got %s", endpoint)
+	}
+	if endpoint := file.Endpoint("IBMCLOUD_IAM_API_ENDPOINT", "private", "us-south", "default"); endpoint != "default" {
+		t.Errorf("expected the default endpoint for an empty endpoint, got %s", endpoint)
+	}
+}
+
+func TestParseEndpointsFileReportsEveryProblem(t *testing.T) {
+	_, err := ParseEndpointsFile([]byte(`{
+		"IBMCLOUD_IS_NG_API_ENDPOINTS": {"public": {"us-south": "https://vpc.example.com"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {
+			"internal": {"us-south": "https://iam.example.com"},
+			"public": {"us-south": "iam.example.com", "[us": "https://iam.example.com"}
+		}
+	}`))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, problem := range []string{
+		`unknown service "IBMCLOUD_IS_NG_API_ENDPOINTS"`,
+		`IBMCLOUD_IAM_API_ENDPOINT: unknown visibility "internal"`,
+		`IBMCLOUD_IAM_API_ENDPOINT.public.us-south: malformed URL "iam.example.com"`,
+		`IBMCLOUD_IAM_API_ENDPOINT.public: malformed region "[us"`,
+	} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("expected the error to report %s, got %s", problem, err)
+		}
+	}
+
+	if _, err := ParseEndpointsFile([]byte(`{"IBMCLOUD_IAM_API_ENDPOINT": {"public": {"us-south": 1}}}`)); err == nil {
+		t.Fatal("expected an error for an endpoint which is not a string")
+	}
+}
+
+func TestClientSessionEndpointsFile(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "endpoints.json")
+	if err := ioutil.WriteFile(filename, []byte(testEndpointsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+
+	c := testTokenConfig(t)
+	c.EndpointsFile = filename
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	vpcAPI, err := sess.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url := vpcAPI.Service.GetServiceURL(); url != "https://us-south.vpc.example.com/v1" {
+		t.Fatalf("unexpected VPC endpoint %s", url)
+	}
+	bmxSession, err := sess.(ClientSession).BluemixSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url, _ := bmxSession.Config.EndpointLocator.IAMEndpoint(); url != "https://iam.example.com" {
+		t.Fatalf("unexpected IAM endpoint of the bluemix session %s", url)
+	}
+
+	if err := ioutil.WriteFile(filename, []byte(`{"IBMCLOUD_IAM_API_ENDPOINT": {"public": {"*": "iam"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ClientSession(); err == nil || !strings.Contains(err.Error(), `malformed URL "iam"`) {
+		t.Fatalf("expected the malformed URL to be reported, got %v", err)
+	}
+}
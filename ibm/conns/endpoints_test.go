@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestEndpointServicesKeys(t *testing.T) {
+	for service, key := range EndpointServices {
+		if i := sort.SearchStrings(EndpointsFileKeys, key); i == len(EndpointsFileKeys) || EndpointsFileKeys[i] != key {
+			t.Errorf("the endpoint variable %s of %s is not one of EndpointsFileKeys", key, service)
+		}
+	}
+}
+
+func TestConfigEndpointFallBack(t *testing.T) {
+	c := &Config{Endpoints: map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "http://127.0.0.1:8080/v1"}}
+	os.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://env.example.com/v1")
+	os.Setenv("IBMCLOUD_TG_API_ENDPOINT", "https://env.example.com/v1")
+	defer os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+	defer os.Unsetenv("IBMCLOUD_TG_API_ENDPOINT")
+
+	if endpoint := c.EndpointFallBack("IBMCLOUD_IS_NG_API_ENDPOINT", "default"); endpoint != "http://127.0.0.1:8080/v1" {
+		t.Fatalf("expected the endpoints block to have precedence, got %s", endpoint)
+	}
+	if endpoint := c.EndpointFallBack("IBMCLOUD_TG_API_ENDPOINT", "default"); endpoint != "https://env.example.com/v1" {
+		t.Fatalf("expected the environment variable, got %s", endpoint)
+	}
+	if endpoint := c.EndpointFallBack("IBMCLOUD_DL_API_ENDPOINT", "default"); endpoint != "default" {
+		t.Fatalf("expected the default endpoint, got %s", endpoint)
+	}
+}
+
+func TestClientSessionEndpoints(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := ioutil.WriteFile(filename, []byte(testEndpointsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := testTokenConfig(t)
+	c.EndpointsFile = filename
+	c.Endpoints = map[string]string{
+		"IBMCLOUD_IS_NG_API_ENDPOINT":               "http://127.0.0.1:8080/v1",
+		"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "http://127.0.0.1:8081",
+	}
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	vpcAPI, err := sess.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url := vpcAPI.Service.GetServiceURL(); url != "http://127.0.0.1:8080/v1" {
+		t.Fatalf("expected the endpoints block to have precedence over the endpoints file, got %s", url)
+	}
+	bmxSession, err := sess.(ClientSession).BluemixSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url, _ := bmxSession.Config.EndpointLocator.ResourceControllerEndpoint(); url != "http://127.0.0.1:8081" {
+		t.Fatalf("unexpected resource controller endpoint of the bluemix session %s", url)
+	}
+	if url, _ := bmxSession.Config.EndpointLocator.IAMEndpoint(); url != "https://iam.example.com" {
+		t.Fatalf("unexpected IAM endpoint of the bluemix session %s", url)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ResourceLocks serializes the changes of the resources which share a parent, such as the
+// listeners and the pools of a load balancer, on the key of the parent
+var ResourceLocks = NewKeyedLock()
+
+// KeyedLock is a set of read/write locks identified by keys. The waits for a lock end with the
+// context, and the locks of a key are removed once they are released by all their holders and
+// waiters.
+type KeyedLock struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLockEntry
+}
+
+type keyedLockEntry struct {
+	// refs counts the holders and the waiters of the lock
+	refs           int
+	readers        int
+	writer         bool
+	writersWaiting int
+	// released is closed and replaced when the lock is released
+	released chan struct{}
+}
+
+// NewKeyedLock returns an empty KeyedLock
+func NewKeyedLock() *KeyedLock {
+	return &KeyedLock{locks: make(map[string]*keyedLockEntry)}
+}
+
+// Lock locks the key for writing, it waits for the readers and the writer holding it until the
+// context is done. The returned function releases the lock.
+func (l *KeyedLock) Lock(ctx context.Context, key string) (func(), error) {
+	return l.lock(ctx, key, true)
+}
+
+// RLock locks the key for reading, it waits for the writer holding it and the writers waiting for
+// it until the context is done. The readers of a key hold it at the same time. The returned
+// function releases the lock.
+func (l *KeyedLock) RLock(ctx context.Context, key string) (func(), error) {
+	return l.lock(ctx, key, false)
+}
+
+func (l *KeyedLock) lock(ctx context.Context, key string, write bool) (func(), error) {
+	mode := "reading"
+	if write {
+		mode = "writing"
+	}
+	log.Printf("[DEBUG] Locking %q for %s", key, mode)
+	ctx, span := Tracer().Start(ctx, "lock", trace.WithAttributes(attribute.String("lock.key", key), attribute.Bool("lock.write", write)))
+	start := time.Now()
+
+	l.mu.Lock()
+	entry, ok := l.locks[key]
+	if !ok {
+		entry = &keyedLockEntry{released: make(chan struct{})}
+		l.locks[key] = entry
+	}
+	entry.refs++
+	if write {
+		entry.writersWaiting++
+	}
+	for {
+		if write && !entry.writer && entry.readers == 0 {
+			entry.writersWaiting--
+			entry.writer = true
+			break
+		}
+		// the waiting writers go first, so that a stream of readers doesn't starve them
+		if !write && !entry.writer && entry.writersWaiting == 0 {
+			entry.readers++
+			break
+		}
+		released := entry.released
+		l.mu.Unlock()
+		select {
+		case <-released:
+			l.mu.Lock()
+		case <-ctx.Done():
+			l.mu.Lock()
+			if write {
+				entry.writersWaiting--
+				// the readers waiting for this writer may go
+				l.release(key, entry)
+			} else {
+				l.unref(key, entry)
+			}
+			l.mu.Unlock()
+			err := fmt.Errorf("[ERROR] Timed out after %s waiting for the lock of %q held by another resource: %s", time.Since(start).Round(time.Millisecond), key, ctx.Err())
+			EndSpan(span, err)
+			return nil, err
+		}
+	}
+	l.mu.Unlock()
+
+	waited := time.Since(start)
+	log.Printf("[DEBUG] Locked %q for %s after waiting %s", key, mode, waited.Round(time.Millisecond))
+	span.SetAttributes(attribute.Int64("lock.wait_ms", waited.Milliseconds()))
+	span.End()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if write {
+				entry.writer = false
+			} else {
+				entry.readers--
+			}
+			l.release(key, entry)
+			log.Printf("[DEBUG] Unlocked %q for %s", key, mode)
+		})
+	}, nil
+}
+
+// release wakes up the waiters of the entry and drops a reference to it, l.mu is held
+func (l *KeyedLock) release(key string, entry *keyedLockEntry) {
+	close(entry.released)
+	entry.released = make(chan struct{})
+	l.unref(key, entry)
+}
+
+// unref drops a reference to the entry and removes it when it is unused, l.mu is held
+func (l *KeyedLock) unref(key string, entry *keyedLockEntry) {
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.locks, key)
+	}
+}
+
+// len returns the number of keys in use
+func (l *KeyedLock) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.locks)
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// testLockAcquired returns whether lock acquires the key within 50ms, the lock is released when
+// the test ends
+func testLockAcquired(t *testing.T, lock func(context.Context, string) (func(), error), key string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	unlock, err := lock(ctx, key)
+	if err != nil {
+		return false
+	}
+	t.Cleanup(unlock)
+	return true
+}
+
+func TestKeyedLock(t *testing.T) {
+	l := NewKeyedLock()
+
+	unlock, err := l.Lock(context.Background(), "load_balancer_key_r006-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if testLockAcquired(t, l.Lock, "load_balancer_key_r006-1") || testLockAcquired(t, l.RLock, "load_balancer_key_r006-1") {
+		t.Fatal("expected a locked key not to be acquired")
+	}
+	if !testLockAcquired(t, l.Lock, "load_balancer_key_r006-2") {
+		t.Fatal("expected another key to be acquired")
+	}
+
+	unlock()
+	unlock()
+	if !testLockAcquired(t, l.RLock, "load_balancer_key_r006-1") || !testLockAcquired(t, l.RLock, "load_balancer_key_r006-1") {
+		t.Fatal("expected the readers to hold an unlocked key at the same time")
+	}
+	if testLockAcquired(t, l.Lock, "load_balancer_key_r006-1") {
+		t.Fatal("expected a key held by readers not to be locked for writing")
+	}
+}
+
+func TestKeyedLockWritersGoFirst(t *testing.T) {
+	l := NewKeyedLock()
+	runlock, _ := l.RLock(context.Background(), "sg")
+
+	locked := make(chan func())
+	go func() {
+		unlock, _ := l.Lock(context.Background(), "sg")
+		locked <- unlock
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if testLockAcquired(t, l.RLock, "sg") {
+		t.Fatal("expected a reader to wait for the waiting writer")
+	}
+
+	runlock()
+	select {
+	case unlock := <-locked:
+		unlock()
+	case <-time.After(time.Second):
+		t.Fatal("expected the writer to lock the key released by the reader")
+	}
+	if !testLockAcquired(t, l.RLock, "sg") {
+		t.Fatal("expected a reader to lock the released key")
+	}
+}
+
+func TestKeyedLockRemovesUnusedKeys(t *testing.T) {
+	l := NewKeyedLock()
+	unlock, _ := l.Lock(context.Background(), "dns")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Lock(ctx, "dns"); err == nil {
+		t.Fatal("expected the wait to end with the context")
+	}
+	if _, err := l.RLock(ctx, "dns"); err == nil {
+		t.Fatal("expected the wait to end with the context")
+	}
+	unlock()
+	if n := l.len(); n != 0 {
+		t.Fatalf("expected the unused keys to be removed, got %d keys", n)
+	}
+}
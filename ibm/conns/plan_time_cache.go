@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "sync"
+
+// PlanTimeCache holds the catalogs of the services which the plan time validations of the
+// resources query, such as the instance profiles of a region. Each catalog is queried once per
+// run of the provider.
+type PlanTimeCache struct {
+	mu      sync.Mutex
+	entries map[string]*planTimeEntry
+}
+
+type planTimeEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// Load returns the catalog cached under the key, it is loaded with load on first use. A failed
+// load is cached too, so that a failing service is not queried for every resource.
+func (c *PlanTimeCache) Load(key string, load func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*planTimeEntry)
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &planTimeEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = load()
+	})
+	return entry.value, entry.err
+}
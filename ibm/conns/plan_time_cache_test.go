@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestPlanTimeCacheLoad(t *testing.T) {
+	cache := &PlanTimeCache{}
+	var mu sync.Mutex
+	loads := map[string]int{}
+	load := func(key string, err error) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			loads[key]++
+			return key + "-catalog", err
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := cache.Load("profiles", load("profiles", nil)); err != nil || v != "profiles-catalog" {
+				t.Errorf("unexpected catalog %v %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	failure := errors.New("unavailable")
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Load("flavors/us-south-1", load("flavors/us-south-1", failure)); err != failure {
+			t.Errorf("expected the error of the load, got %v", err)
+		}
+	}
+	if loads["profiles"] != 1 || loads["flavors/us-south-1"] != 1 {
+		t.Fatalf("expected each catalog to be loaded once, got %v", loads)
+	}
+}
+
+func TestClientSessionPlanTimeValidation(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		sess, err := (&Config{PlanTimeValidation: enabled}).ClientSession()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if cache := sess.(ClientSession).PlanTimeValidation(); (cache != nil) != enabled {
+			t.Errorf("expected the cache to be set when plan_time_validation is %t, got %v", enabled, cache)
+		}
+	}
+}
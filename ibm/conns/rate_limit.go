@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	"math"
+	gohttp "net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitServices are the services whose request rate can be limited with the rate_limits
+// argument of the provider, sorted for ValidateRateLimits
+var RateLimitServices = []string{
+	"account_management",
+	"api_gateway",
+	"app_configuration",
+	"appid",
+	"atracker",
+	"catalog_management",
+	"certificate_manager",
+	"cis",
+	"cloud_shell",
+	"container",
+	"container_registry",
+	"context_based_restrictions",
+	"cos",
+	"directlink",
+	"enterprise",
+	"event_notifications",
+	"event_streams",
+	"functions",
+	"global_search",
+	"global_tagging",
+	"hpcs",
+	"iam",
+	"icd",
+	"kms",
+	"mccp",
+	"power",
+	"private_dns",
+	"push_notifications",
+	"resource_catalog",
+	"resource_controller",
+	"resource_manager",
+	"satellite",
+	"scc",
+	"schematics",
+	"secrets_manager",
+	"transit_gateway",
+	"user_management",
+	"vpc",
+}
+
+// rateLimiters holds a token bucket per service, shared by all the clients of the service
+type rateLimiters map[string]*rate.Limiter
+
+// ValidateRateLimits checks that the limits are set for known services and are positive
+func ValidateRateLimits(limits map[string]float64) error {
+	services := make([]string, 0, len(limits))
+	for service := range limits {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		if i := sort.SearchStrings(RateLimitServices, service); i == len(RateLimitServices) || RateLimitServices[i] != service {
+			return fmt.Errorf("[ERROR] Unknown service %q in rate_limits, expected one of %v", service, RateLimitServices)
+		}
+		if limits[service] <= 0 {
+			return fmt.Errorf("[ERROR] The rate limit of %s must be a positive number of requests per second, got %v", service, limits[service])
+		}
+	}
+	return nil
+}
+
+// rateLimiters returns a token bucket for each service limited in the configuration. A bucket
+// refills at the configured number of requests per second and holds up to one second of requests.
+func (c *Config) rateLimiters() rateLimiters {
+	limiters := make(rateLimiters, len(c.RateLimits))
+	for service, limit := range c.RateLimits {
+		burst := int(math.Ceil(limit))
+		if burst < 1 {
+			burst = 1
+		}
+		limiters[service] = rate.NewLimiter(rate.Limit(limit), burst)
+	}
+	return limiters
+}
+
+// Client returns a pooled http client whose requests to the service are rate limited,
+// or nil when the service is not limited
+func (l rateLimiters) Client(service string) *gohttp.Client {
+	if l[service] == nil {
+		return nil
+	}
+	client := cleanhttp.DefaultPooledClient()
+	client.Transport = l.Transport(service, client.Transport)
+	return client
+}
+
+// Transport wraps the round tripper with the limiter of the service, base is returned as is
+// when the service is not limited
+func (l rateLimiters) Transport(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	limiter := l[service]
+	if limiter == nil {
+		return base
+	}
+	if base == nil {
+		base = cleanhttp.DefaultPooledTransport()
+	}
+	return &rateLimitedTransport{service: service, limiter: limiter, base: base}
+}
+
+// rateLimitedTransport waits for a token of the limiter before sending each request
+type rateLimitedTransport struct {
+	service string
+	limiter *rate.Limiter
+	base    gohttp.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	r := t.limiter.Reserve()
+	if delay := r.Delay(); delay > 0 {
+		log.Printf("[DEBUG] Rate limit of %s reached, delaying %s %s by %s", t.service, req.Method, req.URL.Path, delay)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			r.Cancel()
+			return nil, req.Context().Err()
+		}
+	}
+	return t.base.RoundTrip(req)
+}
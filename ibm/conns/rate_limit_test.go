@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestValidateRateLimits(t *testing.T) {
+	if err := ValidateRateLimits(map[string]float64{"vpc": 20, "iam": 0.5}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := ValidateRateLimits(map[string]float64{"vpcs": 20}); err == nil {
+		t.Fatal("expected an error for an unknown service")
+	}
+	if err := ValidateRateLimits(map[string]float64{"vpc": 0}); err == nil {
+		t.Fatal("expected an error for a limit which is not positive")
+	}
+}
+
+func TestValidateRateLimitsServices(t *testing.T) {
+	if !sort.StringsAreSorted(RateLimitServices) {
+		t.Fatalf("expected the services to be sorted, got %v", RateLimitServices)
+	}
+	for _, service := range RateLimitServices {
+		if err := ValidateRateLimits(map[string]float64{service: 1}); err != nil {
+			t.Errorf("unexpected error for %s: %s", service, err)
+		}
+	}
+}
+
+func TestRateLimitersUnlimitedService(t *testing.T) {
+	l := (&Config{RateLimits: map[string]float64{"vpc": 20}}).rateLimiters()
+	if l.Client("iam") != nil {
+		t.Fatal("expected no client for a service without a limit")
+	}
+	base := http.DefaultTransport
+	if l.Transport("iam", base) != base {
+		t.Fatal("expected the base transport for a service without a limit")
+	}
+}
+
+func TestRateLimitersLimitRequests(t *testing.T) {
+	server, attempts := testServer(t)
+	l := (&Config{RateLimits: map[string]float64{"vpc": 20}}).rateLimiters()
+
+	// the first second of requests is sent at once, the next 10 wait for the bucket to refill
+	client := l.Client("vpc")
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("expected 30 requests at 20 per second to take at least 450ms, took %s", elapsed)
+	}
+	if *attempts != 30 {
+		t.Fatalf("expected 30 requests, got %d", *attempts)
+	}
+}
+
+func TestRateLimitersShareBucketAcrossClients(t *testing.T) {
+	server, _ := testServer(t)
+	l := (&Config{RateLimits: map[string]float64{"iam": 1}}).rateLimiters()
+
+	resp, err := l.Client("iam").Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := l.Client("iam").Do(req); err == nil {
+		t.Fatal("expected the second client of the service to wait for the shared bucket")
+	}
+}
+
+func TestRateLimitersLimitRetries(t *testing.T) {
+	server, attempts := testServer(t, 503, 503)
+	l := (&Config{RateLimits: map[string]float64{"vpc": 2}}).rateLimiters()
+
+	p := testRetryPolicy()
+	start := time.Now()
+	resp, err := p.Client(l.Client("vpc")).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || *attempts != 3 {
+		t.Fatalf("expected status 200 after 3 attempts, got %d after %d", resp.StatusCode, *attempts)
+	}
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("expected the retry to wait for the rate limit, took %s", elapsed)
+	}
+}
+
+func TestBluemixServiceSession(t *testing.T) {
+	server, _ := testServer(t)
+	bmxSession := &bxsession.Session{Config: &bluemix.Config{HTTPClient: http.DefaultClient}}
+	session := &clientSession{
+		session:      &Session{BluemixSession: bmxSession},
+		retryPolicy:  testRetryPolicy(),
+		rateLimiters: (&Config{RateLimits: map[string]float64{"container": 1}}).rateLimiters(),
+	}
+	if session.bluemixServiceSession("resource_controller") != bmxSession {
+		t.Fatal("expected the session as is for a service without a limit")
+	}
+
+	limited := session.bluemixServiceSession("container")
+	if limited == bmxSession || limited.Config.HTTPClient == bmxSession.Config.HTTPClient || bmxSession.Config.HTTPClient != http.DefaultClient {
+		t.Fatal("expected a copy of the session with its own HTTP client for a limited service")
+	}
+	resp, err := limited.Config.HTTPClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := session.bluemixServiceSession("container").Config.HTTPClient.Do(req); err == nil {
+		t.Fatal("expected the clients of the service to share the bucket")
+	}
+}
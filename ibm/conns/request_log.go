@@ -0,0 +1,411 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/trace"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// RequestIDHeader is the header of the request ID sent with every request, the services of
+	// IBM Cloud log it and return it in their responses
+	RequestIDHeader = "X-Request-ID"
+
+	redacted = "[redacted]"
+
+	// maxLoggedBodySize caps the size of the bodies logged at the TRACE level
+	maxLoggedBodySize = 16 * 1024
+)
+
+// transactionIDHeaders are the response headers which identify a request in the support cases
+// of the services
+var transactionIDHeaders = []string{"Transaction-Id", "X-Global-Transaction-Id", "X-Correlation-Id"}
+
+// redactedHeaders are the headers holding credentials, they are redacted from the logs
+var redactedHeaders = map[string]bool{
+	"Authorization":        true,
+	"Cookie":               true,
+	"Set-Cookie":           true,
+	"Refresh-Token":        true,
+	"X-Api-Key":            true,
+	"X-Auth-Refresh-Token": true,
+	"X-Auth-Token":         true,
+	"X-Auth-User-Token":    true,
+}
+
+// secretFields are the names of the body fields whose values are redacted from the logs,
+// compared in lower case without "_" and "-". The fields containing one of secretFieldParts,
+// such as adminpassword, are redacted too.
+var secretFields = map[string]bool{
+	"accesstoken":           true,
+	"credentials":           true,
+	"delegatedrefreshtoken": true,
+	"iamtoken":              true,
+	"keymaterial":           true,
+	"payload":               true,
+	"refreshtoken":          true,
+	"token":                 true,
+}
+
+var secretFieldParts = []string{"apikey", "passcode", "passphrase", "password", "privatekey", "secret"}
+
+var (
+	jsonStringFieldRe = regexp.MustCompile(`("([^"\\]+)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	headerLineRe      = regexp.MustCompile(`(?m)^([A-Za-z0-9-]+):[ \t][^\r\n]*`)
+)
+
+// isSecretField returns whether the values of the field are redacted from the logs
+func isSecretField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	if secretFields[name] {
+		return true
+	}
+	for _, part := range secretFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactSecrets returns the text with the values of the secret fields of JSON and form bodies and
+// the values of the credential headers redacted. It is applied to the debug output of the SDKs.
+func RedactSecrets(text string) string {
+	text = core.RedactSecrets(text)
+	text = jsonStringFieldRe.ReplaceAllStringFunc(text, func(field string) string {
+		match := jsonStringFieldRe.FindStringSubmatch(field)
+		if !isSecretField(match[2]) {
+			return field
+		}
+		return match[1] + `"` + redacted + `"`
+	})
+	return headerLineRe.ReplaceAllStringFunc(text, func(line string) string {
+		name := headerLineRe.FindStringSubmatch(line)[1]
+		if !redactedHeaders[gohttp.CanonicalHeaderKey(name)] {
+			return line
+		}
+		return name + ": " + redacted
+	})
+}
+
+// redactBody returns the body with the values of the secret fields redacted. The nested values of
+// a secret field of a JSON body are redacted as a whole.
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return RedactSecrets(string(body))
+	}
+	b, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSecretField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+// redactHeaders returns the headers as a map of their first values with the credentials redacted
+func redactHeaders(header gohttp.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if redactedHeaders[name] {
+			headers[name] = redacted
+		} else if len(values) > 0 {
+			headers[name] = values[0]
+		}
+	}
+	return headers
+}
+
+// OperationLog identifies the operation of a resource or data source which sends the requests.
+// The requests sent with a context carrying it are logged with its fields.
+type OperationLog struct {
+	// ResourceType is the type of the resource or data source, such as ibm_is_vpc
+	ResourceType string
+	// ResourceID is the ID of the resource, empty until it is created
+	ResourceID string
+	// Operation is one of create, read, update, delete or import
+	Operation string
+	// OperationID correlates the log lines of the operation
+	OperationID string
+}
+
+type operationLogKey struct{}
+
+// WithOperationLog returns a context carrying the operation
+func WithOperationLog(ctx context.Context, operation OperationLog) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, operation)
+}
+
+// OperationLogFromContext returns the operation carried by the context
+func OperationLogFromContext(ctx context.Context) (OperationLog, bool) {
+	operation, ok := ctx.Value(operationLogKey{}).(OperationLog)
+	return operation, ok
+}
+
+// Fields returns the fields of the log lines of the operation
+func (o OperationLog) Fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"tf_resource_type": o.ResourceType,
+		"tf_operation":     o.Operation,
+		"tf_operation_id":  o.OperationID,
+	}
+	if o.ResourceID != "" {
+		fields["tf_resource_id"] = o.ResourceID
+	}
+	return fields
+}
+
+// NewOperationID returns a random ID correlating the log lines of an operation
+func NewOperationID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return id
+}
+
+// LogArgs returns the fields as the key and value pairs of the arguments of tflog, sorted by key
+func LogArgs(fields map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	args := make([]interface{}, 0, 2*len(fields))
+	for _, k := range keys {
+		args = append(args, k, fields[k])
+	}
+	return args
+}
+
+// logRequest logs a request with the logger of tflog of its context, at the trace or debug level.
+// A request sent without a logger in its context, outside of the functions which the SDK passes
+// a context to, is logged with the log package.
+func logRequest(ctx context.Context, level, message string, fields map[string]interface{}) {
+	// tflog.With returns the context as is when it carries no logger
+	if tflog.With(ctx, "service", fields["service"]) == ctx {
+		log.Printf("[%s] %s: %v", strings.ToUpper(level), message, fields)
+		return
+	}
+	if level == "trace" {
+		tflog.Trace(ctx, message, LogArgs(fields)...)
+	} else {
+		tflog.Debug(ctx, message, LogArgs(fields)...)
+	}
+}
+
+// traceLogging returns whether Terraform logs at the TRACE level, the bodies of the requests are
+// logged only then
+func traceLogging() bool {
+	for _, v := range []string{"TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := os.Getenv(v); level != "" {
+			return strings.EqualFold(level, "TRACE") || level == "1"
+		}
+	}
+	return false
+}
+
+// instrumentedClient returns a pooled http client which logs and traces the requests sent with
+// base to the service. A pooled client is used when base is nil.
+func instrumentedClient(service string, base *gohttp.Client) *gohttp.Client {
+	if base == nil {
+		base = cleanhttp.DefaultPooledClient()
+	}
+	client := *base
+	client.Transport = instrumentedTransport(service, base.Transport)
+	return &client
+}
+
+// instrumentedTransport wraps the round tripper with the logging and the tracing of the requests
+// to the service
+func instrumentedTransport(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	return requestLogTransport(service, traceTransport(service, base))
+}
+
+// requestLogTransport wraps the round tripper with the request logging of the service
+func requestLogTransport(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = cleanhttp.DefaultPooledTransport()
+	}
+	return &requestLoggingTransport{service: service, base: base, bodies: traceLogging()}
+}
+
+// requestLoggingTransport sets the request ID of each request and logs each attempt of the
+// request with its response with tflog, with the fields of the operation sending it
+type requestLoggingTransport struct {
+	service string
+	base    gohttp.RoundTripper
+	bodies  bool
+}
+
+func (t *requestLoggingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	ctx := req.Context()
+	req = req.Clone(ctx)
+	if req.Header.Get(RequestIDHeader) == "" {
+		req.Header.Set(RequestIDHeader, NewOperationID())
+	}
+	fields := map[string]interface{}{
+		"service":        t.service,
+		"http_method":    req.Method,
+		"http_url":       RedactSecrets(req.URL.Redacted()),
+		"x_request_id":   req.Header.Get(RequestIDHeader),
+		"request_header": redactHeaders(req.Header),
+	}
+	if operation, ok := OperationLogFromContext(ctx); ok {
+		for k, v := range operation.Fields() {
+			fields[k] = v
+		}
+	}
+	if t.bodies && req.Body != nil && req.Body != gohttp.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fields["request_body"] = loggedBody(body)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = RedactSecrets(err.Error())
+		logRequest(ctx, "debug", "HTTP request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	for _, header := range transactionIDHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			fields["transaction_id"] = v
+			break
+		}
+	}
+	if v := resp.Header.Get(RequestIDHeader); v != "" {
+		fields["x_request_id"] = v
+	}
+	if t.bodies && resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fields["response_header"] = redactHeaders(resp.Header)
+		fields["response_body"] = loggedBody(body)
+		logRequest(ctx, "trace", "HTTP request", fields)
+		return resp, nil
+	}
+	delete(fields, "request_header")
+	logRequest(ctx, "debug", "HTTP request", fields)
+	return resp, nil
+}
+
+func loggedBody(body []byte) string {
+	if len(body) > maxLoggedBodySize {
+		return RedactSecrets(string(body[:maxLoggedBodySize])) + "...[truncated]"
+	}
+	return redactBody(body)
+}
+
+// redactingLogger redacts the secrets from the messages of the SDK logger
+type redactingLogger struct {
+	core.Logger
+}
+
+func (l redactingLogger) Log(level core.LogLevel, format string, inserts ...interface{}) {
+	if l.IsLogLevelEnabled(level) {
+		l.Logger.Log(level, "%s", RedactSecrets(fmt.Sprintf(format, inserts...)))
+	}
+}
+
+func (l redactingLogger) Error(format string, inserts ...interface{}) {
+	l.Log(core.LevelError, format, inserts...)
+}
+
+func (l redactingLogger) Warn(format string, inserts ...interface{}) {
+	l.Log(core.LevelWarn, format, inserts...)
+}
+
+func (l redactingLogger) Info(format string, inserts ...interface{}) {
+	l.Log(core.LevelInfo, format, inserts...)
+}
+
+func (l redactingLogger) Debug(format string, inserts ...interface{}) {
+	l.Log(core.LevelDebug, format, inserts...)
+}
+
+// redactingPrinter redacts the secrets from the trace of the bluemix-go clients
+type redactingPrinter struct {
+	trace.Printer
+}
+
+func (p redactingPrinter) Print(v ...interface{}) {
+	p.Printer.Print(RedactSecrets(fmt.Sprint(v...)))
+}
+
+func (p redactingPrinter) Printf(format string, v ...interface{}) {
+	p.Printer.Print(RedactSecrets(fmt.Sprintf(format, v...)))
+}
+
+func (p redactingPrinter) Println(v ...interface{}) {
+	p.Printer.Println(RedactSecrets(fmt.Sprint(v...)))
+}
+
+// redactSDKLogs redacts the secrets from the debug output of the SDKs
+func redactSDKLogs() {
+	if _, ok := trace.Logger.(redactingPrinter); !ok {
+		trace.Logger = redactingPrinter{trace.Logger}
+	}
+	if _, ok := core.GetLogger().(redactingLogger); !ok {
+		core.SetLogger(redactingLogger{core.GetLogger()})
+	}
+}
+
+// serviceClient returns the http client of the service, which logs, traces, rate limits and
+// retries each request
+func (session *clientSession) serviceClient(service string) *gohttp.Client {
+	return session.retryPolicy.Client(instrumentedClient(service, session.rateLimiters.Client(service)))
+}
+
+// serviceTransport wraps the round tripper of the service with the logging, the tracing, the rate
+// limit and the retries of the requests
+func (session *clientSession) serviceTransport(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	return session.retryPolicy.Transport(instrumentedTransport(service, session.rateLimiters.Transport(service, base)))
+}
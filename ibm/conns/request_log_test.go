@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// testLogContext returns a context carrying a logger of tflog, and a function which returns the
+// output of the logger
+func testLogContext(t *testing.T) (context.Context, func() string) {
+	f, err := ioutil.TempFile(t.TempDir(), "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	// the logger writes to the standard error of the time it is created
+	stderr := os.Stderr
+	os.Stderr = f
+	defer func() { os.Stderr = stderr }()
+	ctx := tfsdklog.NewRootProviderLogger(context.Background(), tfsdklog.WithLevel(hclog.Trace), tfsdklog.WithoutLocation())
+	return ctx, func() string {
+		b, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+}
+
+func testLogOutput(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	out, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(out)
+		log.SetFlags(flags)
+	})
+	return &buf
+}
+
+// testLogLines returns the JSON log lines of the output
+func testLogLines(t *testing.T, out string) []map[string]interface{} {
+	lines := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		i := strings.Index(line, "{")
+		if i < 0 {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line[i:]), &fields); err != nil {
+			t.Fatalf("invalid log line %q: %s", line, err)
+		}
+		lines = append(lines, fields)
+	}
+	return lines
+}
+
+func TestRedactSecrets(t *testing.T) {
+	for _, c := range []struct {
+		text, expected string
+	}{
+		{`{"name": "db", "adminpassword": "s3cr3t"}`, `{"name": "db", "adminpassword":"[redacted]"}`},
+		{`{"api_key":"k3y","region":"us-south"}`, `{"api_key":"[redacted]","region":"us-south"}`},
+		{`{"client-secret": "a\"b"}`, `{"client-secret": "[redacted]"}`},
+		{"grant_type=urn&apikey=k3y", "grant_type=urn&apikey=[redacted]"},
+	} {
+		if redactedText := RedactSecrets(c.text); redactedText != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, redactedText)
+		}
+	}
+
+	dump := RedactSecrets("GET /v1 HTTP/1.1\r\nAuthorization: Bearer t0ken\r\nCookie: s=1\r\nAccept: */*\r\n")
+	if expected := "GET /v1 HTTP/1.1\r\nAuthorization: [redacted]\nCookie: [redacted]\r\nAccept: */*\r\n"; dump != expected {
+		t.Errorf("expected %q, got %q", expected, dump)
+	}
+
+	body := redactBody([]byte(`{"name":"target","cos_endpoint":{"bucket":"b","api_key":"k3y"},"credentials":{"user":"u"},"tags":["a"]}`))
+	if expected := `{"cos_endpoint":{"api_key":"[redacted]","bucket":"b"},"credentials":"[redacted]","name":"target","tags":["a"]}`; body != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestRequestLogTransport(t *testing.T) {
+	var requestID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get(RequestIDHeader)
+		w.Header().Set("Transaction-Id", "txn-1")
+		w.Write([]byte(`{"id":"r006-1","password":"s3cr3t"}`))
+	}))
+	t.Cleanup(server.Close)
+	ctx, out := testLogContext(t)
+
+	op := OperationLog{ResourceType: "ibm_is_vpc", Operation: "create", OperationID: "op-1"}
+	for _, bodies := range []bool{false, true} {
+		client := instrumentedClient("vpc", nil)
+		client.Transport.(*requestLoggingTransport).bodies = bodies
+		req, _ := http.NewRequestWithContext(WithOperationLog(ctx, op), http.MethodPost, server.URL+"/v1/vpcs?version=2021", strings.NewReader(`{"name":"vpc","api_key":"k3y"}`))
+		req.Header.Set("Authorization", "Bearer t0ken")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+		if requestID == "" || req.Header.Get(RequestIDHeader) != "" {
+			t.Fatalf("expected the request ID to be set on a copy of the request, got %q", requestID)
+		}
+	}
+
+	if s := out(); strings.Contains(s, "s3cr3t") || strings.Contains(s, "k3y") || strings.Contains(s, "t0ken") {
+		t.Fatalf("secrets logged: %s", s)
+	}
+	lines := testLogLines(t, out())
+	if len(lines) != 2 {
+		t.Fatalf("expected a line per request, got %v", lines)
+	}
+	for i, line := range lines {
+		for k, v := range map[string]interface{}{
+			"service":          "vpc",
+			"http_method":      "POST",
+			"http_status":      float64(200),
+			"transaction_id":   "txn-1",
+			"x_request_id":     requestID,
+			"tf_resource_type": "ibm_is_vpc",
+			"tf_operation":     "create",
+			"tf_operation_id":  "op-1",
+		} {
+			if k == "x_request_id" && i == 0 {
+				continue
+			}
+			if line[k] != v {
+				t.Errorf("line %d: expected %s %v, got %v", i, k, v, line[k])
+			}
+		}
+	}
+	if lines[0]["@level"] != "debug" || lines[0]["response_body"] != nil {
+		t.Errorf("expected no bodies at the debug level, got %v", lines[0])
+	}
+	if lines[1]["@level"] != "trace" || lines[1]["request_body"] != `{"api_key":"[redacted]","name":"vpc"}` {
+		t.Errorf("expected the redacted bodies at the trace level, got %v", lines[1])
+	}
+}
+
+func TestRequestLogTransportWithoutLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+	client := instrumentedClient("vpc", nil)
+
+	// a request sent without the context of an operation is logged with the log package
+	logOut := testLogOutput(t)
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if !strings.HasPrefix(logOut.String(), "[DEBUG] HTTP request: ") {
+		t.Fatalf("expected the request to be logged with the log package, got %q", logOut)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/x509"
+	"log"
+	"math"
+	"math/rand"
+	gohttp "net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// DefaultRetryMinDelay is the base delay of the exponential backoff between two retries
+	DefaultRetryMinDelay = 1 * time.Second
+	// DefaultRetryMaxDelay caps the backoff delay between two retries
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried when none are configured
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+var (
+	// net/http doesn't type the errors of too many redirects and of an invalid scheme
+	redirectsErrorRe = regexp.MustCompile(`stopped after \d+ redirects\z`)
+	schemeErrorRe    = regexp.MustCompile(`unsupported protocol scheme`)
+)
+
+// RetryPolicy is the retry policy shared by the clients of every service
+type RetryPolicy struct {
+	// MaxRetries is the number of times a failed request is retried
+	MaxRetries int
+	// MinDelay is the base delay of the exponential backoff
+	MinDelay time.Duration
+	// MaxDelay caps the backoff delay and the delay requested with Retry-After
+	MaxDelay time.Duration
+	// RetryableStatusCodes are the HTTP status codes of the responses to retry
+	RetryableStatusCodes []int
+}
+
+// RetryPolicy returns the retry policy configured for the provider
+func (c *Config) RetryPolicy() *RetryPolicy {
+	p := &RetryPolicy{
+		MaxRetries:           c.RetryCount,
+		MinDelay:             c.RetryDelay,
+		MaxDelay:             c.RetryMaxDelay,
+		RetryableStatusCodes: c.RetryableStatusCodes,
+	}
+	if p.MinDelay <= 0 {
+		p.MinDelay = DefaultRetryMinDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryMaxDelay
+	}
+	if p.MaxDelay < p.MinDelay {
+		p.MaxDelay = p.MinDelay
+	}
+	if len(p.RetryableStatusCodes) == 0 {
+		p.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+	return p
+}
+
+// Client returns a http client which retries the requests sent with base following the policy.
+// A pooled client is used when base is nil.
+func (p *RetryPolicy) Client(base *gohttp.Client) *gohttp.Client {
+	return p.retryableClient(base).StandardClient()
+}
+
+// Transport wraps the round tripper with the policy
+func (p *RetryPolicy) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = cleanhttp.DefaultPooledTransport()
+	}
+	return &retryablehttp.RoundTripper{Client: p.retryableClient(&gohttp.Client{Transport: base})}
+}
+
+func (p *RetryPolicy) retryableClient(base *gohttp.Client) *retryablehttp.Client {
+	if base == nil {
+		base = cleanhttp.DefaultPooledClient()
+	}
+	return &retryablehttp.Client{
+		HTTPClient:   base,
+		Logger:       log.Default(),
+		RetryWaitMin: p.MinDelay,
+		RetryWaitMax: p.MaxDelay,
+		RetryMax:     p.MaxRetries,
+		CheckRetry:   p.CheckRetry,
+		Backoff:      p.Backoff,
+		ErrorHandler: retryablehttp.PassthroughErrorHandler,
+	}
+}
+
+// CheckRetry retries the connection errors and the responses with a retryable status code
+func (p *RetryPolicy) CheckRetry(ctx context.Context, resp *gohttp.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil {
+		if v, ok := err.(*url.Error); ok {
+			if redirectsErrorRe.MatchString(v.Error()) || schemeErrorRe.MatchString(v.Error()) {
+				return false, v
+			}
+			if _, ok := v.Err.(x509.UnknownAuthorityError); ok {
+				return false, v
+			}
+		}
+		return true, nil
+	}
+
+	for _, code := range p.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Backoff returns the delay before the next attempt. The delay requested by the Retry-After header
+// of the response is used when present, up to max, otherwise the delay grows exponentially from min
+// up to max with a random jitter of up to half of the delay.
+func (p *RetryPolicy) Backoff(min, max time.Duration, attemptNum int, resp *gohttp.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp); ok {
+			if delay > max {
+				log.Printf("[DEBUG] The API asks to retry after %s, retrying after %s", delay, max)
+				return max
+			}
+			return delay
+		}
+	}
+
+	delay := float64(min) * math.Pow(2, float64(attemptNum))
+	if delay > float64(max) || math.IsInf(delay, 0) {
+		delay = float64(max)
+	}
+	half := int64(delay / 2)
+	if half <= 0 {
+		return time.Duration(delay)
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryAfter parses the Retry-After header, as a number of seconds or as a HTTP date
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := gohttp.ParseTime(v); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func testRetryPolicy(codes ...int) *RetryPolicy {
+	c := &Config{
+		RetryCount:           3,
+		RetryDelay:           time.Millisecond,
+		RetryMaxDelay:        5 * time.Millisecond,
+		RetryableStatusCodes: codes,
+	}
+	return c.RetryPolicy()
+}
+
+// testServer replies with the given status codes in order, then with 200
+func testServer(t *testing.T, codes ...int) (*httptest.Server, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPost && strings.TrimSpace(string(body)) != `{"name":"test"}` {
+			t.Errorf("attempt %d sent body %q", atomic.LoadInt32(&attempts)+1, body)
+		}
+		i := int(atomic.AddInt32(&attempts, 1)) - 1
+		if i < len(codes) {
+			if codes[i] == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			w.WriteHeader(codes[i])
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &attempts
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	p := (&Config{RetryCount: 10}).RetryPolicy()
+	if p.MaxRetries != 10 || p.MinDelay != DefaultRetryMinDelay || p.MaxDelay != DefaultRetryMaxDelay {
+		t.Fatalf("unexpected default policy %#v", p)
+	}
+	if !reflect.DeepEqual(p.RetryableStatusCodes, DefaultRetryableStatusCodes) {
+		t.Fatalf("expected the default retryable status codes, got %v", p.RetryableStatusCodes)
+	}
+}
+
+func TestRetryPolicyRetriesThrottledRequests(t *testing.T) {
+	server, attempts := testServer(t, 429, 503, 429)
+
+	resp, err := testRetryPolicy().Client(nil).Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if *attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", *attempts)
+	}
+}
+
+func TestRetryPolicyStopsAfterMaxRetries(t *testing.T) {
+	server, attempts := testServer(t, 503, 503, 503, 503, 503)
+
+	resp, err := testRetryPolicy().Client(nil).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode)
+	}
+	if *attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", *attempts)
+	}
+}
+
+func TestRetryPolicyRetryableStatusCodes(t *testing.T) {
+	server, attempts := testServer(t, 429)
+
+	resp, err := testRetryPolicy(503).Client(nil).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", resp.StatusCode)
+	}
+	if *attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", *attempts)
+	}
+}
+
+func TestRetryPolicyTransport(t *testing.T) {
+	server, attempts := testServer(t, 503, 429)
+
+	client := &http.Client{Transport: testRetryPolicy().Transport(nil)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || *attempts != 3 {
+		t.Fatalf("expected status 200 after 3 attempts, got %d after %d", resp.StatusCode, *attempts)
+	}
+}
+
+func TestRetryPolicySDKService(t *testing.T) {
+	server, attempts := testServer(t, 429, 503)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.SetHTTPClient(testRetryPolicy().Client(nil))
+
+	builder := core.NewRequestBuilder(core.POST)
+	if _, err := builder.ResolveRequestURL(server.URL, "/instances", nil); err != nil {
+		t.Fatal(err)
+	}
+	builder.AddHeader("Accept", "application/json")
+	if _, err := builder.SetBodyContentJSON(map[string]string{"name": "test"}); err != nil {
+		t.Fatal(err)
+	}
+	req, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if _, err := service.Request(req, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result["id"] != "1" || *attempts != 3 {
+		t.Fatalf("expected the result after 3 attempts, got %v after %d", result, *attempts)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := testRetryPolicy()
+	p.MinDelay = time.Second
+	p.MaxDelay = 30 * time.Second
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "20")
+	if d := p.Backoff(p.MinDelay, p.MaxDelay, 0, resp); d != 20*time.Second {
+		t.Fatalf("expected the delay of Retry-After, got %s", d)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(20*time.Second).UTC().Format(http.TimeFormat))
+	if d := p.Backoff(p.MinDelay, p.MaxDelay, 0, resp); d < 18*time.Second || d > 20*time.Second {
+		t.Fatalf("expected the delay until the date of Retry-After, got %s", d)
+	}
+
+	// a longer delay is capped by the maximum delay of the policy
+	for _, v := range []string{"120", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)} {
+		resp.Header.Set("Retry-After", v)
+		if d := p.Backoff(p.MinDelay, p.MaxDelay, 0, resp); d != p.MaxDelay {
+			t.Fatalf("expected the delay of Retry-After %s to be capped to %s, got %s", v, p.MaxDelay, d)
+		}
+	}
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		for i := 0; i < 20; i++ {
+			d := p.Backoff(p.MinDelay, p.MaxDelay, attempt, nil)
+			if d < max/2 || d > max {
+				t.Fatalf("attempt %d: expected a delay between %s and %s, got %s", attempt, max/2, max, d)
+			}
+		}
+	}
+	if d := p.Backoff(p.MinDelay, p.MaxDelay, 2000, nil); d < 15*time.Second || d > 30*time.Second {
+		t.Fatalf("expected the delay to be capped, got %s", d)
+	}
+}
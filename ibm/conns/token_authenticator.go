@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// tokenAuthenticator is an authenticator which requests a new IAM token before the current one
+// expires, such as the authenticator of a compute resource or of an assumed trusted profile
+type tokenAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// tokenAuthenticator returns the authenticator of the compute resource or of the assumed trusted
+// profile of the configuration, or nil when the provider authenticates with the API key or the
+// IAM token as is
+func (c *Config) tokenAuthenticator(iamURL string) (tokenAuthenticator, error) {
+	authenticator, err := c.computeResourceAuthenticator(iamURL)
+	if err != nil {
+		return nil, err
+	}
+	if c.AssumeTrustedProfile == nil {
+		return authenticator, nil
+	}
+	return c.assumeTrustedProfileAuthenticator(iamURL, authenticator)
+}
+
+// tokenTransport replaces the IAM token of the requests of the clients which do not use an
+// authenticator, such as the bluemix-go clients, with the current IAM token of the authenticator
+type tokenTransport struct {
+	authenticator tokenAuthenticator
+	base          gohttp.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(strings.ToLower(auth), "bearer ") {
+		token, err := t.authenticator.GetToken()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error occured while refreshing the IAM token: %s", err)
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// tokenClient returns the client with a transport which sets the current IAM token of the
+// authenticator, the client is returned as is when authenticator is nil
+func tokenClient(authenticator tokenAuthenticator, client *gohttp.Client) *gohttp.Client {
+	client.Transport = tokenRoundTripper(authenticator, client.Transport)
+	return client
+}
+
+// tokenRoundTripper wraps the round tripper with the IAM token of the authenticator, base is
+// returned as is when authenticator is nil
+func tokenRoundTripper(authenticator tokenAuthenticator, base gohttp.RoundTripper) gohttp.RoundTripper {
+	if authenticator == nil {
+		return base
+	}
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &tokenTransport{authenticator: authenticator, base: base}
+}
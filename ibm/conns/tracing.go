@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"log"
+	gohttp "net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/hashicorp/go-cleanhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/contrib/propagators/autoprop"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The traces are exported with the OpenTelemetry SDK, configured with the OpenTelemetry
+// environment variables: the OTLP exporter with the grpc or http/protobuf protocol, the sampler
+// of OTEL_TRACES_SAMPLER, the batch span processor and the propagators of OTEL_PROPAGATORS.
+const (
+	tracingInstrumentation   = "github.com/IBM-Cloud/terraform-provider-ibm"
+	defaultTracedServiceName = "terraform-provider-ibm"
+)
+
+var (
+	tracingOnce    sync.Once
+	tracerProvider *sdktrace.TracerProvider
+)
+
+// Tracer returns the tracer of the provider. Its spans are not recorded when tracing is not
+// enabled with the OpenTelemetry environment variables.
+func Tracer() trace.Tracer {
+	setupTracing()
+	return otel.Tracer(tracingInstrumentation, trace.WithInstrumentationVersion(version.Version))
+}
+
+// EndSpan ends the span, with an error status when err is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, RedactSecrets(err.Error()))
+	}
+	span.End()
+}
+
+// setupTracing registers the tracer provider and the propagators of the OpenTelemetry environment
+// variables as the global ones, once
+func setupTracing() {
+	tracingOnce.Do(func() {
+		tp, err := newTracerProviderFromEnv(context.Background())
+		if err != nil {
+			log.Printf("[WARN] Unable to export the traces of the provider: %s", err)
+			return
+		}
+		if tp == nil {
+			return
+		}
+		tracerProvider = tp
+		otel.SetTracerProvider(tp)
+		otel.SetTextMapPropagator(autoprop.NewTextMapPropagator())
+	})
+}
+
+// newTracerProviderFromEnv returns a tracer provider configured with the OpenTelemetry environment
+// variables, or nil when the traces are not exported. The traces are exported when
+// OTEL_TRACES_EXPORTER is otlp or an OTLP endpoint is set.
+func newTracerProviderFromEnv(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return nil, nil
+	}
+	exporter := strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER"))
+	endpoint := firstEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT")
+	if exporter == "none" || (exporter != "otlp" && endpoint == "") {
+		return nil, nil
+	}
+	if exporter != "" && exporter != "otlp" {
+		log.Printf("[WARN] The traces exporter %s is not supported, the traces are exported with otlp", exporter)
+	}
+
+	var client otlptrace.Client
+	switch protocol := firstEnv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"); protocol {
+	case "grpc":
+		client = otlptracegrpc.NewClient()
+	case "", "http/protobuf":
+		client = otlptracehttp.NewClient()
+	default:
+		log.Printf("[WARN] The OTLP protocol %s is not supported, the traces are exported with http/protobuf", protocol)
+		client = otlptracehttp.NewClient()
+	}
+	spanExporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	// the service name of OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES overrides the default one
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", defaultTracedServiceName),
+			attribute.String("service.version", version.Version),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] Exporting the traces of the provider with OTLP")
+	return sdktrace.NewTracerProvider(sdktrace.WithBatcher(spanExporter), sdktrace.WithResource(res)), nil
+}
+
+func firstEnv(keys ...string) string {
+	for _, key := range keys {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// FlushTraces exports the spans ended so far and stops the tracing, the provider calls it before
+// exiting
+func FlushTraces(timeout time.Duration) {
+	if tracerProvider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		log.Printf("[WARN] Unable to export the traces of the provider: %s", err)
+	}
+}
+
+// traceTransport wraps the round tripper with a client span for each request to the service,
+// the span is the child of the span of the context of the request. The trace context of the span
+// is sent in the headers of the propagators. base is returned as is when tracing is disabled.
+func traceTransport(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	setupTracing()
+	if tracerProvider == nil {
+		return base
+	}
+	if base == nil {
+		base = cleanhttp.DefaultPooledTransport()
+	}
+	return otelhttp.NewTransport(&spanAttributesTransport{service: service, base: base},
+		otelhttp.WithSpanNameFormatter(func(_ string, req *gohttp.Request) string {
+			return "HTTP " + req.Method
+		}),
+	)
+}
+
+// spanAttributesTransport sets the attributes of the provider on the client span of the request,
+// the service, the resource of the operation and the IDs of the request and of the transaction
+type spanAttributesTransport struct {
+	service string
+	base    gohttp.RoundTripper
+}
+
+func (t *spanAttributesTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	span := trace.SpanFromContext(req.Context())
+	attributes := []attribute.KeyValue{
+		attribute.String("ibm.service", t.service),
+		attribute.String("http.url", RedactSecrets(req.URL.Redacted())),
+	}
+	if v := req.Header.Get(RequestIDHeader); v != "" {
+		attributes = append(attributes, attribute.String("ibm.request_id", v))
+	}
+	if operation, ok := OperationLogFromContext(req.Context()); ok {
+		attributes = append(attributes, attribute.String("tf.resource.type", operation.ResourceType))
+		if operation.ResourceID != "" {
+			attributes = append(attributes, attribute.String("tf.resource.id", operation.ResourceID))
+		}
+	}
+	span.SetAttributes(attributes...)
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	for _, header := range transactionIDHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			span.SetAttributes(attribute.String("ibm.transaction_id", v))
+			break
+		}
+	}
+	return resp, nil
+}
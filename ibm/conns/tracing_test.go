@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewTracerProviderFromEnv(t *testing.T) {
+	for _, env := range []map[string]string{
+		{},
+		{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318"},
+		{"OTEL_SDK_DISABLED": "true", "OTEL_TRACES_EXPORTER": "otlp"},
+	} {
+		t.Run("disabled", func(t *testing.T) {
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+			if tp, err := newTracerProviderFromEnv(context.Background()); tp != nil || err != nil {
+				t.Errorf("%v: expected tracing to be disabled, got %v %v", env, tp, err)
+			}
+		})
+	}
+
+	for _, env := range []map[string]string{
+		{"OTEL_TRACES_EXPORTER": "otlp"},
+		{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318"},
+		{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://collector:4317", "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "grpc"},
+		{"OTEL_TRACES_EXPORTER": "otlp", "OTEL_EXPORTER_OTLP_PROTOCOL": "http/json"},
+	} {
+		t.Run("enabled", func(t *testing.T) {
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+			tp, err := newTracerProviderFromEnv(context.Background())
+			if tp == nil || err != nil {
+				t.Fatalf("%v: expected a tracer provider, got %v", env, err)
+			}
+			defer tp.Shutdown(context.Background())
+			if _, span := tp.Tracer("test").Start(context.Background(), "test"); !span.IsRecording() {
+				t.Errorf("%v: expected the spans to be sampled", env)
+			}
+		})
+	}
+
+	t.Run("sampler", func(t *testing.T) {
+		t.Setenv("OTEL_TRACES_EXPORTER", "otlp")
+		t.Setenv("OTEL_TRACES_SAMPLER", "always_off")
+		tp, err := newTracerProviderFromEnv(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer tp.Shutdown(context.Background())
+		if _, span := tp.Tracer("test").Start(context.Background(), "test"); span.IsRecording() {
+			t.Errorf("expected the sampler of OTEL_TRACES_SAMPLER to drop the spans")
+		}
+	})
+}
+
+// testTracing enables tracing with an in memory exporter and the W3C trace context propagator
+func testTracing(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracingOnce.Do(func() {})
+	previous, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	tracerProvider = tp
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		tracerProvider = nil
+		otel.SetTracerProvider(previous)
+		otel.SetTextMapPropagator(propagator)
+	})
+	return exporter
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]string {
+	attributes := map[attribute.Key]string{}
+	for _, a := range span.Attributes {
+		attributes[a.Key] = a.Value.Emit()
+	}
+	return attributes
+}
+
+func TestTracing(t *testing.T) {
+	exporter := testTracing(t)
+	var traceparent string
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("Transaction-Id", "txn-1")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(service.Close)
+
+	ctx := WithOperationLog(context.Background(), OperationLog{ResourceType: "ibm_is_vpc", ResourceID: "r006-1", Operation: "read"})
+	ctx, operation := Tracer().Start(ctx, "ibm_is_vpc read")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, service.URL+"/v1/vpcs/r006-1?apikey=s3cr3t", nil)
+	resp, err := (&http.Client{Transport: traceTransport("vpc", nil)}).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	operation.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected the spans of the request and of the operation, got %v", spans)
+	}
+	request, op := spans[0], spans[1]
+	if request.Parent.SpanID() != op.SpanContext.SpanID() || request.SpanContext.TraceID() != op.SpanContext.TraceID() {
+		t.Errorf("expected the request to be the child of the operation, got %v %v", request, op)
+	}
+	if !strings.HasPrefix(traceparent, "00-"+op.SpanContext.TraceID().String()+"-"+request.SpanContext.SpanID().String()) {
+		t.Errorf("unexpected traceparent %s", traceparent)
+	}
+	attributes := spanAttributes(request)
+	for k, v := range map[attribute.Key]string{"ibm.service": "vpc", "http.status_code": "404", "ibm.transaction_id": "txn-1", "tf.resource.type": "ibm_is_vpc", "tf.resource.id": "r006-1"} {
+		if attributes[k] != v {
+			t.Errorf("expected the attribute %s %v, got %v", k, v, attributes[k])
+		}
+	}
+	if strings.Contains(attributes["http.url"], "s3cr3t") {
+		t.Errorf("expected the secrets of the URL to be redacted, got %s", attributes["http.url"])
+	}
+	if request.Name != "HTTP GET" || request.Status.Code != codes.Error {
+		t.Errorf("expected a failed client span, got %v", request)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// systemDefaultTimeout is the timeout Terraform uses for the operations whose timeout is not
+// declared by the resource
+const systemDefaultTimeout = 20 * time.Minute
+
+// WithTimeouts declares the timeouts of the create, read, update and delete of the resource which
+// it does not declare, with the timeout Terraform uses for them: the default timeout of the
+// resource, or 20 minutes. The timeouts of every operation can then be set by the timeouts block
+// of the resource and by the default_timeouts of the provider.
+//
+// The timeouts must be declared when the provider is built, because they are part of the schema
+// of the resource.
+func WithTimeouts(resource *schema.Resource) *schema.Resource {
+	if resource.Timeouts == nil {
+		resource.Timeouts = &schema.ResourceTimeout{}
+	}
+	timeouts := resource.Timeouts
+	fallback := systemDefaultTimeout
+	if timeouts.Default != nil {
+		fallback = *timeouts.Default
+	}
+	declare := func(timeout **time.Duration, declared bool) {
+		if *timeout == nil && declared {
+			*timeout = schema.DefaultTimeout(fallback)
+		}
+	}
+	declare(&timeouts.Create, resource.Create != nil || resource.CreateContext != nil)
+	declare(&timeouts.Read, resource.Read != nil || resource.ReadContext != nil)
+	declare(&timeouts.Update, resource.Update != nil || resource.UpdateContext != nil)
+	declare(&timeouts.Delete, resource.Delete != nil || resource.DeleteContext != nil)
+	return resource
+}
+
+// ExpandDefaultTimeouts returns the timeouts of a default_timeouts block of the provider, its
+// default timeout applies to the operations which it does not set
+func ExpandDefaultTimeouts(l map[string]interface{}) (*schema.ResourceTimeout, error) {
+	timeouts := &schema.ResourceTimeout{}
+	for key, timeout := range map[string]**time.Duration{
+		schema.TimeoutCreate:  &timeouts.Create,
+		schema.TimeoutRead:    &timeouts.Read,
+		schema.TimeoutUpdate:  &timeouts.Update,
+		schema.TimeoutDelete:  &timeouts.Delete,
+		schema.TimeoutDefault: &timeouts.Default,
+	} {
+		if v, ok := l[key].(string); ok && v != "" {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error parsing the %s timeout of %s: %s", key, l["resource"], err)
+			}
+			*timeout = &duration
+		}
+	}
+	if timeouts.Default != nil {
+		for _, timeout := range []**time.Duration{&timeouts.Create, &timeouts.Read, &timeouts.Update, &timeouts.Delete} {
+			if *timeout == nil {
+				*timeout = schema.DefaultTimeout(*timeouts.Default)
+			}
+		}
+	}
+	return timeouts, nil
+}
+
+// WithDefaultTimeouts returns a copy of the resource whose declared timeouts are the ones of
+// defaults, the resource is not changed. The timeouts block of a resource has precedence over them.
+//
+// The SDK plans the timeouts of an operation with the timeouts of the resource and saves them in
+// the plan and in the state, so the copy is the resource of a provider configuration.
+func WithDefaultTimeouts(resource *schema.Resource, defaults *schema.ResourceTimeout) *schema.Resource {
+	if resource.Timeouts == nil {
+		return resource
+	}
+	timeouts := *resource.Timeouts
+	set := func(timeout **time.Duration, value *time.Duration) {
+		if *timeout != nil && value != nil {
+			*timeout = schema.DefaultTimeout(*value)
+		}
+	}
+	set(&timeouts.Create, defaults.Create)
+	set(&timeouts.Read, defaults.Read)
+	set(&timeouts.Update, defaults.Update)
+	set(&timeouts.Delete, defaults.Delete)
+	set(&timeouts.Default, defaults.Default)
+	copied := *resource
+	copied.Timeouts = &timeouts
+	return &copied
+}
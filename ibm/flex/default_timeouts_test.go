@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testTimeoutsResource(timeouts *schema.ResourceTimeout) *schema.Resource {
+	noop := func(d *schema.ResourceData, meta interface{}) error { return nil }
+	return &schema.Resource{
+		Schema:   map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true, ForceNew: true}},
+		Create:   noop,
+		Read:     noop,
+		Delete:   noop,
+		Timeouts: timeouts,
+	}
+}
+
+// testPlannedTimeout returns the timeout of the operation planned for the creation of the resource
+// with the config
+func testPlannedTimeout(t *testing.T, r *schema.Resource, config map[string]interface{}, operation string) time.Duration {
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	timeouts := &schema.ResourceTimeout{}
+	if err := timeouts.DiffDecode(diff); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	switch operation {
+	case schema.TimeoutCreate:
+		return *timeouts.Create
+	case schema.TimeoutRead:
+		return *timeouts.Read
+	case schema.TimeoutDelete:
+		return *timeouts.Delete
+	}
+	t.Fatalf("unexpected operation %s", operation)
+	return 0
+}
+
+func TestWithTimeouts(t *testing.T) {
+	r := WithTimeouts(testTimeoutsResource(nil))
+	if r.Timeouts.Create == nil || *r.Timeouts.Read != 20*time.Minute || r.Timeouts.Update != nil || r.Timeouts.Default != nil {
+		t.Fatalf("expected the timeouts of the operations to be declared, got %+v", r.Timeouts)
+	}
+
+	r = WithTimeouts(testTimeoutsResource(&schema.ResourceTimeout{
+		Create:  schema.DefaultTimeout(60 * time.Minute),
+		Default: schema.DefaultTimeout(10 * time.Minute),
+	}))
+	if *r.Timeouts.Create != 60*time.Minute || *r.Timeouts.Read != 10*time.Minute || *r.Timeouts.Delete != 10*time.Minute {
+		t.Fatalf("expected the undeclared timeouts to be the default timeout, got %+v", r.Timeouts)
+	}
+}
+
+func TestWithDefaultTimeouts(t *testing.T) {
+	built := WithTimeouts(testTimeoutsResource(&schema.ResourceTimeout{Create: schema.DefaultTimeout(60 * time.Minute)}))
+	defaults, err := ExpandDefaultTimeouts(map[string]interface{}{"resource": "ibm_database", "create": "3h", "default": "45m", "update": ""})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	r := WithDefaultTimeouts(built, defaults)
+	if r.Timeouts.Update != nil || r.Timeouts.Default != nil {
+		t.Fatalf("expected only the declared timeouts to be replaced, got %+v", r.Timeouts)
+	}
+	if r == built || *built.Timeouts.Create != 60*time.Minute || *built.Timeouts.Delete != 20*time.Minute {
+		t.Fatalf("expected the resource to be copied rather than changed, got %+v", built.Timeouts)
+	}
+	if timeout := testPlannedTimeout(t, built, map[string]interface{}{"name": "db"}, schema.TimeoutCreate); timeout != 60*time.Minute {
+		t.Errorf("expected the timeout of the resource without default timeouts, got %s", timeout)
+	}
+
+	if timeout := testPlannedTimeout(t, r, map[string]interface{}{"name": "db"}, schema.TimeoutCreate); timeout != 3*time.Hour {
+		t.Errorf("expected the default create timeout, got %s", timeout)
+	}
+	if timeout := testPlannedTimeout(t, r, map[string]interface{}{"name": "db"}, schema.TimeoutDelete); timeout != 45*time.Minute {
+		t.Errorf("expected the default timeout of the provider for delete, got %s", timeout)
+	}
+	config := map[string]interface{}{"name": "db", "timeouts": map[string]interface{}{"create": "5h"}}
+	if timeout := testPlannedTimeout(t, r, config, schema.TimeoutCreate); timeout != 5*time.Hour {
+		t.Errorf("expected the timeouts block of the resource to have precedence, got %s", timeout)
+	}
+
+	if _, err := ExpandDefaultTimeouts(map[string]interface{}{"resource": "ibm_database", "create": "3 hours"}); err == nil {
+		t.Error("expected an invalid duration to fail")
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeletionProtection is the argument which prevents a stateful resource from being deleted
+const DeletionProtection = "deletion_protection"
+
+// ForceNewFunc returns the changed key whose change replaces the resource in its CustomizeDiff, with
+// diff.ForceNew, or "" when the change doesn't replace the resource
+type ForceNewFunc func(diff *schema.ResourceDiff) string
+
+// WithDeletionProtection adds the deletion_protection argument to the resource. The Delete of
+// a protected resource fails before calling the service, and a plan which replaces it fails in
+// CustomizeDiff. The plan fails on the changes of the ForceNew attributes of the schema, and on
+// the replacements of forceNew which the CustomizeDiff of the resource forces: the SDK forces
+// them on a copy of the schema, which the deletion protection can't read.
+//
+// Terraform does not call CustomizeDiff when it plans to destroy a resource, so the destroy of
+// a protected resource fails on apply, before any resource is deleted by the apply.
+func WithDeletionProtection(resource *schema.Resource, forceNew ...ForceNewFunc) *schema.Resource {
+	resource.Schema[DeletionProtection] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the resource is protected from being deleted or replaced, set it to false and apply before deleting the resource",
+	}
+
+	if deleteFunc := resource.Delete; deleteFunc != nil {
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkDeletionProtection(d); err != nil {
+				return err
+			}
+			return deleteFunc(d, meta)
+		}
+	}
+	if deleteFunc := resource.DeleteContext; deleteFunc != nil {
+		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := checkDeletionProtection(d); err != nil {
+				return diag.FromErr(err)
+			}
+			return deleteFunc(ctx, d, meta)
+		}
+	}
+
+	resourceSchema := resource.Schema
+	protect := func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		return ResourceDeletionProtectionCustomizeDiff(resourceSchema, diff, forceNew...)
+	}
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = customdiff.Sequence(resource.CustomizeDiff, protect)
+	} else {
+		resource.CustomizeDiff = protect
+	}
+	return resource
+}
+
+func checkDeletionProtection(d *schema.ResourceData) error {
+	if d.Get(DeletionProtection).(bool) {
+		return fmt.Errorf("[ERROR] Deletion protection is enabled for %s, set %s to false and apply before deleting it", d.Id(), DeletionProtection)
+	}
+	return nil
+}
+
+// ResourceDeletionProtectionCustomizeDiff fails the plan when a change of a ForceNew attribute of
+// the resource schema, or a change which one of forceNew replaces, would replace a resource whose
+// deletion protection is enabled
+func ResourceDeletionProtectionCustomizeDiff(resourceSchema map[string]*schema.Schema, diff *schema.ResourceDiff, forceNew ...ForceNewFunc) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// the replacement is deleted with the state, so the protection of the state applies
+	if protected, _ := diff.GetChange(DeletionProtection); protected == nil || !protected.(bool) {
+		return nil
+	}
+	keys := diff.GetChangedKeysPrefix("")
+	sort.Strings(keys)
+	for _, key := range keys {
+		if forceNewKey(resourceSchema, strings.Split(key, ".")) {
+			return deletionProtectionReplaceError(diff, key)
+		}
+	}
+	for _, f := range forceNew {
+		if key := f(diff); key != "" {
+			return deletionProtectionReplaceError(diff, key)
+		}
+	}
+	return nil
+}
+
+func deletionProtectionReplaceError(diff *schema.ResourceDiff, key string) error {
+	return fmt.Errorf("[ERROR] Deletion protection is enabled for %s, which would be replaced because %s changed. Set %s to false and apply before replacing it", diff.Id(), key, DeletionProtection)
+}
+
+// forceNewKey returns whether the attribute at the path of a flatmap key, such as
+// "boot_volume.0.name", or one of its parents is ForceNew
+func forceNewKey(attributes map[string]*schema.Schema, path []string) bool {
+	attribute, ok := attributes[path[0]]
+	if !ok {
+		return false
+	}
+	if attribute.ForceNew {
+		return true
+	}
+	// path[1] is the index, the set hash or the map key of the element
+	if len(path) < 3 {
+		if elem, ok := attribute.Elem.(*schema.Schema); ok && len(path) == 2 {
+			return elem.ForceNew
+		}
+		return false
+	}
+	if elem, ok := attribute.Elem.(*schema.Resource); ok {
+		return forceNewKey(elem.Schema, path[2:])
+	}
+	return false
+}
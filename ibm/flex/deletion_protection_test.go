@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testDeletionProtectionResource(deleted *bool) *schema.Resource {
+	return WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true, ForceNew: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"boot_volume": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile": {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"label":   {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			*deleted = true
+			return nil
+		},
+	})
+}
+
+func testDeletionProtectionDiff(r *schema.Resource, state map[string]string, config map[string]interface{}) error {
+	s := &terraform.InstanceState{ID: "r006-1", Attributes: state}
+	_, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+func TestDeletionProtectionDelete(t *testing.T) {
+	var deleted bool
+	r := testDeletionProtectionResource(&deleted)
+	d := r.TestResourceData()
+	d.SetId("r006-1")
+	d.Set(DeletionProtection, true)
+	if diags := r.Delete(d, nil); diags == nil || !strings.Contains(diags.Error(), DeletionProtection) || deleted {
+		t.Fatalf("expected the delete of a protected resource to fail, got %v", diags)
+	}
+	d.Set(DeletionProtection, false)
+	if err := r.Delete(d, nil); err != nil || !deleted {
+		t.Fatalf("expected the resource to be deleted, got %v", err)
+	}
+}
+
+func TestDeletionProtectionCustomizeDiff(t *testing.T) {
+	var deleted bool
+	r := testDeletionProtectionResource(&deleted)
+	state := map[string]string{
+		"id":                    "r006-1",
+		"name":                  "db",
+		"description":           "first",
+		"deletion_protection":   "true",
+		"boot_volume.#":         "1",
+		"boot_volume.0.profile": "general-purpose",
+		"boot_volume.0.label":   "boot",
+	}
+	bootVolume := func(profile, label string) []interface{} {
+		return []interface{}{map[string]interface{}{"profile": profile, "label": label}}
+	}
+	for _, c := range []struct {
+		name      string
+		config    map[string]interface{}
+		forceNew  bool
+		protected bool
+	}{
+		{"update", map[string]interface{}{"name": "db", "description": "second", "deletion_protection": true, "boot_volume": bootVolume("general-purpose", "root")}, false, true},
+		{"replace", map[string]interface{}{"name": "db-2", "deletion_protection": true, "boot_volume": bootVolume("general-purpose", "boot")}, true, true},
+		{"replace nested", map[string]interface{}{"name": "db", "deletion_protection": true, "boot_volume": bootVolume("10iops-tier", "boot")}, true, true},
+		// the protection of the state applies to the replacement
+		{"disable and replace", map[string]interface{}{"name": "db-2", "deletion_protection": false, "boot_volume": bootVolume("general-purpose", "boot")}, true, true},
+		{"unprotected", map[string]interface{}{"name": "db-2", "boot_volume": bootVolume("general-purpose", "boot")}, true, false},
+	} {
+		s := map[string]string{}
+		for k, v := range state {
+			s[k] = v
+		}
+		if !c.protected {
+			s[DeletionProtection] = "false"
+		}
+		err := testDeletionProtectionDiff(r, s, c.config)
+		if expected := c.forceNew && c.protected; (err != nil) != expected {
+			t.Errorf("%s: expected an error %t, got %v", c.name, expected, err)
+		}
+	}
+}
+
+func TestDeletionProtectionCustomizeDiffForceNew(t *testing.T) {
+	// the volumes are replaced by their CustomizeDiff when the profile changes to or from custom
+	r := WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"profile":  {Type: schema.TypeString, Required: true},
+			"capacity": {Type: schema.TypeInt, Optional: true, Default: 100},
+			"iops":     {Type: schema.TypeInt, Optional: true, Computed: true},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return ResourceVolumeValidate(diff)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	}, VolumeProfileForceNew)
+	for _, c := range []struct {
+		name      string
+		config    map[string]interface{}
+		protected bool
+		error     bool
+	}{
+		{"update", map[string]interface{}{"profile": "10iops-tier", "deletion_protection": true}, true, false},
+		{"replace", map[string]interface{}{"profile": "custom", "iops": 1000, "deletion_protection": true}, true, true},
+		{"unprotected", map[string]interface{}{"profile": "custom", "iops": 1000}, false, false},
+	} {
+		state := map[string]string{"id": "r006-1", "profile": "general-purpose", "capacity": "100", "iops": "3000", DeletionProtection: fmt.Sprint(c.protected)}
+		err := testDeletionProtectionDiff(r, state, c.config)
+		if (err != nil) != c.error || (c.error && !strings.Contains(err.Error(), "replaced because profile changed")) {
+			t.Errorf("%s: expected an error %t, got %v", c.name, c.error, err)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// FilterTags is the filter name which matches the tags of the items
+	FilterTags = "tags"
+	// FilterTagPrefix is the prefix of the filter names which match the value of the key:value
+	// tags of the items with the key, for example "tag:env"
+	FilterTagPrefix = "tag:"
+)
+
+// WithFilter adds the filter blocks to the list data source whose items are in the list
+// attribute. The name of a filter is validated against the attributes of the items.
+func WithFilter(resource *schema.Resource, list string) *schema.Resource {
+	items := resource.Schema[list].Elem.(*schema.Resource)
+	resource.Schema["filter"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: fmt.Sprintf("Selects the %s whose attribute matches one of the values, every filter must match", list),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateFilterName(items),
+					Description:  "The attribute path, such as name or vpc.0.id, the tags of the item with tags or the value of a key:value tag with tag:<key>",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The values of the attribute, an item matches when its attribute equals one of them",
+				},
+				"regex": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether the values are regular expressions which the whole attribute must match",
+				},
+			},
+		},
+	}
+	return resource
+}
+
+func validateFilterName(items *schema.Resource) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		name := v.(string)
+		if name == FilterTags || strings.HasPrefix(name, FilterTagPrefix) && len(name) > len(FilterTagPrefix) {
+			return
+		}
+		attributes, list := items.Schema, false
+		for _, segment := range strings.Split(name, ".") {
+			// the index of a list is optional, a filter without it matches any of the elements
+			if _, err := strconv.Atoi(segment); err == nil && list {
+				list = false
+				continue
+			}
+			attribute, ok := attributes[segment]
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q: %s is not an attribute path of the items, %s is unknown", k, name, segment))
+				return
+			}
+			attributes = nil
+			if elem, ok := attribute.Elem.(*schema.Resource); ok {
+				attributes = elem.Schema
+			}
+			list = attribute.Type == schema.TypeList || attribute.Type == schema.TypeSet
+		}
+		return
+	}
+}
+
+// Filter selects the items of a list data source whose attribute matches one of the values
+type Filter struct {
+	Name     string
+	Values   []string
+	Regex    bool
+	patterns []*regexp.Regexp
+}
+
+// ExpandFilters returns the filter blocks of the data source, the regular expressions of the
+// values are compiled
+func ExpandFilters(d *schema.ResourceData) ([]Filter, error) {
+	var filters []Filter
+	for _, v := range d.Get("filter").(*schema.Set).List() {
+		block := v.(map[string]interface{})
+		filter := Filter{
+			Name:   block["name"].(string),
+			Values: ExpandStringList(block["values"].([]interface{})),
+			Regex:  block["regex"].(bool),
+		}
+		if filter.Regex {
+			for _, value := range filter.Values {
+				pattern, err := regexp.Compile("^(?:" + value + ")$")
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Invalid regular expression %q of the filter %s: %s", value, filter.Name, err)
+				}
+				filter.patterns = append(filter.patterns, pattern)
+			}
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// FilterItems returns the items which match every filter block of the data source. tags returns
+// the tags of an item for the tag filters when the items have no tags attribute, it may be nil
+// when the data source does not support tag filters.
+func FilterItems(d *schema.ResourceData, items []map[string]interface{}, tags func(item map[string]interface{}) ([]string, error)) ([]map[string]interface{}, error) {
+	filters, err := ExpandFilters(d)
+	if err != nil || len(filters) == 0 {
+		return items, err
+	}
+	filtered := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		ok, err := matchFilters(filters, item, tags)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// CRNTags returns the tags of the items by their crn attribute, for FilterItems
+func CRNTags(meta interface{}) func(item map[string]interface{}) ([]string, error) {
+	return func(item map[string]interface{}) ([]string, error) {
+		crn, _ := filterValue(item["crn"])
+		if crn == "" {
+			return nil, nil
+		}
+		tags, err := GetTagsUsingCRN(meta, crn)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting the tags of %s: %s", crn, err)
+		}
+		return ExpandStringList(tags.List()), nil
+	}
+}
+
+func matchFilters(filters []Filter, item map[string]interface{}, tags func(item map[string]interface{}) ([]string, error)) (bool, error) {
+	var itemTags []string
+	tagsLoaded := false
+	for _, filter := range filters {
+		var values []string
+		if filter.Name == FilterTags || strings.HasPrefix(filter.Name, FilterTagPrefix) {
+			if !tagsLoaded {
+				var err error
+				if itemTags, err = filterTags(item, tags); err != nil {
+					return false, err
+				}
+				tagsLoaded = true
+			}
+			values = tagValues(filter.Name, itemTags)
+		} else {
+			values = attributeValues(item, strings.Split(filter.Name, "."))
+		}
+		if !filter.match(values) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func filterTags(item map[string]interface{}, tags func(item map[string]interface{}) ([]string, error)) ([]string, error) {
+	if _, ok := item[FilterTags]; ok {
+		return attributeValues(item, []string{FilterTags}), nil
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("[ERROR] Tag filters are not supported by this data source")
+	}
+	return tags(item)
+}
+
+// tagValues returns the tags for the tags filter, or the values of the key:value tags of the key
+// of a tag:<key> filter
+func tagValues(name string, tags []string) []string {
+	if name == FilterTags {
+		return tags
+	}
+	key := strings.TrimPrefix(name, FilterTagPrefix)
+	var values []string
+	for _, tag := range tags {
+		if k, v, ok := cutTag(tag); ok && strings.TrimSpace(k) == key {
+			values = append(values, strings.TrimSpace(v))
+		}
+	}
+	return values
+}
+
+func cutTag(tag string) (string, string, bool) {
+	i := strings.Index(tag, ":")
+	if i < 0 {
+		return "", "", false
+	}
+	return tag[:i], tag[i+1:], true
+}
+
+// match returns whether one of the values of the attribute matches one of the filter values
+func (f Filter) match(values []string) bool {
+	for _, value := range values {
+		if f.Regex {
+			for _, pattern := range f.patterns {
+				if pattern.MatchString(value) {
+					return true
+				}
+			}
+			continue
+		}
+		for _, v := range f.Values {
+			if v == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// attributeValues returns the values at the path of the attribute, a path which goes through a
+// list without an index returns the values of every element
+func attributeValues(v interface{}, path []string) []string {
+	if set, ok := v.(*schema.Set); ok && set != nil {
+		return attributeValues(set.List(), path)
+	}
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if len(path) > 0 {
+			if i, err := strconv.Atoi(path[0]); err == nil {
+				if i < 0 || i >= rv.Len() {
+					return nil
+				}
+				return attributeValues(rv.Index(i).Interface(), path[1:])
+			}
+		}
+		var values []string
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, attributeValues(rv.Index(i).Interface(), path)...)
+		}
+		return values
+	case reflect.Map:
+		if len(path) == 0 || rv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		value := rv.MapIndex(reflect.ValueOf(path[0]).Convert(rv.Type().Key()))
+		if !value.IsValid() {
+			return nil
+		}
+		return attributeValues(value.Interface(), path[1:])
+	}
+	if len(path) > 0 {
+		return nil
+	}
+	value, _ := filterValue(rv.Interface())
+	return []string{value}
+}
+
+// filterValue returns the string value of a primitive attribute, dereferencing pointers
+func filterValue(v interface{}) (string, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "", false
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return "", false
+	}
+	if s, ok := rv.Interface().(fmt.Stringer); ok {
+		return s.String(), true
+	}
+	return fmt.Sprint(rv.Interface()), true
+}
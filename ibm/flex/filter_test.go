@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testFilterResource() *schema.Resource {
+	return WithFilter(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Computed: true},
+						"crn":  {Type: schema.TypeString, Computed: true},
+						"vpc": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, "items")
+}
+
+var testFilterItems = []map[string]interface{}{
+	{"name": "web-1", "crn": "crn:web-1", "vpc": []map[string]interface{}{{"id": "vpc-a"}}},
+	{"name": "web-2", "crn": "crn:web-2", "vpc": []map[string]interface{}{{"id": "vpc-b"}}},
+	{"name": "db-1", "crn": "crn:db-1", "vpc": []map[string]interface{}{{"id": "vpc-a"}}},
+}
+
+func testFilterNames(t *testing.T, filters []interface{}, tags func(map[string]interface{}) ([]string, error)) []string {
+	d := schema.TestResourceDataRaw(t, testFilterResource().Schema, map[string]interface{}{"filter": filters})
+	items, err := FilterItems(d, testFilterItems, tags)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item["name"].(string))
+	}
+	return names
+}
+
+func TestFilterItems(t *testing.T) {
+	tags := func(item map[string]interface{}) ([]string, error) {
+		return map[string][]string{
+			"crn:web-1": {"env:prod", "team:web"},
+			"crn:web-2": {"env:dev"},
+			"crn:db-1":  {"env:prod", "backup"},
+		}[item["crn"].(string)], nil
+	}
+	for _, c := range []struct {
+		name     string
+		filters  []interface{}
+		expected []string
+	}{
+		{"none", nil, []string{"web-1", "web-2", "db-1"}},
+		{"values", []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web-2", "db-1"}}}, []string{"web-2", "db-1"}},
+		{"regex", []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web-.*"}, "regex": true}}, []string{"web-1", "web-2"}},
+		{"whole value", []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web"}, "regex": true}}, []string{}},
+		{"list index", []interface{}{map[string]interface{}{"name": "vpc.0.id", "values": []interface{}{"vpc-a"}}}, []string{"web-1", "db-1"}},
+		{"list elements", []interface{}{map[string]interface{}{"name": "vpc.id", "values": []interface{}{"vpc-b"}}}, []string{"web-2"}},
+		{"tags", []interface{}{map[string]interface{}{"name": "tags", "values": []interface{}{"backup"}}}, []string{"db-1"}},
+		{"tag key", []interface{}{map[string]interface{}{"name": "tag:env", "values": []interface{}{"prod"}}}, []string{"web-1", "db-1"}},
+		{"every filter", []interface{}{
+			map[string]interface{}{"name": "tag:env", "values": []interface{}{"prod"}},
+			map[string]interface{}{"name": "name", "values": []interface{}{"web-.*"}, "regex": true},
+		}, []string{"web-1"}},
+	} {
+		if names := testFilterNames(t, c.filters, tags); !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, names)
+		}
+	}
+}
+
+func TestFilterItemsErrors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testFilterResource().Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "tags", "values": []interface{}{"prod"}}},
+	})
+	if _, err := FilterItems(d, testFilterItems, nil); err == nil {
+		t.Error("expected an error for a tag filter without tags")
+	}
+	d = schema.TestResourceDataRaw(t, testFilterResource().Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"web-("}, "regex": true}},
+	})
+	if _, err := FilterItems(d, testFilterItems, nil); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}
+
+func TestFilterNameValidation(t *testing.T) {
+	validate := testFilterResource().Schema["filter"].Elem.(*schema.Resource).Schema["name"].ValidateFunc
+	for _, name := range []string{"name", "vpc.id", "vpc.0.id", "tags", "tag:env"} {
+		if _, errs := validate(name, "filter.0.name"); len(errs) > 0 {
+			t.Errorf("unexpected errors for %s: %v", name, errs)
+		}
+	}
+	for _, name := range []string{"nmae", "vpc.name", "name.id", "tag:", "0"} {
+		if _, errs := validate(name, "filter.0.name"); len(errs) == 0 {
+			t.Errorf("expected an error for %s", name)
+		}
+	}
+}
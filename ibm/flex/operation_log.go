@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WithOperationLogging logs the start and the end of each create, read, update and delete of the
+// resource or data source with tflog, correlated by an operation ID, and traces it in a span with
+// the type and the ID of the resource. The context passed to the functions carries the operation
+// and the span, so that the requests sent with it are logged with the type and the ID of the
+// resource and their spans are children of the span of the operation. It is the OperationContext
+// of the resource data, which the legacy functions pass to the requests which they send.
+//
+// The legacy functions are adapted to context aware functions without a timeout, like the SDK
+// runs them, so that their requests and waits are not cut short by the deadline of the operation.
+func WithOperationLogging(resourceType string, resource *schema.Resource) *schema.Resource {
+	logged := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, span := startOperationSpan(ctx, resourceType, operation, d)
+			ctx, op, start := startOperationLog(ctx, resourceType, operation, d)
+			unregister := registerOperationContext(d, ctx)
+			diags := f(ctx, d, meta)
+			unregister()
+			var err error
+			if diags.HasError() {
+				err = diagsError(diags)
+			}
+			endOperationLog(ctx, op, start, d, err)
+			endOperationSpan(span, d, err)
+			return diags
+		}
+	}
+
+	resource.CreateContext = logged("create", resource.CreateContext)
+	resource.ReadContext = logged("read", resource.ReadContext)
+	resource.UpdateContext = logged("update", resource.UpdateContext)
+	resource.DeleteContext = logged("delete", resource.DeleteContext)
+	resource.CreateWithoutTimeout = logged("create", withoutTimeoutFunc(resource.CreateWithoutTimeout, resource.Create))
+	resource.ReadWithoutTimeout = logged("read", withoutTimeoutFunc(resource.ReadWithoutTimeout, resource.Read))
+	resource.UpdateWithoutTimeout = logged("update", withoutTimeoutFunc(resource.UpdateWithoutTimeout, resource.Update))
+	resource.DeleteWithoutTimeout = logged("delete", withoutTimeoutFunc(resource.DeleteWithoutTimeout, resource.Delete))
+	resource.Create, resource.Read, resource.Update, resource.Delete = nil, nil, nil, nil
+	return resource
+}
+
+// withoutTimeoutFunc returns the function of an operation without a timeout, or its legacy
+// function adapted to a context aware function which ignores the context
+func withoutTimeoutFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, legacy func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f != nil || legacy == nil {
+		return f
+	}
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(legacy(d, meta))
+	}
+}
+
+// diagsError returns the summaries of the errors of the diagnostics
+func diagsError(diags diag.Diagnostics) error {
+	summaries := []string{}
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			summaries = append(summaries, d.Summary)
+		}
+	}
+	return errors.New(strings.Join(summaries, "; "))
+}
+
+func startOperationLog(ctx context.Context, resourceType, operation string, d *schema.ResourceData) (context.Context, conns.OperationLog, time.Time) {
+	op := conns.OperationLog{
+		ResourceType: resourceType,
+		ResourceID:   d.Id(),
+		Operation:    operation,
+		OperationID:  conns.NewOperationID(),
+	}
+	ctx = conns.WithOperationLog(ctx, op)
+	tflog.Debug(ctx, "Starting "+operation, conns.LogArgs(op.Fields())...)
+	return ctx, op, time.Now()
+}
+
+func endOperationLog(ctx context.Context, op conns.OperationLog, start time.Time, d *schema.ResourceData, err error) {
+	fields := op.Fields()
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if d.Id() != "" {
+		fields["tf_resource_id"] = d.Id()
+	}
+	if err != nil {
+		fields["error"] = conns.RedactSecrets(err.Error())
+		tflog.Error(ctx, "Failed to "+op.Operation, conns.LogArgs(fields)...)
+		return
+	}
+	tflog.Debug(ctx, "Finished "+op.Operation, conns.LogArgs(fields)...)
+}
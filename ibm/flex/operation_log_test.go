@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testLogContext returns a context carrying a logger of tflog, and a function which returns the
+// output of the logger
+func testLogContext(t *testing.T) (context.Context, func() string) {
+	f, err := ioutil.TempFile(t.TempDir(), "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	// the logger writes to the standard error of the time it is created
+	stderr := os.Stderr
+	os.Stderr = f
+	defer func() { os.Stderr = stderr }()
+	ctx := tfsdklog.NewRootProviderLogger(context.Background(), tfsdklog.WithLevel(hclog.Trace), tfsdklog.WithoutLocation())
+	return ctx, func() string {
+		b, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+}
+
+func TestWithOperationLogging(t *testing.T) {
+	ctx, out := testLogContext(t)
+
+	var op, legacyOp conns.OperationLog
+	r := WithOperationLogging("ibm_is_vpc", &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			op, _ = conns.OperationLogFromContext(ctx)
+			d.SetId("r006-1")
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			legacyOp, _ = conns.OperationLogFromContext(OperationContext(d))
+			return errors.New("password=s3cr3t&")
+		},
+	})
+	if r.ReadContext != nil || r.Read != nil {
+		t.Fatal("expected the missing operations to stay unset")
+	}
+	if r.Delete != nil || r.DeleteContext != nil || r.DeleteWithoutTimeout == nil {
+		t.Fatal("expected the legacy function to be adapted to a context aware function without a timeout")
+	}
+
+	d := r.TestResourceData()
+	if diags := r.CreateContext(ctx, d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if op.ResourceType != "ibm_is_vpc" || op.Operation != "create" || op.OperationID == "" {
+		t.Fatalf("expected the context to carry the operation, got %#v", op)
+	}
+	if diags := r.DeleteWithoutTimeout(ctx, d, nil); !diags.HasError() || diags[0].Summary != "password=s3cr3t&" {
+		t.Fatalf("expected the error of the delete, got %v", diags)
+	}
+	if legacyOp.Operation != "delete" || legacyOp.ResourceID != "r006-1" {
+		t.Fatalf("expected the operation in the context of the legacy function, got %#v", legacyOp)
+	}
+	if OperationContext(d) != context.Background() {
+		t.Fatal("expected the context to be unregistered after the operation")
+	}
+
+	logs := out()
+	for _, expected := range []string{`"@message":"Starting create"`, `"@message":"Finished create"`, `"tf_resource_id":"r006-1"`, `"@message":"Failed to delete"`, `"tf_operation_id":"` + op.OperationID + `"`} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("expected %s in the logs:\n%s", expected, logs)
+		}
+	}
+	if strings.Contains(logs, "s3cr3t") {
+		t.Errorf("secret logged:\n%s", logs)
+	}
+}
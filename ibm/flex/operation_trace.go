@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// operationContexts holds the context of the running operation of each ResourceData, for the
+// functions which don't take the context of the operation
+var operationContexts sync.Map
+
+// OperationContext returns the context of the operation running on the resource data, carrying
+// the deadline, the cancellation, the operation and the span of the operation. It is
+// context.Background() outside of an operation.
+func OperationContext(d *schema.ResourceData) context.Context {
+	if ctx, ok := operationContexts.Load(d); ok {
+		return ctx.(context.Context)
+	}
+	return context.Background()
+}
+
+// registerOperationContext registers ctx as the context of the operation running on d, until the
+// returned function is called which restores the context registered before
+func registerOperationContext(d *schema.ResourceData, ctx context.Context) func() {
+	previous, registered := operationContexts.Load(d)
+	operationContexts.Store(d, ctx)
+	return func() {
+		if registered {
+			operationContexts.Store(d, previous)
+		} else {
+			operationContexts.Delete(d)
+		}
+	}
+}
+
+func startOperationSpan(ctx context.Context, resourceType, operation string, d *schema.ResourceData) (context.Context, trace.Span) {
+	ctx, span := conns.Tracer().Start(ctx, resourceType+" "+operation, trace.WithAttributes(
+		attribute.String("tf.resource.type", resourceType),
+		attribute.String("tf.operation", operation),
+	))
+	if d.Id() != "" {
+		span.SetAttributes(attribute.String("tf.resource.id", d.Id()))
+	}
+	return ctx, span
+}
+
+func endOperationSpan(span trace.Span, d *schema.ResourceData, err error) {
+	if d.Id() != "" {
+		span.SetAttributes(attribute.String("tf.resource.id", d.Id()))
+	}
+	conns.EndSpan(span, err)
+}
+
+// WaitForStateContext waits for the state of a resource with conf until ctx is done, in a span
+// which is the child of the span of ctx. The span records the number of polls and the last state.
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	attributes := []attribute.KeyValue{
+		attribute.String("wait.pending", strings.Join(conf.Pending, ",")),
+		attribute.String("wait.target", strings.Join(conf.Target, ",")),
+		attribute.Int64("wait.timeout_s", int64(conf.Timeout.Seconds())),
+	}
+	name := "wait"
+	if op, ok := conns.OperationLogFromContext(ctx); ok {
+		name += " " + op.ResourceType
+		attributes = append(attributes, attribute.String("tf.resource.type", op.ResourceType))
+		if op.ResourceID != "" {
+			attributes = append(attributes, attribute.String("tf.resource.id", op.ResourceID))
+		}
+	}
+	ctx, span := conns.Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+
+	var mu sync.Mutex
+	polls, state := 0, ""
+	// the refresh of a timed out wait may still be running, so conf is copied rather than restored
+	counted := *conf
+	counted.Refresh = func() (interface{}, string, error) {
+		result, s, err := conf.Refresh()
+		mu.Lock()
+		polls, state = polls+1, s
+		mu.Unlock()
+		return result, s, err
+	}
+	result, err := counted.WaitForStateContext(ctx)
+
+	mu.Lock()
+	span.SetAttributes(attribute.Int("wait.polls", polls), attribute.String("wait.last_state", state))
+	mu.Unlock()
+	conns.EndSpan(span, err)
+	return result, err
+}
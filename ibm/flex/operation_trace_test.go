@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// testTracing records the spans of the provider in memory
+func testTracing(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return exporter
+}
+
+func TestWithOperationLoggingTracing(t *testing.T) {
+	exporter := testTracing(t)
+	var span trace.Span
+	r := WithOperationLogging("ibm_is_vpc", &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			span = trace.SpanFromContext(OperationContext(d))
+			return errors.New("not found")
+		},
+	})
+	if r.Read != nil || r.ReadWithoutTimeout == nil {
+		t.Fatalf("expected the legacy read to be adapted")
+	}
+	d := r.TestResourceData()
+	d.SetId("r006-1")
+	if diags := r.ReadWithoutTimeout(context.Background(), d, nil); !diags.HasError() {
+		t.Fatalf("expected the error of the read")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || span.SpanContext().SpanID() != spans[0].SpanContext.SpanID() {
+		t.Fatalf("expected the span of the operation in the context of the legacy read, got %v", spans)
+	}
+	if spans[0].Name != "ibm_is_vpc read" || spans[0].Status.Code != codes.Error || spans[0].Status.Description != "not found" {
+		t.Errorf("unexpected span %v", spans[0])
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	exporter := testTracing(t)
+	ctx := conns.WithOperationLog(context.Background(), conns.OperationLog{ResourceType: "ibm_is_vpc", ResourceID: "r006-1", Operation: "create"})
+	ctx, operation := conns.Tracer().Start(ctx, "ibm_is_vpc create")
+
+	states := []string{"pending", "pending", "available"}
+	_, err := WaitForStateContext(ctx, &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			state := states[0]
+			states = states[1:]
+			return state, state, nil
+		},
+		Timeout:    time.Minute,
+		MinTimeout: time.Millisecond,
+	})
+	operation.End()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected the spans of the wait and of the operation, got %v", spans)
+	}
+	wait := spans[0]
+	if wait.Name != "wait ibm_is_vpc" || wait.Parent.SpanID() != spans[1].SpanContext.SpanID() {
+		t.Errorf("expected the wait to be the child of the operation, got %v", wait)
+	}
+	attributes := map[attribute.Key]string{}
+	for _, a := range wait.Attributes {
+		attributes[a.Key] = a.Value.Emit()
+	}
+	for k, v := range map[attribute.Key]string{"wait.polls": "3", "wait.last_state": "available", "tf.resource.id": "r006-1"} {
+		if attributes[k] != v {
+			t.Errorf("expected the attribute %s %v, got %v", k, v, attributes[k])
+		}
+	}
+}
+
+func TestWaitForStateContextCanceled(t *testing.T) {
+	exporter := testTracing(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := WaitForStateContext(ctx, &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			return "pending", "pending", nil
+		},
+		Timeout:    time.Minute,
+		MinTimeout: time.Millisecond,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the wait to end with the context, got %v", err)
+	}
+	if spans := exporter.GetSpans(); len(spans) != 1 || spans[0].Name != "wait" || spans[0].Status.Code != codes.Error {
+		t.Errorf("expected a failed span of the wait, got %v", spans)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// LockResource locks the key of conns.ResourceLocks for writing, for the operation running on d.
+// It waits for the lock up to the remaining time of the operation, or up to the timeout of the
+// operation, timeoutKey such as schema.TimeoutCreate, outside of an operation. The returned
+// function releases the lock.
+func LockResource(d *schema.ResourceData, timeoutKey, key string) (func(), error) {
+	ctx, cancel := lockContext(d, timeoutKey)
+	defer cancel()
+	return conns.ResourceLocks.Lock(ctx, key)
+}
+
+// RLockResource locks the key of conns.ResourceLocks for reading, for the operation running on d.
+// It waits for the lock like LockResource. The returned function releases the lock.
+func RLockResource(d *schema.ResourceData, timeoutKey, key string) (func(), error) {
+	ctx, cancel := lockContext(d, timeoutKey)
+	defer cancel()
+	return conns.ResourceLocks.RLock(ctx, key)
+}
+
+// lockContext returns the context of the wait for a lock of the operation running on d. The wait
+// ends with the context of the operation, whose deadline is the timeout of the operation set by
+// the SDK, so that the time spent waiting is not added to the time of the operation.
+func lockContext(d *schema.ResourceData, timeoutKey string) (context.Context, context.CancelFunc) {
+	ctx := OperationContext(d)
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d.Timeout(timeoutKey))
+}
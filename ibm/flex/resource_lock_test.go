@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLockResource(t *testing.T) {
+	unlock, err := conns.ResourceLocks.Lock(context.Background(), "lb/r006-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer unlock()
+
+	var lockErr error
+	var registered bool
+	// the context of the operation is registered for the resource data
+	r := WithOperationLogging("ibm_is_lb_pool", &schema.Resource{
+		Schema:   map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		Timeouts: &schema.ResourceTimeout{Update: schema.DefaultTimeout(time.Hour)},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			_, registered = conns.OperationLogFromContext(OperationContext(d))
+			_, lockErr = LockResource(d, schema.TimeoutUpdate, "lb/r006-1")
+			return nil
+		},
+	})
+	d := r.Data(nil)
+
+	// the SDK sets the timeout of the operation as the deadline of its context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	r.UpdateContext(ctx, d, nil)
+	if !registered {
+		t.Errorf("expected the context of the operation to be registered")
+	}
+	if lockErr == nil || time.Since(start) > 10*time.Second {
+		t.Errorf("expected the wait for the lock to end with the operation, got %v after %s", lockErr, time.Since(start))
+	}
+	if _, ok := conns.OperationLogFromContext(OperationContext(d)); ok {
+		t.Errorf("expected the context of the operation to be unregistered at its end")
+	}
+
+	// outside of an operation, the wait ends with the timeout of the operation
+	r.Timeouts.Update = schema.DefaultTimeout(50 * time.Millisecond)
+	d = r.Data(nil)
+	if _, err := RLockResource(d, schema.TimeoutUpdate, "lb/r006-1"); err == nil {
+		t.Errorf("expected the wait for the lock to time out")
+	}
+}
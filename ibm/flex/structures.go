@@ -5,6 +5,7 @@ package flex
 
 import (
 	"bytes"
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
@@ -23,6 +24,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
@@ -59,6 +61,8 @@ const (
 	ResourceStatus = "resource_status"
 	//ResourceGroupName ...
 	ResourceGroupName = "resource_group_name"
+	//TagsAll ...
+	TagsAll = "tags_all"
 	//RelatedCRN ...
 	RelatedCRN                                = "related_crn"
 	SystemIBMLabelPrefix                      = "ibm-cloud.kubernetes.io/"
@@ -1859,7 +1863,7 @@ func GetTags(d *schema.ResourceData, meta interface{}) error {
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
-	d.Set("tags", FlattenStringList(taglist))
+	d.Set("tags", FlattenStringList(removeIgnoredTags(taglist, meta)))
 	return nil
 }
 
@@ -1910,7 +1914,7 @@ func GetTags(d *schema.ResourceData, meta interface{}) error {
 // 	return nil
 // }
 
-func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
+func GetGlobalTagsUsingCRN(ctx context.Context, meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
 
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
@@ -1940,7 +1944,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 			ListTagsOptions.AccountID = PtrToString(accountID)
 		}
 	}
-	taggingResult, _, err := gtClient.ListTags(ListTagsOptions)
+	taggingResult, _, err := gtClient.ListTagsWithContext(ctx, ListTagsOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -1948,11 +1952,12 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, *item.Name)
 	}
+	taglist = removeIgnoredTags(taglist, meta)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
 
-func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
+func UpdateGlobalTagsUsingCRN(ctx context.Context, oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
@@ -1987,6 +1992,9 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		remove[i] = fmt.Sprint(v)
 	}
 
+	// Tags managed outside of Terraform are never detached
+	remove = removeIgnoredTags(remove, meta)
+
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
 		schematicTags := os.Getenv("IC_ENV_TAGS")
 		var envTags []string
@@ -1994,6 +2002,15 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			envTags = strings.Split(schematicTags, ",")
 			add = append(add, envTags...)
 		}
+
+		// Provider default tags are always attached and never detached
+		defaultTags := DefaultTags(meta)
+		for _, v := range defaultTags {
+			if !tagInList(v, add) {
+				add = append(add, v)
+			}
+		}
+		remove = removeTagsInList(remove, defaultTags)
 	}
 
 	if len(remove) > 0 {
@@ -2007,7 +2024,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			}
 		}
 
-		_, resp, err := gtClient.DetachTag(detachTagOptions)
+		_, resp, err := gtClient.DetachTagWithContext(ctx, detachTagOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error detaching database tags %v: %s\n%s", remove, err, resp)
 		}
@@ -2015,7 +2032,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
 				TagName: PtrToString(v),
 			}
-			_, resp, err := gtClient.DeleteTag(delTagOptions)
+			_, resp, err := gtClient.DeleteTagWithContext(ctx, delTagOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error deleting database tag %v: %s\n%s", v, err, resp)
 			}
@@ -2033,7 +2050,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			}
 		}
 
-		_, resp, err := gtClient.AttachTag(AttachTagOptions)
+		_, resp, err := gtClient.AttachTagWithContext(ctx, AttachTagOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating database tags %v : %s\n%s", add, err, resp)
 		}
@@ -2070,6 +2087,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
+	taglist = removeIgnoredTags(taglist, meta)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
@@ -2105,6 +2123,18 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		add = append(add, envTags...)
 	}
 
+	// Provider default tags are always attached and never detached
+	defaultTags := DefaultTags(meta)
+	for _, v := range defaultTags {
+		if !tagInList(v, add) {
+			add = append(add, v)
+		}
+	}
+	remove = removeTagsInList(remove, defaultTags)
+
+	// Tags managed outside of Terraform are never detached
+	remove = removeIgnoredTags(remove, meta)
+
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
 		if err != nil {
@@ -2166,6 +2196,154 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// ResourceDefaultTagsCustomizeDiff suppresses the diff on tags when the only
+// difference comes from the provider default_tags or IC_ENV_TAGS, and plans
+// the effective set of tags in tags_all. Tags matching the provider
+// ignore_tags are rejected.
+func ResourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	providerTags := append([]string{}, DefaultTags(meta)...)
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		providerTags = append(providerTags, strings.Split(v, ",")...)
+	}
+
+	// An ignored tag is filtered out when the tags are read, so it would be added back on every plan
+	if ignoreTags := IgnoreTags(meta); ignoreTags != nil && diff.NewValueKnown("tags.#") {
+		for _, v := range ExpandStringList(diff.Get("tags").(*schema.Set).List()) {
+			if isIgnoredTag(v, ignoreTags) {
+				return fmt.Errorf("[ERROR] The tag %q matches the provider ignore_tags, remove it from tags or from ignore_tags", v)
+			}
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("tags") {
+		o, n := diff.GetChange("tags")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+		removeInt := oldSet.Difference(newSet).List()
+		addInt := newSet.Difference(oldSet).List()
+		remove := make([]string, len(removeInt))
+		for i, v := range removeInt {
+			remove[i] = fmt.Sprint(v)
+		}
+		if len(addInt) == 0 && len(removeTagsInList(remove, providerTags)) == 0 {
+			log.Println("[INFO] Suppressing the tag diff introduced by provider level tags")
+			if err := diff.Clear("tags"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Resources without a tags_all attribute only get the diff suppression
+	oldTagsAll, ok := diff.Get(TagsAll).(*schema.Set)
+	if !ok {
+		return nil
+	}
+	// the count of a set is unknown when any of its elements is, unlike the set itself
+	if !diff.NewValueKnown("tags.#") {
+		return diff.SetNewComputed(TagsAll)
+	}
+	tagsAll := ExpandStringList(diff.Get("tags").(*schema.Set).List())
+	for _, v := range providerTags {
+		if !tagInList(v, tagsAll) {
+			tagsAll = append(tagsAll, v)
+		}
+	}
+	newTagsAll := NewStringSet(ResourceIBMVPCHash, tagsAll)
+	if oldTagsAll.Equal(newTagsAll) {
+		return nil
+	}
+	return diff.SetNew(TagsAll, newTagsAll)
+}
+
+// DefaultTags returns the tags configured in the provider default_tags block
+func DefaultTags(meta interface{}) []string {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.DefaultTags()
+	}
+	return nil
+}
+
+// IgnoreTags returns the tags configured in the provider ignore_tags block
+func IgnoreTags(meta interface{}) *conns.IgnoreTagsConfig {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.IgnoreTags()
+	}
+	return nil
+}
+
+// PlanTimeValidation returns the cache of the plan time validations, nil when the provider
+// plan_time_validation is disabled
+func PlanTimeValidation(meta interface{}) *conns.PlanTimeCache {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.PlanTimeValidation()
+	}
+	return nil
+}
+
+// PlanTimeValidationValue returns the value of the attribute when the plan creates the resource
+// or changes the attribute, and the value is known, so that it is validated at plan time
+func PlanTimeValidationValue(diff *schema.ResourceDiff, key string) (string, bool) {
+	if diff.Id() != "" && !diff.HasChange(key) || !diff.NewValueKnown(key) {
+		return "", false
+	}
+	value, ok := diff.Get(key).(string)
+	return value, ok && value != ""
+}
+
+// isIgnoredTag reports whether the tag matches one of the keys or key prefixes
+// of the provider ignore_tags block
+func isIgnoredTag(tag string, ignoreTags *conns.IgnoreTagsConfig) bool {
+	if ignoreTags == nil {
+		return false
+	}
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	key := strings.TrimSpace(strings.SplitN(tag, ":", 2)[0])
+	for _, k := range ignoreTags.Keys {
+		if key == strings.ToLower(strings.TrimSpace(k)) {
+			return true
+		}
+	}
+	for _, p := range ignoreTags.KeyPrefixes {
+		if strings.HasPrefix(tag, strings.ToLower(strings.TrimSpace(p))) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeIgnoredTags(tags []string, meta interface{}) []string {
+	ignoreTags := IgnoreTags(meta)
+	if ignoreTags == nil {
+		return tags
+	}
+	result := make([]string, 0, len(tags))
+	for _, v := range tags {
+		if !isIgnoredTag(v, ignoreTags) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func tagInList(tag string, list []string) bool {
+	for _, v := range list {
+		if strings.EqualFold(strings.TrimSpace(v), strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeTagsInList(tags, list []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, v := range tags {
+		if !tagInList(v, list) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
 	policyActionIntf, _ := diff.GetOk(isLBListenerPolicyAction)
 	policyAction := policyActionIntf.(string)
@@ -2204,22 +2382,16 @@ func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
 }
 
 func ResourceIBMISLBPoolCookieValidate(diff *schema.ResourceDiff) error {
-	_, sessionPersistenceTypeIntf := diff.GetChange(isLBPoolSessPersistenceType)
-	_, sessionPersistenceCookieNameIntf := diff.GetChange(isLBPoolSessPersistenceAppCookieName)
-	sessionPersistenceType := sessionPersistenceTypeIntf.(string)
-	sessionPersistenceCookieName := sessionPersistenceCookieNameIntf.(string)
-
-	if sessionPersistenceType == "app_cookie" {
-		if sessionPersistenceCookieName == "" {
-			return fmt.Errorf("Load Balancer Pool: %s is required for %s 'app_cookie'", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)
-		}
-		if strings.HasPrefix(sessionPersistenceCookieName, "IBM") {
-			return fmt.Errorf("Load Balancer Pool: %s starting with IBM are not allowed", isLBPoolSessPersistenceAppCookieName)
-		}
+	if err := validate.CheckRules(diff,
+		validate.WithMessage(validate.RequiredWith(isLBPoolSessPersistenceAppCookieName, validate.Equals(isLBPoolSessPersistenceType, "app_cookie")),
+			fmt.Sprintf("Load Balancer Pool: %s is required for %s 'app_cookie'", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)),
+		validate.WithMessage(validate.ConflictsWhen(isLBPoolSessPersistenceAppCookieName, validate.NotEquals(isLBPoolSessPersistenceType, "app_cookie")),
+			fmt.Sprintf("Load Balancer Pool: %s is only applicable for %s 'app_cookie'.", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)),
+	); err != nil {
+		return err
 	}
-
-	if sessionPersistenceCookieName != "" && sessionPersistenceType != "app_cookie" {
-		return fmt.Errorf("Load Balancer Pool: %s is only applicable for %s 'app_cookie'.", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)
+	if cookieName, ok := diff.GetOk(isLBPoolSessPersistenceAppCookieName); ok && strings.HasPrefix(cookieName.(string), "IBM") {
+		return fmt.Errorf("Load Balancer Pool: %s starting with IBM are not allowed", isLBPoolSessPersistenceAppCookieName)
 	}
 	return nil
 }
@@ -2231,20 +2403,13 @@ func ResourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 		for volAttIdx := range vols {
 			volumeid := "volume_attachments." + strconv.Itoa(volAttIdx) + "." + "volume"
 			volumePrototype := "volume_attachments." + strconv.Itoa(volAttIdx) + "." + "volume_prototype"
-			var volIdnterpolated = false
-			var volumeIdFound = false
-			if _, volumeIdFound = diff.GetOk(volumeid); !volumeIdFound {
-				if !diff.NewValueKnown(volumeid) {
-					volIdnterpolated = true
-				}
-			}
-			_, volPrototypeFound := diff.GetOk(volumePrototype)
-
-			if volPrototypeFound && (volumeIdFound || volIdnterpolated) {
-				return fmt.Errorf("InstanceTemplate - volume_attachments[%d]: Cannot provide both 'volume' and 'volume_prototype' together.", volAttIdx)
-			}
-			if !volPrototypeFound && !volumeIdFound && !volIdnterpolated {
-				return fmt.Errorf("InstanceTemplate - volume_attachments[%d]: Volume details missing. Provide either 'volume' or 'volume_prototype'.", volAttIdx)
+			if err := validate.CheckRules(diff,
+				validate.WithMessage(validate.AtMostOneOfWhen([]string{volumeid, volumePrototype}, validate.Always),
+					fmt.Sprintf("InstanceTemplate - volume_attachments[%d]: Cannot provide both 'volume' and 'volume_prototype' together.", volAttIdx)),
+				validate.WithMessage(validate.AtLeastOneOfWhen([]string{volumeid, volumePrototype}, validate.Always),
+					fmt.Sprintf("InstanceTemplate - volume_attachments[%d]: Volume details missing. Provide either 'volume' or 'volume_prototype'.", volAttIdx)),
+			); err != nil {
+				return err
 			}
 		}
 	}
@@ -2252,6 +2417,34 @@ func ResourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// customVolumeIOPSRanges are the ranges of the iops of the custom volumes, by capacity
+var customVolumeIOPSRanges = []struct {
+	minCapacity, maxCapacity, minIOPS, maxIOPS int
+}{
+	{10, 39, 100, 1000},
+	{40, 79, 100, 2000},
+	{80, 99, 100, 4000},
+	{100, 499, 100, 6000},
+	{500, 999, 100, 10000},
+	{1000, 1999, 100, 20000},
+	{2000, 3999, 200, 40000},
+	{4000, 7999, 300, 40000},
+	{8000, 9999, 500, 48000},
+	{10000, 16000, 1000, 48000},
+}
+
+// VolumeProfileForceNew returns "profile" when the change of the profile replaces the volume, the
+// profile of a volume can't be changed to or from the custom profile
+func VolumeProfileForceNew(diff *schema.ResourceDiff) string {
+	if diff.HasChange("profile") {
+		oldProfile, newProfile := diff.GetChange("profile")
+		if oldProfile.(string) == "custom" || newProfile.(string) == "custom" {
+			return "profile"
+		}
+	}
+	return ""
+}
+
 func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 
 	if diff.Id() != "" && diff.HasChange("capacity") {
@@ -2263,138 +2456,39 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 		}
 	}
 
-	profile := ""
-	var capacity, iops int64
-	if profileOk, ok := diff.GetOk("profile"); ok {
-		profile = profileOk.(string)
-	}
-	if capacityOk, ok := diff.GetOk("capacity"); ok {
-		capacity = int64(capacityOk.(int))
+	if key := VolumeProfileForceNew(diff); key != "" {
+		diff.ForceNew(key)
 	}
 
-	if capacity == int64(0) {
-		capacity = int64(100)
-	}
-	if profile == "5iops-tier" && capacity > 9600 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 9600)
-	} else if profile == "10iops-tier" && capacity > 4800 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 4800)
-	}
-
-	if iopsOk, ok := diff.GetOk("iops"); ok {
-		iops = int64(iopsOk.(int))
-	}
-
-	if diff.HasChange("profile") {
-		oldProfile, newProfile := diff.GetChange("profile")
-		if oldProfile.(string) == "custom" || newProfile.(string) == "custom" {
-			diff.ForceNew("profile")
-		}
+	custom := validate.Equals("profile", "custom")
+	rules := []validate.Rule{
+		validate.WithMessage(validate.RangeDependsOn("capacity", validate.DependentRange{When: validate.Equals("profile", "5iops-tier"), Min: 10, Max: 9600}),
+			"'%s' storage block supports capacity up to 9600.", "profile"),
+		validate.WithMessage(validate.RangeDependsOn("capacity", validate.DependentRange{When: validate.Equals("profile", "10iops-tier"), Min: 10, Max: 4800}),
+			"'%s' storage block supports capacity up to 4800.", "profile"),
+		validate.WithMessage(validate.ConflictsWhen("iops", validate.And(validate.NotEquals("profile", "custom"), validate.Changed("iops"))),
+			"VolumeError : iops is applicable for only custom volume profiles"),
+		validate.WithMessage(validate.RequiredWith("iops", custom),
+			"VolumeError : iops is required for the custom volume profile"),
 	}
-
-	if profile != "custom" {
-		if iops != 0 && diff.NewValueKnown("iops") && diff.HasChange("iops") {
-			return fmt.Errorf("VolumeError : iops is applicable for only custom volume profiles")
-		}
-	} else {
-		if capacity == 0 {
-			capacity = int64(100)
-		}
-		if capacity >= 10 && capacity <= 39 {
-			min := int64(100)
-			max := int64(1000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 40 && capacity <= 79 {
-			min := int64(100)
-			max := int64(2000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 80 && capacity <= 99 {
-			min := int64(100)
-			max := int64(4000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 100 && capacity <= 499 {
-			min := int64(100)
-			max := int64(6000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 500 && capacity <= 999 {
-			min := int64(100)
-			max := int64(10000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 1000 && capacity <= 1999 {
-			min := int64(100)
-			max := int64(20000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 2000 && capacity <= 3999 {
-			min := int64(200)
-			max := int64(40000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 4000 && capacity <= 7999 {
-			min := int64(300)
-			max := int64(40000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 8000 && capacity <= 9999 {
-			min := int64(500)
-			max := int64(48000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 10000 && capacity <= 16000 {
-			min := int64(1000)
-			max := int64(48000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
+	for _, r := range customVolumeIOPSRanges {
+		rules = append(rules, validate.WithMessage(validate.RangeDependsOn("iops", validate.DependentRange{
+			When: validate.And(custom, validate.Between("capacity", r.minCapacity, r.maxCapacity)),
+			Min:  r.minIOPS,
+			Max:  r.maxIOPS,
+		}), fmt.Sprintf("VolumeError : allowed iops value for capacity(%%d) is [%d-%d] ", r.minIOPS, r.maxIOPS), "capacity"))
 	}
-	return nil
+	// the capacity of a volume attachment is computed, the volume is created with 100 when it is not set
+	return validate.CheckRules(validate.WithDefault(diff, "capacity", 100), rules...)
 }
 
 func ResourceRouteModeValidate(diff *schema.ResourceDiff) error {
-
-	var lbtype, lbprofile string
-	if typeOk, ok := diff.GetOk(isLBType); ok {
-		lbtype = typeOk.(string)
-	}
-	if profileOk, ok := diff.GetOk(isLBProfile); ok {
-		lbprofile = profileOk.(string)
-	}
-	if rmOk, ok := diff.GetOk(isLBRouteMode); ok {
-		routeMode := rmOk.(bool)
-
-		if routeMode && lbtype != "private" {
-			return fmt.Errorf("'type' must be 'private', at present public load balancers are not supported with route mode enabled.")
-		}
-		if routeMode && lbprofile != "network-fixed" {
-			return fmt.Errorf("'profile' must be 'network-fixed', route mode is supported by private network load balancer.")
-		}
-	}
-
-	return nil
+	return validate.CheckRules(diff,
+		validate.WithMessage(validate.ConflictsWhen(isLBRouteMode, validate.NotEquals(isLBType, "private")),
+			"'type' must be 'private', at present public load balancers are not supported with route mode enabled."),
+		validate.WithMessage(validate.ConflictsWhen(isLBRouteMode, validate.NotEquals(isLBProfile, "network-fixed")),
+			"'profile' must be 'network-fixed', route mode is supported by private network load balancer."),
+	)
 }
 
 func FlattenRoleData(object []iampolicymanagementv1.Role, roleType string) []map[string]string {
@@ -2483,7 +2577,7 @@ func GetNextIAM(next interface{}) string {
 }
 
 /* Return the default resource group */
-func DefaultResourceGroup(meta interface{}) (string, error) {
+func DefaultResourceGroup(ctx context.Context, meta interface{}) (string, error) {
 
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
@@ -2493,7 +2587,7 @@ func DefaultResourceGroup(meta interface{}) (string, error) {
 	resourceGroupList := rg.ListResourceGroupsOptions{
 		Default: &defaultGrp,
 	}
-	grpList, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
+	grpList, resp, err := rMgtClient.ListResourceGroupsWithContext(ctx, &resourceGroupList)
 	if err != nil || grpList == nil || grpList.Resources == nil {
 		return "", fmt.Errorf("[ERROR] Error retrieving resource group: %s %s", err, resp)
 	}
@@ -2941,7 +3035,7 @@ func GeneratePolicyOptions(d *schema.ResourceData, meta interface{}) (iampolicym
 		ServiceName: &serviceToQuery,
 	}
 
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(OperationContext(d), listRoleOptions)
 	if err != nil {
 		return iampolicymanagementv1.CreatePolicyOptions{}, err
 	}
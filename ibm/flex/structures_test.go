@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testCustomizeDiff returns the error of the validator on the plan of the creation of a resource
+// with the config
+func testCustomizeDiff(s map[string]*schema.Schema, validator func(*schema.ResourceDiff) error, config map[string]interface{}) error {
+	r := &schema.Resource{
+		Schema: s,
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return validator(diff)
+		},
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+func TestResourceVolumeValidate(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"profile":  {Type: schema.TypeString, Required: true, ForceNew: true},
+		"capacity": {Type: schema.TypeInt, Optional: true, Default: 100},
+		"iops":     {Type: schema.TypeInt, Optional: true, Computed: true},
+	}
+	// the capacity of a volume attachment is computed, without a default
+	attachment := map[string]*schema.Schema{
+		"profile":  s["profile"],
+		"capacity": {Type: schema.TypeInt, Optional: true, Computed: true},
+		"iops":     s["iops"],
+	}
+	for _, c := range []struct {
+		schema map[string]*schema.Schema
+		config map[string]interface{}
+		error  string
+	}{
+		{s, map[string]interface{}{"profile": "general-purpose"}, ""},
+		{s, map[string]interface{}{"profile": "5iops-tier", "capacity": 9600}, ""},
+		{s, map[string]interface{}{"profile": "5iops-tier", "capacity": 9700}, "'5iops-tier' storage block supports capacity up to 9600."},
+		{s, map[string]interface{}{"profile": "10iops-tier", "capacity": 4900}, "'10iops-tier' storage block supports capacity up to 4800."},
+		{s, map[string]interface{}{"profile": "general-purpose", "iops": 1000}, "VolumeError : iops is applicable for only custom volume profiles"},
+		{s, map[string]interface{}{"profile": "custom", "iops": 6000}, ""},
+		{s, map[string]interface{}{"profile": "custom", "capacity": 20, "iops": 6000}, "VolumeError : allowed iops value for capacity(20) is [100-1000]"},
+		{s, map[string]interface{}{"profile": "custom", "capacity": 10000, "iops": 1000}, ""},
+		{s, map[string]interface{}{"profile": "custom", "capacity": 10000, "iops": 500}, "VolumeError : allowed iops value for capacity(10000) is [1000-48000]"},
+		// an unset capacity is checked as the capacity of 100 which the volume is created with
+		{attachment, map[string]interface{}{"profile": "custom", "iops": 6000}, ""},
+		{attachment, map[string]interface{}{"profile": "custom", "iops": 7000}, "VolumeError : allowed iops value for capacity(100) is [100-6000]"},
+		{attachment, map[string]interface{}{"profile": "custom", "capacity": 20, "iops": 6000}, "VolumeError : allowed iops value for capacity(20) is [100-1000]"},
+	} {
+		err := testCustomizeDiff(c.schema, ResourceVolumeValidate, c.config)
+		switch {
+		case c.error == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", c.config, err)
+		case c.error != "" && (err == nil || !strings.Contains(err.Error(), c.error)):
+			t.Errorf("%v: expected the error %s, got %v", c.config, c.error, err)
+		}
+	}
+}
+
+func TestResourceRouteModeValidate(t *testing.T) {
+	s := map[string]*schema.Schema{
+		isLBType:      {Type: schema.TypeString, Optional: true, Default: "public"},
+		isLBProfile:   {Type: schema.TypeString, Optional: true},
+		isLBRouteMode: {Type: schema.TypeBool, Optional: true, Default: false},
+	}
+	for _, c := range []struct {
+		config map[string]interface{}
+		error  string
+	}{
+		{map[string]interface{}{}, ""},
+		{map[string]interface{}{isLBType: "private", isLBProfile: "network-fixed", isLBRouteMode: true}, ""},
+		{map[string]interface{}{isLBProfile: "network-fixed", isLBRouteMode: true}, "'type' must be 'private', at present public load balancers are not supported with route mode enabled."},
+		{map[string]interface{}{isLBType: "private", isLBRouteMode: true}, "'profile' must be 'network-fixed', route mode is supported by private network load balancer."},
+	} {
+		err := testCustomizeDiff(s, ResourceRouteModeValidate, c.config)
+		switch {
+		case c.error == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", c.config, err)
+		case c.error != "" && (err == nil || !strings.Contains(err.Error(), c.error)):
+			t.Errorf("%v: expected the error %s, got %v", c.config, c.error, err)
+		}
+	}
+}
+
+func TestResourceIBMISLBPoolCookieValidate(t *testing.T) {
+	s := map[string]*schema.Schema{
+		isLBPoolSessPersistenceType:          {Type: schema.TypeString, Optional: true},
+		isLBPoolSessPersistenceAppCookieName: {Type: schema.TypeString, Optional: true},
+	}
+	for _, c := range []struct {
+		config map[string]interface{}
+		error  string
+	}{
+		{map[string]interface{}{}, ""},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "http_cookie"}, ""},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "app_cookie", isLBPoolSessPersistenceAppCookieName: "session"}, ""},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "app_cookie", isLBPoolSessPersistenceAppCookieName: testUnknownValue}, ""},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "app_cookie"}, "Load Balancer Pool: session_persistence_app_cookie_name is required for session_persistence_type 'app_cookie'"},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "http_cookie", isLBPoolSessPersistenceAppCookieName: "session"}, "Load Balancer Pool: session_persistence_app_cookie_name is only applicable for session_persistence_type 'app_cookie'."},
+		{map[string]interface{}{isLBPoolSessPersistenceAppCookieName: "session"}, "Load Balancer Pool: session_persistence_app_cookie_name is only applicable for session_persistence_type 'app_cookie'."},
+		{map[string]interface{}{isLBPoolSessPersistenceType: "app_cookie", isLBPoolSessPersistenceAppCookieName: "IBMsession"}, "Load Balancer Pool: session_persistence_app_cookie_name starting with IBM are not allowed"},
+	} {
+		err := testCustomizeDiff(s, ResourceIBMISLBPoolCookieValidate, c.config)
+		switch {
+		case c.error == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", c.config, err)
+		case c.error != "" && (err == nil || !strings.Contains(err.Error(), c.error)):
+			t.Errorf("%v: expected the error %s, got %v", c.config, c.error, err)
+		}
+	}
+}
+
+func TestResourceVolumeAttachmentValidate(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"volume_attachments": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":   {Type: schema.TypeString, Required: true},
+					"volume": {Type: schema.TypeString, Optional: true},
+					"volume_prototype": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"profile":  {Type: schema.TypeString, Required: true},
+								"capacity": {Type: schema.TypeInt, Required: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	prototype := []interface{}{map[string]interface{}{"profile": "general-purpose", "capacity": 100}}
+	for _, c := range []struct {
+		attachments []interface{}
+		error       string
+	}{
+		{nil, ""},
+		{[]interface{}{map[string]interface{}{"name": "data", "volume": "r006-1"}}, ""},
+		{[]interface{}{map[string]interface{}{"name": "data", "volume_prototype": prototype}}, ""},
+		{[]interface{}{map[string]interface{}{"name": "data", "volume": testUnknownValue}}, ""},
+		{[]interface{}{
+			map[string]interface{}{"name": "data", "volume": "r006-1"},
+			map[string]interface{}{"name": "logs", "volume": "r006-2", "volume_prototype": prototype},
+		}, "InstanceTemplate - volume_attachments[1]: Cannot provide both 'volume' and 'volume_prototype' together."},
+		{[]interface{}{map[string]interface{}{"name": "data", "volume": testUnknownValue, "volume_prototype": prototype}}, "InstanceTemplate - volume_attachments[0]: Cannot provide both 'volume' and 'volume_prototype' together."},
+		{[]interface{}{map[string]interface{}{"name": "data"}}, "InstanceTemplate - volume_attachments[0]: Volume details missing. Provide either 'volume' or 'volume_prototype'."},
+	} {
+		config := map[string]interface{}{}
+		if c.attachments != nil {
+			config["volume_attachments"] = c.attachments
+		}
+		err := testCustomizeDiff(s, ResourceVolumeAttachmentValidate, config)
+		switch {
+		case c.error == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", c.attachments, err)
+		case c.error != "" && (err == nil || !strings.Contains(err.Error(), c.error)):
+			t.Errorf("%v: expected the error %s, got %v", c.attachments, c.error, err)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testUnknownValue is the value of an attribute of the config which is not known at plan time
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// testTagsSession is a client session with the tags of the provider block
+type testTagsSession struct {
+	conns.ClientSession
+	defaultTags []string
+	ignoreTags  *conns.IgnoreTagsConfig
+}
+
+func (s testTagsSession) DefaultTags() []string {
+	return s.defaultTags
+}
+
+func (s testTagsSession) IgnoreTags() *conns.IgnoreTagsConfig {
+	return s.ignoreTags
+}
+
+func testTagsResource(withTagsAll bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      ResourceIBMVPCHash,
+		},
+	}
+	if withTagsAll {
+		s[TagsAll] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      ResourceIBMVPCHash,
+		}
+	}
+	return &schema.Resource{
+		Schema: s,
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return ResourceDefaultTagsCustomizeDiff(diff, meta)
+		},
+	}
+}
+
+// testTagsState returns the attributes of the state of a resource with the tags and tags_all
+func testTagsState(tags, tagsAll []string) map[string]string {
+	state := map[string]string{"id": "r006-1", "name": "a"}
+	for key, values := range map[string][]string{"tags": tags, TagsAll: tagsAll} {
+		if values == nil {
+			continue
+		}
+		state[key+".#"] = fmt.Sprint(len(values))
+		for _, v := range values {
+			state[fmt.Sprintf("%s.%d", key, ResourceIBMVPCHash(v))] = v
+		}
+	}
+	return state
+}
+
+// testDiffSet returns the planned elements of a set attribute, whether they are unknown, and
+// whether the plan changes the set
+func testDiffSet(d *terraform.InstanceDiff, key string) (values []string, computed, changed bool) {
+	if d == nil {
+		return nil, false, false
+	}
+	for k, attr := range d.Attributes {
+		if k == key+".#" {
+			changed = true
+			computed = computed || attr.NewComputed
+			continue
+		}
+		if !strings.HasPrefix(k, key+".") {
+			continue
+		}
+		changed = true
+		if !attr.NewRemoved {
+			values = append(values, attr.New)
+		}
+	}
+	sort.Strings(values)
+	return values, computed, changed
+}
+
+func TestResourceDefaultTagsCustomizeDiff(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		defaultTags []string
+		state       map[string]string
+		config      map[string]interface{}
+		// tagsAll is the planned tags_all, nil when it does not change
+		tagsAll       []string
+		tagsAllKnown  bool
+		tagsChanged   bool
+		withoutTagAll bool
+	}{
+		{
+			name:         "create without default tags",
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAll:      []string{"team:a"},
+			tagsAllKnown: true,
+			tagsChanged:  true,
+		},
+		{
+			name:         "create merges the default tags",
+			defaultTags:  []string{"env:dev", "owner:ops"},
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAll:      []string{"env:dev", "owner:ops", "team:a"},
+			tagsAllKnown: true,
+			tagsChanged:  true,
+		},
+		{
+			// the tags are computed when they are not configured
+			name:         "create without tags",
+			defaultTags:  []string{"env:dev"},
+			config:       map[string]interface{}{"name": "a"},
+			tagsAllKnown: false,
+			tagsChanged:  true,
+		},
+		{
+			name:         "update without tags gets the default tags",
+			defaultTags:  []string{"env:dev"},
+			state:        testTagsState([]string{}, []string{}),
+			config:       map[string]interface{}{"name": "a"},
+			tagsAll:      []string{"env:dev"},
+			tagsAllKnown: true,
+		},
+		{
+			// a resource tag equal to a default tag, regardless of the case, overrides it
+			name:         "resource tag overrides the default tag",
+			defaultTags:  []string{"env:dev", "owner:ops"},
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"ENV:dev", "team:a"}},
+			tagsAll:      []string{"ENV:dev", "owner:ops", "team:a"},
+			tagsAllKnown: true,
+			tagsChanged:  true,
+		},
+		{
+			// tags are not key value pairs, a tag with the same key is a different tag
+			name:         "resource tag with the key of a default tag",
+			defaultTags:  []string{"env:dev"},
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"env:prod"}},
+			tagsAll:      []string{"env:dev", "env:prod"},
+			tagsAllKnown: true,
+			tagsChanged:  true,
+		},
+		{
+			name:         "unknown tags",
+			defaultTags:  []string{"env:dev"},
+			config:       map[string]interface{}{"name": "a", "tags": testUnknownValue},
+			tagsAllKnown: false,
+			tagsChanged:  true,
+		},
+		{
+			name:         "unknown tag",
+			defaultTags:  []string{"env:dev"},
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a", testUnknownValue}},
+			tagsAllKnown: false,
+			tagsChanged:  true,
+		},
+		{
+			name:         "unknown tag of an existing resource",
+			defaultTags:  []string{"env:dev"},
+			state:        testTagsState([]string{"team:a", "env:dev"}, []string{"team:a", "env:dev"}),
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{testUnknownValue}},
+			tagsAllKnown: false,
+			tagsChanged:  true,
+		},
+		{
+			name:         "unchanged",
+			defaultTags:  []string{"env:dev"},
+			state:        testTagsState([]string{"team:a", "env:dev"}, []string{"team:a", "env:dev"}),
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAllKnown: true,
+		},
+		{
+			// the default tags read back in tags are not removed from the resource
+			name:         "default tags read back in tags are suppressed",
+			defaultTags:  []string{"env:dev"},
+			state:        testTagsState([]string{"team:a", "env:dev"}, nil),
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAll:      []string{"env:dev", "team:a"},
+			tagsAllKnown: true,
+		},
+		{
+			name:         "new default tag",
+			defaultTags:  []string{"env:dev", "owner:ops"},
+			state:        testTagsState([]string{"team:a", "env:dev"}, []string{"team:a", "env:dev"}),
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAll:      []string{"env:dev", "owner:ops", "team:a"},
+			tagsAllKnown: true,
+		},
+		{
+			name:         "removed resource tag is not suppressed",
+			defaultTags:  []string{"env:dev"},
+			state:        testTagsState([]string{"team:a", "team:b", "env:dev"}, []string{"team:a", "team:b", "env:dev"}),
+			config:       map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAll:      []string{"env:dev", "team:a"},
+			tagsAllKnown: true,
+			tagsChanged:  true,
+		},
+		{
+			name:          "resource without tags_all only gets the suppression",
+			defaultTags:   []string{"env:dev"},
+			state:         testTagsState([]string{"team:a", "env:dev"}, nil),
+			config:        map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}},
+			tagsAllKnown:  true,
+			withoutTagAll: true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := testTagsResource(!c.withoutTagAll)
+			var s *terraform.InstanceState
+			if c.state != nil {
+				s = &terraform.InstanceState{ID: "r006-1", Attributes: c.state}
+			}
+			meta := testTagsSession{defaultTags: c.defaultTags}
+			d, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(c.config), meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			tagsAll, computed, changed := testDiffSet(d, TagsAll)
+			if computed == c.tagsAllKnown {
+				t.Errorf("expected tags_all known %t, got %t", c.tagsAllKnown, !computed)
+			}
+			if c.tagsAllKnown && c.tagsAll == nil && changed {
+				t.Errorf("expected tags_all unchanged, got %v", tagsAll)
+			}
+			if c.tagsAllKnown && c.tagsAll != nil && !reflect.DeepEqual(tagsAll, c.tagsAll) {
+				t.Errorf("expected tags_all %v, got %v", c.tagsAll, tagsAll)
+			}
+			if _, _, tagsChanged := testDiffSet(d, "tags"); tagsChanged != c.tagsChanged {
+				t.Errorf("expected a change of tags %t, got %t", c.tagsChanged, tagsChanged)
+			}
+		})
+	}
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	tags := []string{"schematics:ws-1", "Schematics:ws-2", "schematics", "cost-center:42", "COST-owner", "env:dev", "team"}
+	for _, c := range []struct {
+		name       string
+		ignoreTags *conns.IgnoreTagsConfig
+		expected   []string
+	}{
+		{"no ignore_tags", nil, tags},
+		{"empty ignore_tags", &conns.IgnoreTagsConfig{}, tags},
+		// the key of a tag is the part before the colon, and a tag without colon is a key
+		{"keys", &conns.IgnoreTagsConfig{Keys: []string{"schematics"}}, []string{"cost-center:42", "COST-owner", "env:dev", "team"}},
+		{"key of a tag with a value", &conns.IgnoreTagsConfig{Keys: []string{"env:dev"}}, tags},
+		{"key prefixes", &conns.IgnoreTagsConfig{KeyPrefixes: []string{"cost-"}}, []string{"schematics:ws-1", "Schematics:ws-2", "schematics", "env:dev", "team"}},
+		{"keys and key prefixes", &conns.IgnoreTagsConfig{Keys: []string{" team "}, KeyPrefixes: []string{"SCHEMATICS:"}}, []string{"schematics", "cost-center:42", "COST-owner", "env:dev"}},
+	} {
+		meta := testTagsSession{ignoreTags: c.ignoreTags}
+		if actual := removeIgnoredTags(tags, meta); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, actual)
+		}
+	}
+	if actual := removeIgnoredTags(tags, nil); !reflect.DeepEqual(actual, tags) {
+		t.Errorf("expected the tags to be kept without a client session, got %v", actual)
+	}
+}
+
+func TestResourceDefaultTagsCustomizeDiffIgnoreTags(t *testing.T) {
+	ignoreTags := &conns.IgnoreTagsConfig{Keys: []string{"schematics"}, KeyPrefixes: []string{"cost-"}}
+	for _, c := range []struct {
+		name   string
+		state  map[string]string
+		config map[string]interface{}
+		valid  bool
+	}{
+		{"tags", nil, map[string]interface{}{"name": "a", "tags": []interface{}{"team:a"}}, true},
+		{"ignored key", nil, map[string]interface{}{"name": "a", "tags": []interface{}{"team:a", "schematics:ws-1"}}, false},
+		{"ignored key prefix", nil, map[string]interface{}{"name": "a", "tags": []interface{}{"cost-center:42"}}, false},
+		{"unknown tags", nil, map[string]interface{}{"name": "a", "tags": []interface{}{testUnknownValue}}, true},
+		{"update", testTagsState([]string{"team:a"}, []string{"team:a"}), map[string]interface{}{"name": "a", "tags": []interface{}{"team:a", "schematics:ws-1"}}, false},
+		// the ignored tags are filtered out of the state when the tags are read
+		{"tags not configured", testTagsState([]string{"team:a"}, []string{"team:a"}), map[string]interface{}{"name": "a"}, true},
+	} {
+		r := testTagsResource(true)
+		var s *terraform.InstanceState
+		if c.state != nil {
+			s = &terraform.InstanceState{ID: "r006-1", Attributes: c.state}
+		}
+		meta := testTagsSession{ignoreTags: ignoreTags}
+		_, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(c.config), meta)
+		if (err == nil) != c.valid {
+			t.Errorf("%s: expected valid %t, got %v", c.name, c.valid, err)
+		}
+	}
+}
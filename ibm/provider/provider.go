@@ -4,13 +4,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -54,7 +59,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -185,6 +190,52 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the IAM Trusted Profile of the compute resource, an alternative to iam_profile_id",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+			},
+			"compute_resource": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.ComputeResourceContainer, conns.ComputeResourceVpcInstance}),
+				Description:  "Compute resource whose token is exchanged for IAM tokens of the trusted profile, container for a Kubernetes pod or vpc_instance for a virtual server instance",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_COMPUTE_RESOURCE", "IBMCLOUD_COMPUTE_RESOURCE"}, nil),
+			},
+			"cr_token_filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File of the compute resource token of the container, defaults to /var/run/secrets/tokens/vault-token",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILENAME", "IBMCLOUD_CR_TOKEN_FILENAME"}, nil),
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Trusted profile assumed with the IAM token of the credentials of the provider, which may be in another account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"assume_trusted_profile.0.profile_name"},
+							Description:   "ID of the trusted profile to assume",
+						},
+						"profile_name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"assume_trusted_profile.0.profile_id"},
+							Description:   "Name of the trusted profile to assume, which requires account_id",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the account of the trusted profile, the account of the IAM token is verified against it",
+						},
+					},
+				},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -210,6 +261,116 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are attached to every taggable resource managed by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "List of tags to attach to every taggable resource",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are managed outside of Terraform and ignored by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag keys to ignore, the key of a tag is the part before the colon",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag key prefixes to ignore",
+						},
+					},
+				},
+			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Timeouts of the operations of a resource type, the timeouts block of a resource has precedence over them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type, such as ibm_database",
+						},
+						schema.TimeoutCreate:  defaultTimeoutSchema("create"),
+						schema.TimeoutRead:    defaultTimeoutSchema("read"),
+						schema.TimeoutUpdate:  defaultTimeoutSchema("update"),
+						schema.TimeoutDelete:  defaultTimeoutSchema("delete"),
+						schema.TimeoutDefault: defaultTimeoutSchema("operations whose timeout is not set"),
+					},
+				},
+			},
+			"plan_time_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Validate the instance profiles, Kubernetes versions and worker flavors of the resources against the catalog of the services at plan time",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PLAN_TIME_VALIDATION", "IBMCLOUD_PLAN_TIME_VALIDATION"}, false),
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy of the API calls, the number of retries is set with max_retries",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The base delay in seconds of the exponential backoff between retries",
+						},
+						"max_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum delay in seconds between retries, including the delay asked by the API with Retry-After",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+							Set:         schema.HashInt,
+							Description: "The HTTP status codes of the API calls to retry, defaults to 408, 429, 500, 502, 503, 504, 520 and 599",
+						},
+					},
+				},
+			},
+			"rate_limits": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "Maximum number of API requests per second sent to each service, for example { vpc = 20, iam = 10 }",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Custom endpoints of the services, they have precedence over the endpoint environment variables and the endpoints file",
+				Elem:        endpointsSchema(),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -332,6 +493,10 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_links":          iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":               iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":         iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_is_bare_metal_server_profile":       vpc.DataSourceIBMIsBareMetalServerProfile(),
+			"ibm_is_bare_metal_server_profiles":      vpc.DataSourceIBMIsBareMetalServerProfiles(),
+			"ibm_is_backup_policy_job":               vpc.DataSourceIBMIsBackupPolicyJob(),
+			"ibm_is_backup_policy_jobs":              vpc.DataSourceIBMIsBackupPolicyJobs(),
 			"ibm_is_dedicated_host":                  vpc.DataSourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_hosts":                 vpc.DataSourceIbmIsDedicatedHosts(),
 			"ibm_is_dedicated_host_profile":          vpc.DataSourceIbmIsDedicatedHostProfile(),
@@ -388,6 +553,7 @@ func Provider() *schema.Provider {
 			"ibm_is_security_group":                  vpc.DataSourceIBMISSecurityGroup(),
 			"ibm_is_security_group_target":           vpc.DataSourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_targets":          vpc.DataSourceIBMISSecurityGroupTargets(),
+			"ibm_is_share_profiles":                  vpc.DataSourceIBMIsShareProfiles(),
 			"ibm_is_snapshot":                        vpc.DataSourceSnapshot(),
 			"ibm_is_snapshots":                       vpc.DataSourceSnapshots(),
 			"ibm_is_volume":                          vpc.DataSourceIBMISVolume(),
@@ -398,6 +564,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateways":                    vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":            vpc.DataSourceIbmIsVpcAddressPrefixes(),
 			"ibm_is_vpn_gateway_connections":         vpc.DataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpn_server_client_configuration": vpc.DataSourceIBMIsVPNServerClientConfiguration(),
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_tables":              vpc.DataSourceIBMISVPCRoutingTables(),
 			"ibm_is_vpc_routing_table_routes":        vpc.DataSourceIBMISVPCRoutingTableRoutes(),
@@ -697,6 +864,12 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_link":                       iamidentity.ResourceIBMIAMTrustedProfileLink(),
 			"ibm_iam_trusted_profile_policy":                     iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
 			"ibm_ipsec_vpn":                                      classicinfrastructure.ResourceIBMIPSecVPN(),
+			"ibm_is_bare_metal_server":                           vpc.ResourceIBMIsBareMetalServer(),
+			"ibm_is_bare_metal_server_action":                    vpc.ResourceIBMIsBareMetalServerAction(),
+			"ibm_is_bare_metal_server_disk":                      vpc.ResourceIBMIsBareMetalServerDisk(),
+			"ibm_is_bare_metal_server_network_interface":         vpc.ResourceIBMIsBareMetalServerNetworkInterface(),
+			"ibm_is_backup_policy":                               vpc.ResourceIBMIsBackupPolicy(),
+			"ibm_is_backup_policy_plan":                          vpc.ResourceIBMIsBackupPolicyPlan(),
 			"ibm_is_dedicated_host":                              vpc.ResourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                        vpc.ResourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":              vpc.ResourceIBMISDedicatedHostDiskManagement(),
@@ -731,6 +904,8 @@ func Provider() *schema.Provider {
 			"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRule(),
 			"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_network_interface_attachment": vpc.ResourceIBMISSecurityGroupNetworkInterfaceAttachment(),
+			"ibm_is_share":                                       vpc.ResourceIBMIsShare(),
+			"ibm_is_share_target":                                vpc.ResourceIBMIsShareTarget(),
 			"ibm_is_subnet":                                      vpc.ResourceIBMISSubnet(),
 			"ibm_is_subnet_reserved_ip":                          vpc.ResourceIBMISReservedIP(),
 			"ibm_is_subnet_network_acl_attachment":               vpc.ResourceIBMISSubnetNetworkACLAttachment(),
@@ -739,6 +914,8 @@ func Provider() *schema.Provider {
 			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      vpc.ResourceIBMISVPNGatewayConnection(),
+			"ibm_is_vpn_server":                                  vpc.ResourceIBMIsVPNServer(),
+			"ibm_is_vpn_server_route":                            vpc.ResourceIBMIsVPNServerRoute(),
 			"ibm_is_vpc":                                         vpc.ResourceIBMISVPC(),
 			"ibm_is_vpc_address_prefix":                          vpc.ResourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   vpc.ResourceIBMISVpcRoute(),
@@ -886,9 +1063,27 @@ func Provider() *schema.Provider {
 			"ibm_en_topic":        eventnotification.ResourceIBMEnTopic(),
 			"ibm_en_subscription": eventnotification.ResourceIBMEnSubscription(),
 		},
+	}
+	// the resources as built, the default_timeouts of a configuration apply to copies of them
+	resources := provider.ResourcesMap
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		resourcesMap, err := resourcesWithDefaultTimeouts(resources, d.Get("default_timeouts").([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		provider.ResourcesMap = resourcesMap
+		meta, err := providerConfigure(d)
+		return meta, diag.FromErr(err)
+	}
 
-		ConfigureFunc: providerConfigure,
+	for name, resource := range provider.ResourcesMap {
+		flex.WithOperationLogging(name, flex.WithTimeouts(resource))
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		flex.WithOperationLogging(name, dataSource)
 	}
+
+	return provider
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1011,9 +1206,31 @@ func Validator() validate.ValidatorDict {
 				"ibm_scc_posture_credential":              scc.ResourceIBMSccPostureCredentialsValidator(),
 				"ibm_cbr_zone":                            contextbasedrestrictions.ResourceIBMCbrZoneValidator(),
 				"ibm_cbr_rule":                            contextbasedrestrictions.ResourceIBMCbrRuleValidator(),
+				"ibm_lbaas":                               classicinfrastructure.ResourceIBMLbaasValidator(),
+				"ibm_lbaas_health_monitor":                classicinfrastructure.ResourceIBMLbaasHealthMonitorValidator(),
+				"ibm_ipsec_vpn":                           classicinfrastructure.ResourceIBMIPSecVPNValidator(),
+				"ibm_iam_access_group_dynamic_rule":       iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
 
 				// // Added for Event Notifications
 				"ibm_en_destination": eventnotification.ResourceIBMEnDestinationValidator(),
+
+				// Added for VPC bare metal servers
+				"ibm_is_bare_metal_server":                   vpc.ResourceIBMIsBareMetalServerValidator(),
+				"ibm_is_bare_metal_server_action":            vpc.ResourceIBMIsBareMetalServerActionValidator(),
+				"ibm_is_bare_metal_server_disk":              vpc.ResourceIBMIsBareMetalServerDiskValidator(),
+				"ibm_is_bare_metal_server_network_interface": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceValidator(),
+
+				// Added for VPC file shares
+				"ibm_is_share":        vpc.ResourceIBMIsShareValidator(),
+				"ibm_is_share_target": vpc.ResourceIBMIsShareTargetValidator(),
+
+				// Added for VPC backup policies
+				"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicyValidator(),
+				"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlanValidator(),
+
+				// Added for VPC client-to-site VPN servers
+				"ibm_is_vpn_server":       vpc.ResourceIBMIsVPNServerValidator(),
+				"ibm_is_vpn_server_route": vpc.ResourceIBMIsVPNServerRouteValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*validate.ResourceValidator{
 				"ibm_is_subnet":               vpc.DataSourceIBMISSubnetValidator(),
@@ -1032,6 +1249,61 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
+// endpointsSchema returns an argument of the endpoints block for each service of conns.EndpointServices
+func endpointsSchema() *schema.Resource {
+	endpoints := make(map[string]*schema.Schema, len(conns.EndpointServices))
+	for service, key := range conns.EndpointServices {
+		endpoints[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("Endpoint of the service, it has precedence over the %s environment variable", key),
+		}
+	}
+	return &schema.Resource{Schema: endpoints}
+}
+
+func defaultTimeoutSchema(operation string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validate.ValidateDuration,
+		Description:  fmt.Sprintf("Timeout of the %s, such as 90m or 3h", operation),
+	}
+}
+
+// resourcesWithDefaultTimeouts returns the resources with the timeouts of the resource types of
+// the default_timeouts of the provider. The resources with default timeouts are copies, the
+// resources passed are not changed.
+func resourcesWithDefaultTimeouts(resources map[string]*schema.Resource, defaultTimeouts []interface{}) (map[string]*schema.Resource, error) {
+	resourcesMap := make(map[string]*schema.Resource, len(resources))
+	for resourceType, resource := range resources {
+		resourcesMap[resourceType] = resource
+	}
+	seen := make(map[string]bool)
+	for _, v := range defaultTimeouts {
+		l, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		resourceType := l["resource"].(string)
+		resource, ok := resources[resourceType]
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] Unknown resource type %q in default_timeouts", resourceType)
+		}
+		if seen[resourceType] {
+			return nil, fmt.Errorf("[ERROR] The default_timeouts of %s are set more than once", resourceType)
+		}
+		seen[resourceType] = true
+		timeouts, err := flex.ExpandDefaultTimeouts(l)
+		if err != nil {
+			return nil, err
+		}
+		resourcesMap[resourceType] = flex.WithDefaultTimeouts(resource, timeouts)
+	}
+	return resourcesMap, nil
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
@@ -1051,6 +1323,20 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if ttoken, ok := d.GetOk("iam_profile_id"); ok {
 		iamTrustedProfileId = ttoken.(string)
 	}
+	var iamProfileName, computeResource, crTokenFilename string
+	if v, ok := d.GetOk("iam_profile_name"); ok {
+		iamProfileName = v.(string)
+	}
+	if v, ok := d.GetOk("compute_resource"); ok {
+		computeResource = v.(string)
+	}
+	if v, ok := d.GetOk("cr_token_filename"); ok {
+		crTokenFilename = v.(string)
+		// the token file is only read by the container compute resource
+		if computeResource == "" {
+			computeResource = conns.ComputeResourceContainer
+		}
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -1092,11 +1378,64 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && v.([]interface{})[0] != nil {
+		defaultTagsConfig := v.([]interface{})[0].(map[string]interface{})
+		defaultTags = flex.ExpandStringList(defaultTagsConfig["tags"].(*schema.Set).List())
+	}
+	var ignoreTags *conns.IgnoreTagsConfig
+	if v, ok := d.GetOk("ignore_tags"); ok && v.([]interface{})[0] != nil {
+		ignoreTagsConfig := v.([]interface{})[0].(map[string]interface{})
+		ignoreTags = &conns.IgnoreTagsConfig{
+			Keys:        flex.ExpandStringList(ignoreTagsConfig["keys"].(*schema.Set).List()),
+			KeyPrefixes: flex.ExpandStringList(ignoreTagsConfig["key_prefixes"].(*schema.Set).List()),
+		}
+	}
+
+	var assumeTrustedProfile *conns.AssumeTrustedProfileConfig
+	if v, ok := d.GetOk("assume_trusted_profile"); ok && v.([]interface{})[0] != nil {
+		assumeConfig := v.([]interface{})[0].(map[string]interface{})
+		assumeTrustedProfile = &conns.AssumeTrustedProfileConfig{
+			ProfileID:   assumeConfig["profile_id"].(string),
+			ProfileName: assumeConfig["profile_name"].(string),
+			AccountID:   assumeConfig["account_id"].(string),
+		}
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryDelay := conns.DefaultRetryMinDelay
+	retryMaxDelay := conns.DefaultRetryMaxDelay
+	var retryableStatusCodes []int
+	if v, ok := d.GetOk("retry"); ok && v.([]interface{})[0] != nil {
+		retryConfig := v.([]interface{})[0].(map[string]interface{})
+		retryDelay = time.Duration(retryConfig["min_delay"].(int)) * time.Second
+		retryMaxDelay = time.Duration(retryConfig["max_delay"].(int)) * time.Second
+		for _, code := range retryConfig["retryable_status_codes"].(*schema.Set).List() {
+			retryableStatusCodes = append(retryableStatusCodes, code.(int))
+		}
+	}
+	var rateLimits map[string]float64
+	if v, ok := d.GetOk("rate_limits"); ok {
+		rateLimits = make(map[string]float64)
+		for service, limit := range v.(map[string]interface{}) {
+			rateLimits[service] = limit.(float64)
+		}
+		if err := conns.ValidateRateLimits(rateLimits); err != nil {
+			return nil, err
+		}
+	}
+	var endpoints map[string]string
+	if v, ok := d.GetOk("endpoints"); ok && v.([]interface{})[0] != nil {
+		endpoints = make(map[string]string)
+		for service, endpoint := range v.([]interface{})[0].(map[string]interface{}) {
+			if endpoint.(string) != "" {
+				endpoints[conns.EndpointServices[service]] = endpoint.(string)
+			}
+		}
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1119,7 +1458,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SoftLayerAPIKey:      softlayerAPIKey,
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
+		RetryDelay:           retryDelay,
+		RetryMaxDelay:        retryMaxDelay,
+		RetryableStatusCodes: retryableStatusCodes,
+		RateLimits:           rateLimits,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -1127,7 +1469,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Zone:                 zone,
 		Visibility:           visibility,
 		EndpointsFile:        file,
+		Endpoints:            endpoints,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		IAMProfileName:       iamProfileName,
+		ComputeResource:      computeResource,
+		CRTokenFilename:      crTokenFilename,
+		AssumeTrustedProfile: assumeTrustedProfile,
+		DefaultTags:          defaultTags,
+		IgnoreTags:           ignoreTags,
+		PlanTimeValidation:   d.Get("plan_time_validation").(bool),
 		//PowerServiceInstance: powerServiceInstance,
 	}
 
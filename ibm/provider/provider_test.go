@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// building the provider records the validators which it invokes, a wrong identifier would
+	// leave its argument rejecting every value
+	if err := validate.CheckValidators(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// The tags of the resources with default tags are cleared from the plan when only the provider
+// tags are removed, which the SDK allows on computed keys only
+func TestProviderDefaultTags(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if _, ok := r.Schema[flex.TagsAll]; !ok {
+			continue
+		}
+		if tags, ok := r.Schema["tags"]; !ok || !tags.Computed {
+			t.Errorf("%s: expected tags to be optional and computed with %s", name, flex.TagsAll)
+		}
+	}
+}
+
+func TestResourcesWithDefaultTimeouts(t *testing.T) {
+	resources := Provider().ResourcesMap
+	database := resources["ibm_database"]
+	create := *database.Timeouts.Create
+
+	configured, err := resourcesWithDefaultTimeouts(resources, []interface{}{
+		map[string]interface{}{"resource": "ibm_database", "create": "3h"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *configured["ibm_database"].Timeouts.Create != 3*time.Hour || configured["ibm_is_vpc"] != resources["ibm_is_vpc"] {
+		t.Errorf("expected the default timeouts of ibm_database only, got %+v", configured["ibm_database"].Timeouts)
+	}
+	// another configuration of the provider starts from the resources as built
+	if *database.Timeouts.Create != create {
+		t.Errorf("expected the timeouts of the built resource to be kept, got %s", *database.Timeouts.Create)
+	}
+	if configured, _ := resourcesWithDefaultTimeouts(resources, nil); configured["ibm_database"] != database {
+		t.Errorf("expected the resource as built without default_timeouts")
+	}
+
+	for _, defaultTimeouts := range [][]interface{}{
+		{map[string]interface{}{"resource": "ibm_unknown", "create": "3h"}},
+		{map[string]interface{}{"resource": "ibm_database", "create": "3h"}, map[string]interface{}{"resource": "ibm_database", "delete": "3h"}},
+	} {
+		if _, err := resourcesWithDefaultTimeouts(resources, defaultTimeouts); err == nil {
+			t.Errorf("%v: expected an error", defaultTimeouts)
+		}
+	}
+}
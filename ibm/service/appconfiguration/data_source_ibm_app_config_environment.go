@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 )
 
@@ -86,7 +87,7 @@ func dataSourceIbmAppConfigEnvironmentRead(d *schema.ResourceData, meta interfac
 	if _, ok := d.GetOk("expand"); ok {
 		options.SetExpand(d.Get("expand").(bool))
 	}
-	result, response, err := appconfigClient.GetEnvironment(options)
+	result, response, err := appconfigClient.GetEnvironmentWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		log.Printf("GetEnvironment failed %s\n%s", err, response)
 		return err
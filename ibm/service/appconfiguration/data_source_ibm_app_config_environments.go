@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 )
 
@@ -195,7 +196,7 @@ func dataSourceIbmAppConfigEnvironmentsRead(d *schema.ResourceData, meta interfa
 	}
 	for {
 		options.SetOffset(offset)
-		result, response, err := appconfigClient.ListEnvironments(options)
+		result, response, err := appconfigClient.ListEnvironmentsWithContext(flex.OperationContext(d), options)
 		environmentList = result
 		if err != nil {
 			log.Printf("[DEBUG] ListEnvironments failed %s\n%s", err, response)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 )
 
@@ -169,7 +170,7 @@ func dataSourceIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{})
 		options.SetInclude(d.Get("includes").(string))
 	}
 
-	result, response, err := appconfigClient.GetFeature(options)
+	result, response, err := appconfigClient.GetFeatureWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		log.Printf("[DEBUG] GetFeature failed %s\n%s", err, response)
 		return err
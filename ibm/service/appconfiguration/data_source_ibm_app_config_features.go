@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 )
 
@@ -316,7 +317,7 @@ func dataSourceIbmAppConfigFeaturesRead(d *schema.ResourceData, meta interface{}
 	}
 	for {
 		options.Offset = &offset
-		result, response, err := appconfigClient.ListFeatures(options)
+		result, response, err := appconfigClient.ListFeaturesWithContext(flex.OperationContext(d), options)
 		featuresList = result
 		if err != nil {
 			log.Printf("[DEBUG] ListFeatures failed %s\n%s", err, response)
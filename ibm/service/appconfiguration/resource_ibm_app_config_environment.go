@@ -105,7 +105,7 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("color_code"); ok {
 		options.SetColorCode(d.Get("color_code").(string))
 	}
-	_, response, err := appconfigClient.CreateEnvironment(options)
+	_, response, err := appconfigClient.CreateEnvironmentWithContext(flex.OperationContext(d), options)
 
 	if err != nil {
 		return fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response)
@@ -140,7 +140,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 			options.SetColorCode(d.Get("color_code").(string))
 		}
 
-		_, response, err := appconfigClient.UpdateEnvironment(options)
+		_, response, err := appconfigClient.UpdateEnvironmentWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response)
 		}
@@ -164,7 +164,7 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	options.SetExpand(true)
 	options.SetEnvironmentID(parts[1])
 
-	result, response, err := appconfigClient.GetEnvironment(options)
+	result, response, err := appconfigClient.GetEnvironmentWithContext(flex.OperationContext(d), options)
 
 	if err != nil {
 		return fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response)
@@ -227,7 +227,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	options := &appconfigurationv1.DeleteEnvironmentOptions{}
 	options.SetEnvironmentID(parts[1])
 
-	response, err := appconfigClient.DeleteEnvironment(options)
+	response, err := appconfigClient.DeleteEnvironmentWithContext(flex.OperationContext(d), options)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -191,7 +191,7 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 		options.SetCollections(collections)
 	}
 
-	feature, response, err := appconfigClient.CreateFeature(options)
+	feature, response, err := appconfigClient.CreateFeatureWithContext(flex.OperationContext(d), options)
 
 	if err != nil {
 		log.Printf("CreateFeature failed %s\n%s", err, response)
@@ -248,7 +248,7 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 			options.SetCollections(collections)
 		}
 
-		_, response, err := appconfigClient.UpdateFeature(options)
+		_, response, err := appconfigClient.UpdateFeatureWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateFeature %s\n%s", err, response)
 			return err
@@ -272,7 +272,7 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 	options.SetEnvironmentID(parts[1])
 	options.SetFeatureID(parts[2])
 
-	result, response, err := appconfigClient.GetFeature(options)
+	result, response, err := appconfigClient.GetFeatureWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response)
 	}
@@ -394,7 +394,7 @@ func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface
 	options.SetEnvironmentID(parts[1])
 	options.SetFeatureID(parts[2])
 
-	response, err := appconfigClient.DeleteFeature(options)
+	response, err := appconfigClient.DeleteFeatureWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -106,7 +107,7 @@ func resourceIBMCmCatalogCreate(d *schema.ResourceData, meta interface{}) error
 		createCatalogOptions.SetResourceGroupID(d.Get("resource_group_id").(string))
 	}
 
-	catalog, response, err := catalogManagementClient.CreateCatalog(createCatalogOptions)
+	catalog, response, err := catalogManagementClient.CreateCatalogWithContext(flex.OperationContext(d), createCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCatalog failed %s\n%s", err, response)
 		return err
@@ -128,7 +129,7 @@ func resourceIBMCmCatalogRead(d *schema.ResourceData, meta interface{}) error {
 
 	getCatalogOptions.SetCatalogIdentifier(d.Id())
 
-	catalog, response, err := catalogManagementClient.GetCatalog(getCatalogOptions)
+	catalog, response, err := catalogManagementClient.GetCatalogWithContext(flex.OperationContext(d), getCatalogOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -180,7 +181,7 @@ func resourceIBMCmCatalogDelete(d *schema.ResourceData, meta interface{}) error
 
 	deleteCatalogOptions.SetCatalogIdentifier(d.Id())
 
-	response, err := catalogManagementClient.DeleteCatalog(deleteCatalogOptions)
+	response, err := catalogManagementClient.DeleteCatalogWithContext(flex.OperationContext(d), deleteCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCatalog failed %s\n%s", err, response)
 		return err
@@ -185,7 +185,7 @@ func resourceIBMCmOfferingCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	offering, response, err := catalogManagementClient.CreateOffering(createOfferingOptions)
+	offering, response, err := catalogManagementClient.CreateOfferingWithContext(flex.OperationContext(d), createOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOffering failed %s\n%s", err, response)
 		return err
@@ -207,7 +207,7 @@ func resourceIBMCmOfferingRead(d *schema.ResourceData, meta interface{}) error {
 	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
 	getOfferingOptions.SetOfferingID(d.Id())
 
-	offering, response, err := catalogManagementClient.GetOffering(getOfferingOptions)
+	offering, response, err := catalogManagementClient.GetOfferingWithContext(flex.OperationContext(d), getOfferingOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -306,7 +306,7 @@ func resourceIBMCmOfferingDelete(d *schema.ResourceData, meta interface{}) error
 	deleteOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
 	deleteOfferingOptions.SetOfferingID(d.Id())
 
-	response, err := catalogManagementClient.DeleteOffering(deleteOfferingOptions)
+	response, err := catalogManagementClient.DeleteOfferingWithContext(flex.OperationContext(d), deleteOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingWithContext failed %s\n%s", err, response)
 		return err
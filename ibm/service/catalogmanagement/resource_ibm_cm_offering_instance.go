@@ -183,7 +183,7 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 		createOfferingInstanceOptions.SetChannel(d.Get("channel").(string))
 	}
 
-	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstance(createOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstanceWithContext(flex.OperationContext(d), createOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingInstance failed %s\n%s", err, response)
 		return err
@@ -216,7 +216,7 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		Pending: []string{inProgress},
 		Target:  []string{success},
 		Refresh: func() (interface{}, string, error) {
-			offeringInstance, _, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+			offeringInstance, _, err := catalogManagementClient.GetOfferingInstanceWithContext(flex.OperationContext(d), getOfferingInstanceOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error retrieving offering instance: %s", err)
 			}
@@ -228,7 +228,7 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -241,7 +241,7 @@ func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{})
 
 	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(flex.OperationContext(d), getOfferingInstanceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -309,7 +309,7 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 
 	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(flex.OperationContext(d), getOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Failed to retrieve rev %s\n%s", err, response)
 		return err
@@ -369,7 +369,7 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 		putOfferingInstanceOptions.SetChannel(d.Get("channel").(string))
 	}
 
-	_, response, err = catalogManagementClient.PutOfferingInstance(putOfferingInstanceOptions)
+	_, response, err = catalogManagementClient.PutOfferingInstanceWithContext(flex.OperationContext(d), putOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutOfferingInstance failed %s\n%s", err, response)
 		return err
@@ -393,7 +393,7 @@ func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{
 	deleteOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 	deleteOfferingInstanceOptions.SetXAuthRefreshToken(rsConClient.Config.IAMRefreshToken)
 
-	response, err := catalogManagementClient.DeleteOfferingInstance(deleteOfferingInstanceOptions)
+	response, err := catalogManagementClient.DeleteOfferingInstanceWithContext(flex.OperationContext(d), deleteOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingInstance failed %s\n%s", err, response)
 		return err
@@ -414,7 +414,7 @@ func resourceIBMCmOfferingInstanceExists(d *schema.ResourceData, meta interface{
 
 	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
 
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(flex.OperationContext(d), getOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetOfferingInstance failed %s\n%s", err, response)
 		return false, err
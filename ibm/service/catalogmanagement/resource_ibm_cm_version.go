@@ -146,7 +146,7 @@ func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error
 		importOfferingVersionOptions.SetTargetVersion(d.Get("target_version").(string))
 	}
 
-	offering, response, err := catalogManagementClient.ImportOfferingVersion(importOfferingVersionOptions)
+	offering, response, err := catalogManagementClient.ImportOfferingVersionWithContext(flex.OperationContext(d), importOfferingVersionOptions)
 
 	if err != nil {
 		log.Printf("[DEBUG] ImportOfferingVersion failed %s\n%s", err, response)
@@ -170,7 +170,7 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 
 	getVersionOptions.SetVersionLocID(d.Id())
 
-	offering, response, err := catalogManagementClient.GetVersion(getVersionOptions)
+	offering, response, err := catalogManagementClient.GetVersionWithContext(flex.OperationContext(d), getVersionOptions)
 	version := offering.Kinds[0].Versions[0]
 
 	if err != nil {
@@ -225,7 +225,7 @@ func resourceIBMCmVersionDelete(d *schema.ResourceData, meta interface{}) error
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
 	deleteVersionOptions.SetVersionLocID(d.Id())
 
-	response, err := catalogManagementClient.DeleteVersion(deleteVersionOptions)
+	response, err := catalogManagementClient.DeleteVersionWithContext(flex.OperationContext(d), deleteVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVersion failed %s\n%s", err, response)
 		return err
@@ -15,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -294,7 +295,7 @@ func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cmService, err := meta.(conns.ClientSession).CertificateManagerAPI()
@@ -324,5 +325,5 @@ func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
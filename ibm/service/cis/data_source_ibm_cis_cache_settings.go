@@ -188,7 +188,7 @@ func dataSourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) er
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	// Cache Level Setting
-	cacheLevel_result, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
+	cacheLevel_result, resp, err := cisClient.GetCacheLevelWithContext(flex.OperationContext(d), cisClient.NewGetCacheLevelOptions())
 
 	if err != nil {
 		log.Printf("Get Cache Level  setting failed : %v\n", resp)
@@ -216,7 +216,7 @@ func dataSourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) er
 
 	}
 	// Serve Stale Content setting
-	servestaleContent_result, resp, err := cisClient.GetServeStaleContent(cisClient.NewGetServeStaleContentOptions())
+	servestaleContent_result, resp, err := cisClient.GetServeStaleContentWithContext(flex.OperationContext(d), cisClient.NewGetServeStaleContentOptions())
 
 	if err != nil {
 		log.Printf("Get Serve Stale Content setting failed : %v\n", resp)
@@ -245,7 +245,7 @@ func dataSourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Browser Expiration setting
-	browserCacheTTL_result, resp, err := cisClient.GetBrowserCacheTTL(cisClient.NewGetBrowserCacheTtlOptions())
+	browserCacheTTL_result, resp, err := cisClient.GetBrowserCacheTTLWithContext(flex.OperationContext(d), cisClient.NewGetBrowserCacheTtlOptions())
 
 	if err != nil {
 		log.Printf("Get browser expiration setting failed : %v\n", resp)
@@ -273,7 +273,7 @@ func dataSourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) er
 
 	}
 	// development mode setting
-	devMode_result, resp, err := cisClient.GetDevelopmentMode(cisClient.NewGetDevelopmentModeOptions())
+	devMode_result, resp, err := cisClient.GetDevelopmentModeWithContext(flex.OperationContext(d), cisClient.NewGetDevelopmentModeOptions())
 
 	if err != nil {
 		log.Printf("Get development mode setting failed : %v", resp)
@@ -302,7 +302,7 @@ func dataSourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Query string sort setting
-	queryStringSort_result, resp, err := cisClient.GetQueryStringSort(cisClient.NewGetQueryStringSortOptions())
+	queryStringSort_result, resp, err := cisClient.GetQueryStringSortWithContext(flex.OperationContext(d), cisClient.NewGetQueryStringSortOptions())
 
 	if err != nil {
 		log.Printf("Get query string sort setting failed : %v", resp)
@@ -119,7 +119,7 @@ func dataIBMCISCertificatesRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListCertificatesOptions()
-	result, response, err := cisClient.ListCertificates(opt)
+	result, response, err := cisClient.ListCertificatesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("List all certificates failed: %v", response)
 		return err
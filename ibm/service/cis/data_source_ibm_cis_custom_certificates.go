@@ -108,7 +108,7 @@ func dataSourceIBMCISCustomCertificatesRead(d *schema.ResourceData, meta interfa
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListCustomCertificatesOptions()
-	result, resp, err := cisClient.ListCustomCertificates(opt)
+	result, resp, err := cisClient.ListCustomCertificatesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to list custom certificates: %v", resp)
 	}
@@ -100,7 +100,7 @@ func dataSourceIBMCISCustomPagesRead(d *schema.ResourceData, meta interface{}) e
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListZoneCustomPagesOptions()
 
-	result, response, err := cisClient.ListZoneCustomPages(opt)
+	result, response, err := cisClient.ListZoneCustomPagesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("List custom pages failed: %v", response)
 		return err
@@ -22,7 +22,7 @@ const (
 )
 
 func DataSourceIBMCISDNSRecords() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		Read:     dataSourceIBMCISDNSRecordsRead,
 		Importer: &schema.ResourceImporter{},
 
@@ -122,7 +122,7 @@ func DataSourceIBMCISDNSRecords() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, cisDNSRecords)
 }
 
 func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) error {
@@ -150,7 +150,7 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 		sess.Crn = core.StringPtr(crn)
 		sess.ZoneIdentifier = core.StringPtr(zoneID)
 		opt := sess.NewGetDnsRecordsBulkOptions()
-		result, response, err := sess.GetDnsRecordsBulk(opt)
+		result, response, err := sess.GetDnsRecordsBulkWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Error exporting dns records: %s", response)
 			return err
@@ -174,7 +174,7 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 	opt := sess.NewListAllDnsRecordsOptions()
 	opt.SetPage(1)
 	opt.SetPerPage(1000)
-	result, response, err := sess.ListAllDnsRecords(opt)
+	result, response, err := sess.ListAllDnsRecordsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error reading dns records: %s", response)
 		return err
@@ -205,6 +205,10 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 
 		records = append(records, record)
 	}
+	records, err = flex.FilterItems(d, records, nil)
+	if err != nil {
+		return err
+	}
 	d.SetId(dataSourceIBMCISDNSRecordID(d))
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
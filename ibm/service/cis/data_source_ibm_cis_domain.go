@@ -68,7 +68,7 @@ func dataSourceIBMCISDomainRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewListZonesOptions()
 	opt.SetPage(1)       // list all zones in one page
 	opt.SetPerPage(1000) // maximum allowed limit is 1000 per page
-	zones, resp, err := cisClient.ListZones(opt)
+	zones, resp, err := cisClient.ListZonesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("dataSourcCISdomainRead - ListZones Failed %s\n", resp)
 		return err
@@ -115,7 +115,7 @@ func dataSourceIBMCISEdgeFunctionsActionsRead(d *schema.ResourceData, meta inter
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewListEdgeFunctionsActionsOptions()
-	result, _, err := cisClient.ListEdgeFunctionsActions(opt)
+	result, _, err := cisClient.ListEdgeFunctionsActionsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error: %v", err)
 	}
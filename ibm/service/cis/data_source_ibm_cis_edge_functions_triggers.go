@@ -79,7 +79,7 @@ func dataSourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta inter
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewListEdgeFunctionsTriggersOptions()
-	result, _, err := cisClient.ListEdgeFunctionsTriggers(opt)
+	result, _, err := cisClient.ListEdgeFunctionsTriggersWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error listing edge functions triggers: %v", err)
 	}
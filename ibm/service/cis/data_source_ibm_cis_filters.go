@@ -76,7 +76,7 @@ func dataIBMCISFiltersRead(d *schema.ResourceData, meta interface{}) error {
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
 
-	result, resp, err := cisClient.ListAllFilters(cisClient.NewListAllFiltersOptions(xAuthtoken, crn, zoneID))
+	result, resp, err := cisClient.ListAllFiltersWithContext(flex.OperationContext(d), cisClient.NewListAllFiltersOptions(xAuthtoken, crn, zoneID))
 	if err != nil || result == nil {
 		return fmt.Errorf("[ERROR] Error Listing all filters %q: %s %s", d.Id(), err, resp)
 	}
@@ -191,7 +191,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 		opt := cisClient.NewListAllZoneLockownRulesOptions()
-		result, response, err := cisClient.ListAllZoneLockownRules(opt)
+		result, response, err := cisClient.ListAllZoneLockownRulesWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("List all zone lockdown rules failed: %v", response)
 			return err
@@ -227,7 +227,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 		opt := cisClient.NewListAllZoneAccessRulesOptions()
-		result, response, err := cisClient.ListAllZoneAccessRules(opt)
+		result, response, err := cisClient.ListAllZoneAccessRulesWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("List all zone access rules failed: %v", response)
 			return err
@@ -255,7 +255,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 		opt := cisClient.NewListAllZoneUserAgentRulesOptions()
-		result, response, err := cisClient.ListAllZoneUserAgentRules(opt)
+		result, response, err := cisClient.ListAllZoneUserAgentRulesWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("List all zone ua rules failed: %v", response)
 			return err
@@ -81,7 +81,7 @@ func dataSourceIBMCISFirewallRulesRead(context context.Context, d *schema.Resour
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
 
-	result, resp, err := cisClient.ListAllFirewallRules(cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID))
+	result, resp, err := cisClient.ListAllFirewallRulesWithContext(flex.OperationContext(d), cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID))
 	if err != nil || result == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the  firewall rules %s:%s", err, resp))
 	}
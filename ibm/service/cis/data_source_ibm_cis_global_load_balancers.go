@@ -168,7 +168,7 @@ func dataSourceCISGlbsRead(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewListAllLoadBalancersOptions()
 
-	result, resp, err := cisClient.ListAllLoadBalancers(opt)
+	result, resp, err := cisClient.ListAllLoadBalancersWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] List all GLB failed: %v\n", resp)
 		return err
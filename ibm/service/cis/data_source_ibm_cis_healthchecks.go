@@ -157,7 +157,7 @@ func dataSourceIBMCISGLBHealthCheckRead(d *schema.ResourceData, meta interface{}
 
 	opt := sess.NewListAllLoadBalancerMonitorsOptions()
 
-	result, resp, err := sess.ListAllLoadBalancerMonitors(opt)
+	result, resp, err := sess.ListAllLoadBalancerMonitorsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error listing global load balancer health check detail: %s", resp)
 		return err
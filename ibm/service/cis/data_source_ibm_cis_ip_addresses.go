@@ -42,7 +42,7 @@ func dataSourceIBMCISIPRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	opt := cisClient.NewListIpsOptions()
-	result, response, err := cisClient.ListIps(opt)
+	result, response, err := cisClient.ListIpsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Failed to list IP addresses: %v", response)
 		return err
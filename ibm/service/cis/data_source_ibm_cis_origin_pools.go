@@ -154,7 +154,7 @@ func dataSourceIBMCISGLBPoolsRead(d *schema.ResourceData, meta interface{}) erro
 	cisClient.Crn = core.StringPtr(crn)
 
 	opt := cisClient.NewListAllLoadBalancerPoolsOptions()
-	result, resp, err := cisClient.ListAllLoadBalancerPools(opt)
+	result, resp, err := cisClient.ListAllLoadBalancerPoolsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error listing global load balancer pools detail: %s", resp)
 		return err
@@ -159,7 +159,7 @@ func dataSourceIBMCISPageRulesRead(d *schema.ResourceData, meta interface{}) err
 
 	opt := sess.NewListPageRulesOptions()
 
-	result, resp, err := sess.ListPageRules(opt)
+	result, resp, err := sess.ListPageRulesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error listing page rules detail: %s", resp)
 		return err
@@ -134,7 +134,7 @@ func dataSourceIBMCISRangeAppsRead(d *schema.ResourceData, meta interface{}) err
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListRangeAppsOptions()
-	result, resp, err := cisClient.ListRangeApps(opt)
+	result, resp, err := cisClient.ListRangeAppsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to list range applications: %v", resp)
 	}
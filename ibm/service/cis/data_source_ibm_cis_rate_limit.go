@@ -194,7 +194,7 @@ func dataSourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) err
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListAllZoneRateLimitsOptions()
-	rateLimitRecord, resp, err := cisClient.ListAllZoneRateLimits(opt)
+	rateLimitRecord, resp, err := cisClient.ListAllZoneRateLimitsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to read RateLimit: %v", resp)
 	}
@@ -97,7 +97,7 @@ func dataSourceIBMCISWAFGroupsRead(d *schema.ResourceData, meta interface{}) err
 	opt := cisClient.NewListWafRuleGroupsOptions(packageID)
 	opt.SetPage(1)
 	opt.SetPerPage(100)
-	result, resp, err := cisClient.ListWafRuleGroups(opt)
+	result, resp, err := cisClient.ListWafRuleGroupsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("List waf rule groups failed: %s\n", resp)
 		return err
@@ -82,7 +82,7 @@ func dataSourceIBMCISWAFPackagesRead(d *schema.ResourceData, meta interface{}) e
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	opt := cisClient.NewListWafPackagesOptions()
-	result, resp, err := cisClient.ListWafPackages(opt)
+	result, resp, err := cisClient.ListWafPackagesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error listing waf packages detail: %s", resp)
 		return err
@@ -121,7 +121,7 @@ func dataSourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewListWafRulesOptions(packageID)
 	opt.SetPage(1)
 	opt.SetPerPage(1000)
-	result, response, err := cisClient.ListWafRules(opt)
+	result, response, err := cisClient.ListWafRulesWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("List waf rules failed %s\n", response)
 		return err
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -47,6 +49,12 @@ func ResourceIBMCISInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -97,9 +105,17 @@ func ResourceIBMCISInstance() *schema.Resource {
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_cis", "tag")},
 				Set:      schema.HashString,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -212,7 +228,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		rg := rsGrpID.(string)
 		rsInst.ResourceGroup = &rg
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return err
 		}
@@ -223,12 +239,12 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		rsInst.Parameters = parameters.(map[string]interface{})
 	}
 
-	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -261,7 +277,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		if strings.Contains(err.Error(), "Object not found") ||
 			strings.Contains(err.Error(), "status code: 404") {
@@ -282,6 +298,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			"Error on get of ibm cis tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -362,7 +379,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -371,7 +388,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+	_, response, err := rsConClient.UpdateResourceInstanceWithContext(flex.OperationContext(d), &updateReq)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
 	}
@@ -396,7 +413,7 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 		ID:        &id,
 		Recursive: &recursive,
 	}
-	response, err := rsConClient.DeleteResourceInstance(&deleteReq)
+	response, err := rsConClient.DeleteResourceInstanceWithContext(flex.OperationContext(d), &deleteReq)
 	if err != nil {
 		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
 		// RC 410 with "Gone" returned as error
@@ -428,7 +445,7 @@ func ResourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
 			if apiErr.StatusCode() == 404 {
@@ -461,7 +478,7 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -478,7 +495,7 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -496,7 +513,7 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -513,7 +530,7 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -530,7 +547,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, CisInstanceSuccessStatus, nil
@@ -547,7 +564,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func filterCISDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
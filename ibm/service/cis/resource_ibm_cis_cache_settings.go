@@ -199,7 +199,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsCachingLevel); ok {
 			opt := cisClient.NewUpdateCacheLevelOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateCacheLevel(opt)
+			_, resp, err := cisClient.UpdateCacheLevelWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update caching level failed : %v\n", resp)
 				return err
@@ -209,7 +209,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheServeStaleContent); ok {
 			opt := cisClient.NewUpdateServeStaleContentOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateServeStaleContent(opt)
+			_, resp, err := cisClient.UpdateServeStaleContentWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update Serve Stale Content Setting failed : %v\n", resp)
 				return err
@@ -220,7 +220,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsBrowserExpiration); ok {
 			opt := cisClient.NewUpdateBrowserCacheTtlOptions()
 			opt.SetValue(int64(value.(int)))
-			_, resp, err := cisClient.UpdateBrowserCacheTTL(opt)
+			_, resp, err := cisClient.UpdateBrowserCacheTTLWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update browser expiration setting failed : %v\n", resp)
 				return err
@@ -231,7 +231,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsDevelopmentMode); ok {
 			opt := cisClient.NewUpdateDevelopmentModeOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateDevelopmentMode(opt)
+			_, resp, err := cisClient.UpdateDevelopmentModeWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update development mode setting failed : %v\n", resp)
 				return err
@@ -241,7 +241,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOk(cisCacheSettingsQueryStringSort); ok {
 			opt := cisClient.NewUpdateQueryStringSortOptions()
 			opt.SetValue(value.(string))
-			_, resp, err := cisClient.UpdateQueryStringSort(opt)
+			_, resp, err := cisClient.UpdateQueryStringSortWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update query string sort setting failed : %v\n", resp)
 				return err
@@ -251,7 +251,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 		if value, ok := d.GetOkExists(cisCachePurgeAll); ok {
 			if value.(bool) == true {
 				opt := cisClient.NewPurgeAllOptions()
-				result, response, err := cisClient.PurgeAll(opt)
+				result, response, err := cisClient.PurgeAllWithContext(flex.OperationContext(d), opt)
 				if err != nil {
 					log.Printf("Purge all failed : %v", response)
 					return err
@@ -263,7 +263,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			urls := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByUrlsOptions()
 			opt.SetFiles(urls)
-			_, response, err := cisClient.PurgeByUrls(opt)
+			_, response, err := cisClient.PurgeByUrlsWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Purge by urls failed : %v", response)
 				return err
@@ -273,7 +273,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			cacheTags := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByCacheTagsOptions()
 			opt.SetTags(cacheTags)
-			result, response, err := cisClient.PurgeByCacheTags(opt)
+			result, response, err := cisClient.PurgeByCacheTagsWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Purge by cache tags failed : %v", response)
 				return err
@@ -285,7 +285,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			hosts := flex.ExpandStringList(value.([]interface{}))
 			opt := cisClient.NewPurgeByHostsOptions()
 			opt.SetHosts(hosts)
-			result, response, err := cisClient.PurgeByHosts(opt)
+			result, response, err := cisClient.PurgeByHostsWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Purge by hosts failed : %v", response)
 				return err
@@ -307,21 +307,21 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	// Caching Level Setting
-	cacheLevel, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
+	cacheLevel, resp, err := cisClient.GetCacheLevelWithContext(flex.OperationContext(d), cisClient.NewGetCacheLevelOptions())
 	if err != nil {
 		log.Printf("Get caching leve setting failed : %v\n", resp)
 		return err
 	}
 
 	// Serve Stale Content setting
-	servestaleContent, resp, err := cisClient.GetServeStaleContent(cisClient.NewGetServeStaleContentOptions())
+	servestaleContent, resp, err := cisClient.GetServeStaleContentWithContext(flex.OperationContext(d), cisClient.NewGetServeStaleContentOptions())
 	if err != nil {
 		log.Printf("Get Serve Stale Content setting failed : %v\n", resp)
 		return err
 	}
 
 	// Browser Expiration setting
-	browserCacheTTL, resp, err := cisClient.GetBrowserCacheTTL(
+	browserCacheTTL, resp, err := cisClient.GetBrowserCacheTTLWithContext(flex.OperationContext(d),
 		cisClient.NewGetBrowserCacheTtlOptions())
 	if err != nil {
 		log.Printf("Get browser expiration setting failed : %v\n", resp)
@@ -329,7 +329,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// development mode setting
-	devMode, resp, err := cisClient.GetDevelopmentMode(
+	devMode, resp, err := cisClient.GetDevelopmentModeWithContext(flex.OperationContext(d),
 		cisClient.NewGetDevelopmentModeOptions())
 	if err != nil {
 		log.Printf("Get development mode setting failed : %v", resp)
@@ -337,7 +337,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// Query string sort setting
-	queryStringSort, resp, err := cisClient.GetQueryStringSort(
+	queryStringSort, resp, err := cisClient.GetQueryStringSortWithContext(flex.OperationContext(d),
 		cisClient.NewGetQueryStringSortOptions())
 	if err != nil {
 		log.Printf("Get query string sort setting failed : %v", resp)
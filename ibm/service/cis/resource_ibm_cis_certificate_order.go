@@ -105,7 +105,7 @@ func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	opt.SetType(certType)
 	opt.SetHosts(hostsList)
 
-	result, resp, err := cisClient.OrderCertificate(opt)
+	result, resp, err := cisClient.OrderCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
 		return err
@@ -128,7 +128,7 @@ func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
-	result, resp, err := cisClient.GetCustomCertificate(opt)
+	result, resp, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Certificate read failed: %v", resp)
 		return err
@@ -155,7 +155,7 @@ func ResourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteCertificateOptions(certificateID)
-	resp, err := cisClient.DeleteCertificate(opt)
+	resp, err := cisClient.DeleteCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
 		return err
@@ -182,7 +182,7 @@ func ResourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
-	_, response, err := cisClient.GetCustomCertificate(opt)
+	_, response, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 400 {
 			log.Printf("Certificate is not found")
@@ -211,7 +211,7 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		Pending: []string{cisCertificateOrderDeletePending},
 		Target:  []string{cisCertificateOrderDeleted},
 		Refresh: func() (interface{}, string, error) {
-			_, detail, err := cisClient.GetCustomCertificate(opt)
+			_, detail, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				if detail != nil && detail.StatusCode == 400 {
 					return detail, cisCertificateOrderDeleted, nil
@@ -226,5 +226,5 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
@@ -164,7 +164,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		opt.SetBundleMethod(v.(string))
 	}
 
-	result, response, err := cisClient.UploadCustomCertificate(opt)
+	result, response, err := cisClient.UploadCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Upload custom certificate failed: %v", response)
 		return err
@@ -182,7 +182,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		certsList = append(certsList, *certsItem)
 		priorityOpt := cisClient.NewChangeCertificatePriorityOptions()
 		priorityOpt.SetCertificates(certsList)
-		priorityResponse, err := cisClient.ChangeCertificatePriority(priorityOpt)
+		priorityResponse, err := cisClient.ChangeCertificatePriorityWithContext(flex.OperationContext(d), priorityOpt)
 		if err != nil {
 			log.Printf("Change certificate priority failed: %v", priorityResponse)
 			return err
@@ -205,7 +205,7 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetCustomCertificateOptions(certID)
-	result, response, err := cisClient.GetCustomCertificate(opt)
+	result, response, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Get custom certificate failed: %v", response)
 		return err
@@ -245,7 +245,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 		if v, ok := d.GetOk(cisCertificateUploadBundleMethod); ok {
 			opt.SetBundleMethod(v.(string))
 		}
-		_, response, err := cisClient.UpdateCustomCertificate(opt)
+		_, response, err := cisClient.UpdateCustomCertificateWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update custom certificate failed: %v", response)
 			return err
@@ -263,7 +263,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 			certsList = append(certsList, *certsItem)
 			priorityOpt := cisClient.NewChangeCertificatePriorityOptions()
 			priorityOpt.SetCertificates(certsList)
-			_, err := cisClient.ChangeCertificatePriority(priorityOpt)
+			_, err := cisClient.ChangeCertificatePriorityWithContext(flex.OperationContext(d), priorityOpt)
 			if err != nil {
 				log.Printf("Change certificate priority failed: %v", err)
 				return err
@@ -286,7 +286,7 @@ func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteCustomCertificateOptions(certID)
-	_, err = cisClient.DeleteCustomCertificate(opt)
+	_, err = cisClient.DeleteCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Delete custom certificate failed: %v", err)
 		return err
@@ -313,7 +313,7 @@ func resourceCISCertificateUploadExists(d *schema.ResourceData, meta interface{}
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetCustomCertificateOptions(certID)
-	_, detail, err := cisClient.GetCustomCertificate(opt)
+	_, detail, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if detail != nil && strings.Contains(err.Error(), "Invalid certificate") {
 			return false, nil
@@ -342,7 +342,7 @@ func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{})
 		Pending: []string{cisCertificateUploadDeletePending},
 		Target:  []string{cisCertificateUploadDeleted},
 		Refresh: func() (interface{}, string, error) {
-			_, detail, err := cisClient.GetCustomCertificate(opt)
+			_, detail, err := cisClient.GetCustomCertificateWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				if detail != nil && strings.Contains(err.Error(), "Invalid certificate") {
 					return detail, cisCertificateUploadDeleted, nil
@@ -357,5 +357,5 @@ func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
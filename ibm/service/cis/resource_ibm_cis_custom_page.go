@@ -136,7 +136,7 @@ func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error
 		opt.SetURL(url)
 		opt.SetState(state)
 
-		result, response, err := cisClient.UpdateZoneCustomPage(opt)
+		result, response, err := cisClient.UpdateZoneCustomPageWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update custom page failed : %v", response)
 			return err
@@ -157,7 +157,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewGetZoneCustomPageOptions(pageID)
 
-	result, response, err := cisClient.GetZoneCustomPage(opt)
+	result, response, err := cisClient.GetZoneCustomPageWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Custom Page has some error: %v", response)
@@ -410,7 +410,7 @@ func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 
 	}
 
-	result, response, err := sess.CreateDnsRecord(opt)
+	result, response, err := sess.CreateDnsRecordWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error creating dns record: %s, error %s", response, err)
 		return err
@@ -440,7 +440,7 @@ func ResourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := sess.NewGetDnsRecordOptions(recordID)
-	result, response, err := sess.GetDnsRecord(opt)
+	result, response, err := sess.GetDnsRecordWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -775,7 +775,7 @@ func ResourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 		}
 
-		result, response, err := sess.UpdateDnsRecord(opt)
+		result, response, err := sess.UpdateDnsRecordWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Error updating dns record: %s, error %s", response, err)
 			return err
@@ -806,7 +806,7 @@ func ResourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	delOpt := sess.NewDeleteDnsRecordOptions(recordID)
-	result, response, err := sess.DeleteDnsRecord(delOpt)
+	result, response, err := sess.DeleteDnsRecordWithContext(flex.OperationContext(d), delOpt)
 
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
 		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
@@ -834,7 +834,7 @@ func ResourceIBMCISDnsRecordExist(d *schema.ResourceData, meta interface{}) (boo
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := sess.NewGetDnsRecordOptions(recordID)
-	_, response, err := sess.GetDnsRecord(opt)
+	_, response, err := sess.GetDnsRecordWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("DNS record is not found")
@@ -79,7 +79,7 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 	}
 	opt := cisClient.NewPostDnsRecordsBulkOptions()
 	opt.SetFile(f)
-	result, response, err := cisClient.PostDnsRecordsBulk(opt)
+	result, response, err := cisClient.PostDnsRecordsBulkWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error importing dns records: %v", response)
 		return err
@@ -77,7 +77,7 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewCreateZoneOptions()
 	opt.SetName(zoneName)
-	result, resp, err := cisClient.CreateZone(opt)
+	result, resp, err := cisClient.CreateZoneWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("CreateZones Failed %s", resp)
 		return err
@@ -98,7 +98,7 @@ func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
-	result, resp, err := cisClient.GetZone(opt)
+	result, resp, err := cisClient.GetZoneWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
 		return err
@@ -127,7 +127,7 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 	log.Println("resource exist :", d.Id())
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
-	_, resp, err := cisClient.GetZone(opt)
+	_, resp, err := cisClient.GetZoneWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] zone is not found")
@@ -157,13 +157,13 @@ func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
-	_, resp, err := cisClient.GetZone(opt)
+	_, resp, err := cisClient.GetZoneWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
 		return err
 	}
 	delOpt := cisClient.NewDeleteZoneOptions(zoneID)
-	_, resp, err = cisClient.DeleteZone(delOpt)
+	_, resp, err = cisClient.DeleteZoneWithContext(flex.OperationContext(d), delOpt)
 	if err != nil {
 		log.Printf("[ERR] Error deleting zone %v\n", resp)
 		return err
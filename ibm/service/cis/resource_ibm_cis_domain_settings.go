@@ -606,7 +606,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateZoneDnssecOptions()
 					opt.SetStatus(v.(string))
-					_, resp, err = cisClient.UpdateZoneDnssec(opt)
+					_, resp, err = cisClient.UpdateZoneDnssecWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsWAF:
@@ -614,7 +614,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateWebApplicationFirewallOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateWebApplicationFirewall(opt)
+					_, resp, err = cisClient.UpdateWebApplicationFirewallWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsSSL:
@@ -628,7 +628,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 					opt := cisClient.NewChangeSslSettingOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.ChangeSslSetting(opt)
+					_, resp, err = cisClient.ChangeSslSettingWithContext(flex.OperationContext(d), opt)
 				}
 			}
 
@@ -637,7 +637,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateMinTlsVersionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateMinTlsVersion(opt)
+					_, resp, err = cisClient.UpdateMinTlsVersionWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsBrotli:
@@ -645,7 +645,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateBrotliOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateBrotli(opt)
+					_, resp, err = cisClient.UpdateBrotliWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsCNAMEFlattening:
@@ -653,7 +653,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateZoneCnameFlatteningOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateZoneCnameFlattening(opt)
+					_, resp, err = cisClient.UpdateZoneCnameFlatteningWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsOpportunisticEncryption:
@@ -661,7 +661,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateOpportunisticEncryptionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateOpportunisticEncryption(opt)
+					_, resp, err = cisClient.UpdateOpportunisticEncryptionWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsAutomaticHTPSRewrites:
@@ -669,7 +669,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateAutomaticHttpsRewritesOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateAutomaticHttpsRewrites(opt)
+					_, resp, err = cisClient.UpdateAutomaticHttpsRewritesWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsAlwaysUseHTTPS:
@@ -677,7 +677,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateAlwaysUseHttpsOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateAlwaysUseHttps(opt)
+					_, resp, err = cisClient.UpdateAlwaysUseHttpsWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsIPv6:
@@ -685,7 +685,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateIpv6Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateIpv6(opt)
+					_, resp, err = cisClient.UpdateIpv6WithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsBrowserCheck:
@@ -693,7 +693,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateBrowserCheckOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateBrowserCheck(opt)
+					_, resp, err = cisClient.UpdateBrowserCheckWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsHotlinkProtection:
@@ -701,7 +701,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateHotlinkProtectionOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateHotlinkProtection(opt)
+					_, resp, err = cisClient.UpdateHotlinkProtectionWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsHTTP2:
@@ -709,7 +709,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateHttp2Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateHttp2(opt)
+					_, resp, err = cisClient.UpdateHttp2WithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsImageLoadOptimization:
@@ -717,7 +717,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateImageLoadOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateImageLoadOptimization(opt)
+					_, resp, err = cisClient.UpdateImageLoadOptimizationWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsImageSizeOptimization:
@@ -725,7 +725,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateImageSizeOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateImageSizeOptimization(opt)
+					_, resp, err = cisClient.UpdateImageSizeOptimizationWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsIPGeoLocation:
@@ -733,7 +733,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateIpGeolocationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateIpGeolocation(opt)
+					_, resp, err = cisClient.UpdateIpGeolocationWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsOriginErrorPagePassThru:
@@ -741,7 +741,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateEnableErrorPagesOnOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateEnableErrorPagesOn(opt)
+					_, resp, err = cisClient.UpdateEnableErrorPagesOnWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsPseudoIPv4:
@@ -749,7 +749,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdatePseudoIpv4Options()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdatePseudoIpv4(opt)
+					_, resp, err = cisClient.UpdatePseudoIpv4WithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsPrefetchPreload:
@@ -757,7 +757,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdatePrefetchPreloadOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdatePrefetchPreload(opt)
+					_, resp, err = cisClient.UpdatePrefetchPreloadWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsResponseBuffering:
@@ -765,7 +765,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateResponseBufferingOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateResponseBuffering(opt)
+					_, resp, err = cisClient.UpdateResponseBufferingWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsScriptLoadOptimisation:
@@ -773,7 +773,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateScriptLoadOptimizationOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateScriptLoadOptimization(opt)
+					_, resp, err = cisClient.UpdateScriptLoadOptimizationWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsServerSideExclude:
@@ -781,7 +781,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateServerSideExcludeOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateServerSideExclude(opt)
+					_, resp, err = cisClient.UpdateServerSideExcludeWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsTLSClientAuth:
@@ -789,7 +789,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateTlsClientAuthOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateTlsClientAuth(opt)
+					_, resp, err = cisClient.UpdateTlsClientAuthWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsTrueClientIPHeader:
@@ -797,7 +797,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateTrueClientIpOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateTrueClientIp(opt)
+					_, resp, err = cisClient.UpdateTrueClientIpWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsWebSockets:
@@ -805,7 +805,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateWebSocketsOptions()
 					opt.SetValue(v.(string))
-					_, resp, err = cisClient.UpdateWebSockets(opt)
+					_, resp, err = cisClient.UpdateWebSocketsWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsChallengeTTL:
@@ -813,7 +813,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateChallengeTtlOptions()
 					opt.SetValue(int64(v.(int)))
-					_, resp, err = cisClient.UpdateChallengeTTL(opt)
+					_, resp, err = cisClient.UpdateChallengeTTLWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsMaxUpload:
@@ -821,7 +821,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					opt := cisClient.NewUpdateMaxUploadOptions()
 					opt.SetValue(int64(v.(int)))
-					_, resp, err = cisClient.UpdateMaxUpload(opt)
+					_, resp, err = cisClient.UpdateMaxUploadWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsCipher:
@@ -830,7 +830,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					cipherValue := flex.ExpandStringList(v.(*schema.Set).List())
 					opt := cisClient.NewUpdateCiphersOptions()
 					opt.SetValue(cipherValue)
-					_, resp, err = cisClient.UpdateCiphers(opt)
+					_, resp, err = cisClient.UpdateCiphersWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsMinify:
@@ -847,7 +847,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					opt := cisClient.NewUpdateMinifyOptions()
 					opt.SetValue(minifyVal)
-					_, resp, err = cisClient.UpdateMinify(opt)
+					_, resp, err = cisClient.UpdateMinifyWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsSecurityHeader:
@@ -871,7 +871,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					opt := cisClient.NewUpdateSecurityHeaderOptions()
 					opt.SetValue(securityOpt)
-					_, resp, err = cisClient.UpdateSecurityHeader(opt)
+					_, resp, err = cisClient.UpdateSecurityHeaderWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		case cisDomainSettingsMobileRedirect:
@@ -888,7 +888,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					opt := cisClient.NewUpdateMobileRedirectOptions()
 					opt.SetValue(mobileOpt)
-					_, resp, err = cisClient.UpdateMobileRedirect(opt)
+					_, resp, err = cisClient.UpdateMobileRedirectWithContext(flex.OperationContext(d), opt)
 				}
 			}
 		}
@@ -921,7 +921,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		switch item {
 		case cisDomainSettingsDNSSEC:
 			opt := cisClient.NewGetZoneDnssecOptions()
-			result, resp, err := cisClient.GetZoneDnssec(opt)
+			result, resp, err := cisClient.GetZoneDnssecWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsDNSSEC, result.Result.Status)
 			}
@@ -930,7 +930,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsWAF:
 			opt := cisClient.NewGetWebApplicationFirewallOptions()
-			result, resp, err := cisClient.GetWebApplicationFirewall(opt)
+			result, resp, err := cisClient.GetWebApplicationFirewallWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsWAF, result.Result.Value)
 			}
@@ -945,7 +945,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 			opt := cisClient.NewGetSslSettingOptions()
-			result, resp, err := cisClient.GetSslSetting(opt)
+			result, resp, err := cisClient.GetSslSettingWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsSSL, result.Result.Value)
 			}
@@ -954,7 +954,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsBrotli:
 			opt := cisClient.NewGetBrotliOptions()
-			result, resp, err := cisClient.GetBrotli(opt)
+			result, resp, err := cisClient.GetBrotliWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsBrotli, result.Result.Value)
 			}
@@ -962,7 +962,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMinTLSVersion:
 			opt := cisClient.NewGetMinTlsVersionOptions()
-			result, resp, err := cisClient.GetMinTlsVersion(opt)
+			result, resp, err := cisClient.GetMinTlsVersionWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsMinTLSVersion, result.Result.Value)
 			}
@@ -971,7 +971,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsCNAMEFlattening:
 			opt := cisClient.NewGetZoneCnameFlatteningOptions()
-			result, resp, err := cisClient.GetZoneCnameFlattening(opt)
+			result, resp, err := cisClient.GetZoneCnameFlatteningWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsCNAMEFlattening, result.Result.Value)
 			}
@@ -980,7 +980,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsOpportunisticEncryption:
 			opt := cisClient.NewGetOpportunisticEncryptionOptions()
-			result, resp, err := cisClient.GetOpportunisticEncryption(opt)
+			result, resp, err := cisClient.GetOpportunisticEncryptionWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsOpportunisticEncryption, result.Result.Value)
 			}
@@ -989,7 +989,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsAutomaticHTPSRewrites:
 			opt := cisClient.NewGetAutomaticHttpsRewritesOptions()
-			result, resp, err := cisClient.GetAutomaticHttpsRewrites(opt)
+			result, resp, err := cisClient.GetAutomaticHttpsRewritesWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsAutomaticHTPSRewrites, result.Result.Value)
 			}
@@ -998,7 +998,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsAlwaysUseHTTPS:
 			opt := cisClient.NewGetAlwaysUseHttpsOptions()
-			result, resp, err := cisClient.GetAlwaysUseHttps(opt)
+			result, resp, err := cisClient.GetAlwaysUseHttpsWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsAlwaysUseHTTPS, result.Result.Value)
 			}
@@ -1007,7 +1007,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsIPv6:
 			opt := cisClient.NewGetIpv6Options()
-			result, resp, err := cisClient.GetIpv6(opt)
+			result, resp, err := cisClient.GetIpv6WithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsIPv6, result.Result.Value)
 			}
@@ -1016,7 +1016,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsBrowserCheck:
 			opt := cisClient.NewGetBrowserCheckOptions()
-			result, resp, err := cisClient.GetBrowserCheck(opt)
+			result, resp, err := cisClient.GetBrowserCheckWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsBrowserCheck, result.Result.Value)
 			}
@@ -1025,7 +1025,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsHotlinkProtection:
 			opt := cisClient.NewGetHotlinkProtectionOptions()
-			result, resp, err := cisClient.GetHotlinkProtection(opt)
+			result, resp, err := cisClient.GetHotlinkProtectionWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsHotlinkProtection, result.Result.Value)
 			}
@@ -1034,7 +1034,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsHTTP2:
 			opt := cisClient.NewGetHttp2Options()
-			result, resp, err := cisClient.GetHttp2(opt)
+			result, resp, err := cisClient.GetHttp2WithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsHTTP2, result.Result.Value)
 			}
@@ -1043,7 +1043,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsImageLoadOptimization:
 			opt := cisClient.NewGetImageLoadOptimizationOptions()
-			result, resp, err := cisClient.GetImageLoadOptimization(opt)
+			result, resp, err := cisClient.GetImageLoadOptimizationWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsImageLoadOptimization, result.Result.Value)
 			}
@@ -1052,7 +1052,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsImageSizeOptimization:
 			opt := cisClient.NewGetImageSizeOptimizationOptions()
-			result, resp, err := cisClient.GetImageSizeOptimization(opt)
+			result, resp, err := cisClient.GetImageSizeOptimizationWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsImageSizeOptimization, result.Result.Value)
 			}
@@ -1061,7 +1061,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsIPGeoLocation:
 			opt := cisClient.NewGetIpGeolocationOptions()
-			result, resp, err := cisClient.GetIpGeolocation(opt)
+			result, resp, err := cisClient.GetIpGeolocationWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsIPGeoLocation, result.Result.Value)
 			}
@@ -1070,7 +1070,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsOriginErrorPagePassThru:
 			opt := cisClient.NewGetEnableErrorPagesOnOptions()
-			result, resp, err := cisClient.GetEnableErrorPagesOn(opt)
+			result, resp, err := cisClient.GetEnableErrorPagesOnWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsOriginErrorPagePassThru, result.Result.Value)
 			}
@@ -1079,7 +1079,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsPseudoIPv4:
 			opt := cisClient.NewGetPseudoIpv4Options()
-			result, resp, err := cisClient.GetPseudoIpv4(opt)
+			result, resp, err := cisClient.GetPseudoIpv4WithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsPseudoIPv4, result.Result.Value)
 			}
@@ -1088,7 +1088,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsPrefetchPreload:
 			opt := cisClient.NewGetPrefetchPreloadOptions()
-			result, resp, err := cisClient.GetPrefetchPreload(opt)
+			result, resp, err := cisClient.GetPrefetchPreloadWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsPrefetchPreload, result.Result.Value)
 			}
@@ -1097,7 +1097,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsResponseBuffering:
 			opt := cisClient.NewGetResponseBufferingOptions()
-			result, resp, err := cisClient.GetResponseBuffering(opt)
+			result, resp, err := cisClient.GetResponseBufferingWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsResponseBuffering, result.Result.Value)
 			}
@@ -1106,7 +1106,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsScriptLoadOptimisation:
 			opt := cisClient.NewGetScriptLoadOptimizationOptions()
-			result, resp, err := cisClient.GetScriptLoadOptimization(opt)
+			result, resp, err := cisClient.GetScriptLoadOptimizationWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsScriptLoadOptimisation, result.Result.Value)
 			}
@@ -1115,7 +1115,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsServerSideExclude:
 			opt := cisClient.NewGetServerSideExcludeOptions()
-			result, resp, err := cisClient.GetServerSideExclude(opt)
+			result, resp, err := cisClient.GetServerSideExcludeWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsServerSideExclude, result.Result.Value)
 			}
@@ -1124,7 +1124,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsTLSClientAuth:
 			opt := cisClient.NewGetTlsClientAuthOptions()
-			result, resp, err := cisClient.GetTlsClientAuth(opt)
+			result, resp, err := cisClient.GetTlsClientAuthWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsTLSClientAuth, result.Result.Value)
 			}
@@ -1133,7 +1133,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsTrueClientIPHeader:
 			opt := cisClient.NewGetTrueClientIpOptions()
-			result, resp, err := cisClient.GetTrueClientIp(opt)
+			result, resp, err := cisClient.GetTrueClientIpWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsTrueClientIPHeader, result.Result.Value)
 			}
@@ -1142,7 +1142,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsWebSockets:
 			opt := cisClient.NewGetWebSocketsOptions()
-			result, resp, err := cisClient.GetWebSockets(opt)
+			result, resp, err := cisClient.GetWebSocketsWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsWebSockets, result.Result.Value)
 			}
@@ -1151,7 +1151,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsChallengeTTL:
 			opt := cisClient.NewGetChallengeTtlOptions()
-			result, resp, err := cisClient.GetChallengeTTL(opt)
+			result, resp, err := cisClient.GetChallengeTTLWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsChallengeTTL, result.Result.Value)
 			}
@@ -1160,7 +1160,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMaxUpload:
 			opt := cisClient.NewGetMaxUploadOptions()
-			result, resp, err := cisClient.GetMaxUpload(opt)
+			result, resp, err := cisClient.GetMaxUploadWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsMaxUpload, result.Result.Value)
 			}
@@ -1169,7 +1169,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsCipher:
 			opt := cisClient.NewGetCiphersOptions()
-			result, resp, err := cisClient.GetCiphers(opt)
+			result, resp, err := cisClient.GetCiphersWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				d.Set(cisDomainSettingsCipher, result.Result.Value)
 			}
@@ -1178,7 +1178,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMinify:
 			opt := cisClient.NewGetMinifyOptions()
-			result, resp, err := cisClient.GetMinify(opt)
+			result, resp, err := cisClient.GetMinifyWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				minify := result.Result.Value
 				value := map[string]string{
@@ -1193,7 +1193,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsSecurityHeader:
 			opt := cisClient.NewGetSecurityHeaderOptions()
-			result, resp, err := cisClient.GetSecurityHeader(opt)
+			result, resp, err := cisClient.GetSecurityHeaderWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 
 				if result.Result.Value != nil && result.Result.Value.StrictTransportSecurity != nil {
@@ -1220,7 +1220,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 
 		case cisDomainSettingsMobileRedirect:
 			opt := cisClient.NewGetMobileRedirectOptions()
-			result, resp, err := cisClient.GetMobileRedirect(opt)
+			result, resp, err := cisClient.GetMobileRedirectWithContext(flex.OperationContext(d), opt)
 			if err == nil {
 				if result.Result.Value != nil {
 
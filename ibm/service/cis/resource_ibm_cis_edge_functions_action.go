@@ -73,7 +73,7 @@ func ResourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interf
 	opt := cisClient.NewUpdateEdgeFunctionsActionOptions(scriptName)
 	opt.SetEdgeFunctionsAction(r)
 
-	_, _, err = cisClient.UpdateEdgeFunctionsAction(opt)
+	_, _, err = cisClient.UpdateEdgeFunctionsActionWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error: %v", err)
 	}
@@ -100,7 +100,7 @@ func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
-	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
+	result, resp, err := cisClient.GetEdgeFunctionsActionWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error: %v", resp)
 	}
@@ -138,7 +138,7 @@ func ResourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
-	_, response, err := cisClient.GetEdgeFunctionsAction(opt)
+	_, response, err := cisClient.GetEdgeFunctionsActionWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Edge functions action script is not found")
@@ -160,7 +160,7 @@ func ResourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
-	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
+	_, response, err := cisClient.DeleteEdgeFunctionsActionWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in edge function action script deletion: %v", response)
 	}
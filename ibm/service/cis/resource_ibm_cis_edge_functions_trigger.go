@@ -81,7 +81,7 @@ func ResourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta inter
 	pattern := d.Get(cisEdgeFunctionsTriggerPattern).(string)
 	opt.SetPattern(pattern)
 
-	result, _, err := cisClient.CreateEdgeFunctionsTrigger(opt)
+	result, _, err := cisClient.CreateEdgeFunctionsTriggerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating edge function trigger route : %v", err)
 	}
@@ -109,7 +109,7 @@ func ResourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta inter
 		pattern := d.Get(cisEdgeFunctionsTriggerPattern).(string)
 		opt.SetPattern(pattern)
 
-		_, _, err := cisClient.UpdateEdgeFunctionsTrigger(opt)
+		_, _, err := cisClient.UpdateEdgeFunctionsTriggerWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating edge function trigger route : %v", err)
 		}
@@ -128,7 +128,7 @@ func ResourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
-	result, resp, err := cisClient.GetEdgeFunctionsTrigger(opt)
+	result, resp, err := cisClient.GetEdgeFunctionsTriggerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error: %v", resp)
 	}
@@ -152,7 +152,7 @@ func ResourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta inter
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
-	_, response, err := cisClient.GetEdgeFunctionsTrigger(opt)
+	_, response, err := cisClient.GetEdgeFunctionsTriggerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Edge functions trigger route is not found")
@@ -174,7 +174,7 @@ func ResourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta inter
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewDeleteEdgeFunctionsTriggerOptions(routeID)
-	_, response, err := cisClient.DeleteEdgeFunctionsTrigger(opt)
+	_, response, err := cisClient.DeleteEdgeFunctionsTriggerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in edge function trigger route deletion: %v", response)
 	}
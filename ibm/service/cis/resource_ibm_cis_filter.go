@@ -99,7 +99,7 @@ func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error
 
 	opt.SetFilterInput([]filtersv1.FilterInput{newfilter})
 
-	result, resp, err := cisClient.CreateFilter(opt)
+	result, resp, err := cisClient.CreateFilterWithContext(flex.OperationContext(d), opt)
 	if err != nil || result == nil {
 		return fmt.Errorf("[ERROR] Error creating Filter for zone %q: %s %s", zoneID, err, resp)
 	}
@@ -124,7 +124,7 @@ func ResourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	opt := cisClient.NewGetFilterOptions(xAuthtoken, crn, zoneID, filterid)
 
-	result, response, err := cisClient.GetFilter(opt)
+	result, response, err := cisClient.GetFilterWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Error GetFilter not found ")
@@ -184,7 +184,7 @@ func ResourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error
 
 		opt.SetFilterUpdateInput([]filtersv1.FilterUpdateInput{updatefilter})
 
-		result, resp, err := cisClient.UpdateFilters(opt)
+		result, resp, err := cisClient.UpdateFiltersWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Filter for zone %q: %s %s", zoneID, err, resp)
 		}
@@ -210,7 +210,7 @@ func ResourceIBMCISFilterDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	opt := cisClient.NewDeleteFiltersOptions(xAuthtoken, crn, zoneID, filterid)
-	_, _, err = cisClient.DeleteFilters(opt)
+	_, _, err = cisClient.DeleteFiltersWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Filter: %s", err)
 	}
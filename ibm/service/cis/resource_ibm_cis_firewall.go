@@ -58,6 +58,16 @@ const (
 	cisFirewallUARuleConfigurationValue            = "value"
 )
 
+// The targets and the modes of the rules of each firewall type take different values, their
+// validators are identified by the path of the argument
+const (
+	cisFirewallLockdownTargetValidator   = "lockdown.configurations.target"
+	cisFirewallAccessRuleTargetValidator = "access_rule.configuration.target"
+	cisFirewallAccessRuleModeValidator   = "access_rule.mode"
+	cisFirewallUARuleTargetValidator     = "ua_rule.configuration.target"
+	cisFirewallUARuleModeValidator       = "ua_rule.mode"
+)
+
 func ResourceIBMCISFirewallRecord() *schema.Resource {
 	return &schema.Resource{
 		Create:   ResourceIBMCISFirewallRecordCreate,
@@ -138,7 +148,7 @@ func ResourceIBMCISFirewallRecord() *schema.Resource {
 										Description: "Target type",
 										ValidateFunc: validate.InvokeValidator(
 											ibmCISFirewall,
-											cisFirewallLockdownConfigurationsTarget),
+											cisFirewallLockdownTargetValidator),
 									},
 									cisFirewallLockdownConfigurationsValue: {
 										Type:        schema.TypeString,
@@ -176,7 +186,7 @@ func ResourceIBMCISFirewallRecord() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							Description:  "Access rule mode",
-							ValidateFunc: validate.InvokeValidator(ibmCISFirewall, cisFirewallAccessRuleMode),
+							ValidateFunc: validate.InvokeValidator(ibmCISFirewall, cisFirewallAccessRuleModeValidator),
 						},
 						cisFirewallAccessRuleConfiguration: {
 							Type:     schema.TypeList,
@@ -191,7 +201,7 @@ func ResourceIBMCISFirewallRecord() *schema.Resource {
 										ForceNew:    true,
 										Description: "Target type",
 										ValidateFunc: validate.InvokeValidator(ibmCISFirewall,
-											cisFirewallAccessRuleConfigurationTarget),
+											cisFirewallAccessRuleTargetValidator),
 									},
 									cisFirewallUARuleConfigurationValue: {
 										Type:        schema.TypeString,
@@ -235,7 +245,7 @@ func ResourceIBMCISFirewallRecord() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							Description:  "user agent rule mode",
-							ValidateFunc: validate.InvokeValidator(ibmCISFirewall, cisFirewallUARuleMode),
+							ValidateFunc: validate.InvokeValidator(ibmCISFirewall, cisFirewallUARuleModeValidator),
 						},
 						cisFirewallUARuleConfiguration: {
 							Type:     schema.TypeList,
@@ -249,7 +259,7 @@ func ResourceIBMCISFirewallRecord() *schema.Resource {
 										Required:    true,
 										Description: "Target type",
 										ValidateFunc: validate.InvokeValidator(ibmCISFirewall,
-											cisFirewallUARuleConfigurationTarget),
+											cisFirewallUARuleTargetValidator),
 									},
 									cisFirewallUARuleConfigurationValue: {
 										Type:        schema.TypeString,
@@ -278,40 +288,40 @@ func ResourceIBMCISFirewallValidator() *validate.ResourceValidator {
 			AllowedValues:              firewallTypes})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 cisFirewallLockdownConfigurationsTarget,
+			Identifier:                 cisFirewallLockdownTargetValidator,
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "ip, ip_range"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 cisFirewallAccessRuleConfigurationTarget,
+			Identifier:                 cisFirewallAccessRuleTargetValidator,
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "ip, ip_range, asn, country"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 cisFirewallUARuleConfigurationTarget,
+			Identifier:                 cisFirewallUARuleTargetValidator,
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "ua"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 cisFirewallAccessRuleMode,
+			Identifier:                 cisFirewallAccessRuleModeValidator,
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "block, challenge, whitelist, js_challenge"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 cisFirewallUARuleMode,
+			Identifier:                 cisFirewallUARuleModeValidator,
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "block, challenge, js_challenge"})
-	cisFirewallValidator := validate.ResourceValidator{ResourceName: ibmCISHealthCheck, Schema: validateSchema}
+	cisFirewallValidator := validate.ResourceValidator{ResourceName: ibmCISFirewall, Schema: validateSchema}
 	return &cisFirewallValidator
 }
 
@@ -350,7 +360,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-		result, response, err := cisClient.CreateZoneLockdownRule(opt)
+		result, response, err := cisClient.CreateZoneLockdownRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Create zone firewall lockdown failed: %v", response)
 			return err
@@ -388,7 +398,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 			opt.SetNotes(v.(string))
 		}
 
-		result, response, err := cisClient.CreateZoneAccessRule(opt)
+		result, response, err := cisClient.CreateZoneAccessRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Create zone firewall access rule failed: %v", response)
 			return err
@@ -430,7 +440,7 @@ func ResourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		v := uaRule[cisFirewallUARulePaused]
 		opt.SetPaused(v.(bool))
 
-		result, response, err := cisClient.CreateZoneUserAgentRule(opt)
+		result, response, err := cisClient.CreateZoneUserAgentRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Create zone user agent rule failed: %v", response)
 			return err
@@ -456,7 +466,7 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 
 		opt := cisClient.NewGetLockdownOptions(lockdownID)
 
-		result, response, err := cisClient.GetLockdown(opt)
+		result, response, err := cisClient.GetLockdownWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
 			return err
@@ -489,7 +499,7 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 
 		opt := cisClient.NewGetZoneAccessRuleOptions(lockdownID)
 
-		result, response, err := cisClient.GetZoneAccessRule(opt)
+		result, response, err := cisClient.GetZoneAccessRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
 			return err
@@ -520,7 +530,7 @@ func ResourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
-		result, response, err := cisClient.GetUserAgentRule(opt)
+		result, response, err := cisClient.GetUserAgentRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Get zone user agent rule failed: %v", response)
 			return err
@@ -587,7 +597,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-			_, response, err := cisClient.UpdateLockdownRule(opt)
+			_, response, err := cisClient.UpdateLockdownRuleWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update zone firewall lockdown failed: %v", response)
 				return err
@@ -612,7 +622,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			}
 			opt.SetMode(mode)
 
-			_, response, err := cisClient.UpdateZoneAccessRule(opt)
+			_, response, err := cisClient.UpdateZoneAccessRuleWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update zone firewall access rule failed: %v", response)
 				return err
@@ -650,7 +660,7 @@ func ResourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			v := uaRule[cisFirewallUARulePaused]
 			opt.SetPaused(v.(bool))
 
-			_, response, err := cisClient.UpdateUserAgentRule(opt)
+			_, response, err := cisClient.UpdateUserAgentRuleWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update zone user agent rule failed: %v", response)
 				return err
@@ -676,7 +686,7 @@ func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 
 		opt := cisClient.NewDeleteZoneLockdownRuleOptions(lockdownID)
 
-		_, response, err := cisClient.DeleteZoneLockdownRule(opt)
+		_, response, err := cisClient.DeleteZoneLockdownRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Delete zone firewall lockdown failed: %v", response)
 			return err
@@ -694,7 +704,7 @@ func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 
 		opt := cisClient.NewDeleteZoneAccessRuleOptions(lockdownID)
 
-		_, response, err := cisClient.DeleteZoneAccessRule(opt)
+		_, response, err := cisClient.DeleteZoneAccessRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Delete zone firewall access rule failed: %v", response)
 			return err
@@ -710,7 +720,7 @@ func ResourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewDeleteZoneUserAgentRuleOptions(lockdownID)
-		_, response, err := cisClient.DeleteZoneUserAgentRule(opt)
+		_, response, err := cisClient.DeleteZoneUserAgentRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Delete zone user agent rule failed: %v", response)
 			return err
@@ -734,7 +744,7 @@ func ResourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 
 		opt := cisClient.NewGetLockdownOptions(lockdownID)
 
-		_, response, err := cisClient.GetLockdown(opt)
+		_, response, err := cisClient.GetLockdownWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				log.Printf("Zone Firewall Lockdown is not found")
@@ -756,7 +766,7 @@ func ResourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 
 		opt := cisClient.NewGetZoneAccessRuleOptions(lockdownID)
 
-		_, response, err := cisClient.GetZoneAccessRule(opt)
+		_, response, err := cisClient.GetZoneAccessRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				log.Printf("Zone Firewall Access Rule is not found")
@@ -776,7 +786,7 @@ func ResourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
-		_, response, err := cisClient.GetUserAgentRule(opt)
+		_, response, err := cisClient.GetUserAgentRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				log.Printf("Zone Firewall User Agent Rule does not found")
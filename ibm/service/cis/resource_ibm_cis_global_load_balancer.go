@@ -220,7 +220,7 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 		opt.SetPopPools(expandedPopPools)
 	}
 
-	result, resp, err := cisClient.CreateLoadBalancer(opt)
+	result, resp, err := cisClient.CreateLoadBalancerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Create GLB failed %s\n", resp)
 		return err
@@ -246,7 +246,7 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 
 	opt := cisClient.NewGetLoadBalancerSettingsOptions(glbID)
 
-	result, resp, err := cisClient.GetLoadBalancerSettings(opt)
+	result, resp, err := cisClient.GetLoadBalancerSettingsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] GLB Read failed: %v\n", resp)
 		return err
@@ -332,7 +332,7 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 			opt.SetPopPools(expandedPopPools)
 		}
 
-		_, resp, err := cisClient.EditLoadBalancer(opt)
+		_, resp, err := cisClient.EditLoadBalancerWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("[WARN] Error updating GLB %v\n", resp)
 			return err
@@ -356,7 +356,7 @@ func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteLoadBalancerOptions(glbID)
 
-	result, resp, err := cisClient.DeleteLoadBalancer(opt)
+	result, resp, err := cisClient.DeleteLoadBalancerWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Error deleting GLB %v\n", resp)
 		return err
@@ -379,7 +379,7 @@ func resourceCISGlbExists(d *schema.ResourceData, meta interface{}) (bool, error
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetLoadBalancerSettingsOptions(glbID)
 
-	_, response, err := cisClient.GetLoadBalancerSettings(opt)
+	_, response, err := cisClient.GetLoadBalancerSettingsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("global load balancer does not exist.")
@@ -272,7 +272,7 @@ func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) erro
 		opt.SetHeader(expandLoadBalancerMonitorHeader(header))
 	}
 
-	result, resp, err := sess.CreateLoadBalancerMonitor(opt)
+	result, resp, err := sess.CreateLoadBalancerMonitorWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("create global load balancer health check failed %s", resp)
 		return err
@@ -296,7 +296,7 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 
 	opt := sess.NewGetLoadBalancerMonitorOptions(monitorID)
 
-	result, resp, err := sess.GetLoadBalancerMonitor(opt)
+	result, resp, err := sess.GetLoadBalancerMonitorWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error reading global load balancer health check detail: %s", resp)
 		return err
@@ -389,7 +389,7 @@ func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 		if header, ok := d.GetOk(cisGLBHealthCheckHeaders); ok {
 			opt.SetHeader(expandLoadBalancerMonitorHeader(header))
 		}
-		result, resp, err := sess.EditLoadBalancerMonitor(opt)
+		result, resp, err := sess.EditLoadBalancerMonitorWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Error updating global load balancer health check detail: %s", resp)
 			return err
@@ -414,7 +414,7 @@ func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) erro
 
 	opt := sess.NewDeleteLoadBalancerMonitorOptions(monitorID)
 
-	result, resp, err := sess.DeleteLoadBalancerMonitor(opt)
+	result, resp, err := sess.DeleteLoadBalancerMonitorWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Error deleting global load balancer health check detail: %s", resp)
 		return err
@@ -437,7 +437,7 @@ func resourceCISHealthCheckExists(d *schema.ResourceData, meta interface{}) (boo
 
 	opt := sess.NewGetLoadBalancerMonitorOptions(monitorID)
 
-	result, response, err := sess.GetLoadBalancerMonitor(opt)
+	result, response, err := sess.GetLoadBalancerMonitorWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("global load balancer health check does not exist.")
@@ -207,7 +207,7 @@ func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		opt.SetDescription(description.(string))
 	}
 
-	result, resp, err := cisClient.CreateLoadBalancerPool(opt)
+	result, resp, err := cisClient.CreateLoadBalancerPoolWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
 		return err
@@ -228,7 +228,7 @@ func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
-	result, resp, err := cisClient.GetLoadBalancerPool(opt)
+	result, resp, err := cisClient.GetLoadBalancerPoolWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
 		return err
@@ -317,7 +317,7 @@ func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		if description, ok := d.GetOk(cisGLBPoolDesc); ok {
 			opt.SetDescription(description.(string))
 		}
-		_, resp, err := cisClient.EditLoadBalancerPool(opt)
+		_, resp, err := cisClient.EditLoadBalancerPoolWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("[WARN] Error getting zone during PoolUpdate %v\n", resp)
 			return err
@@ -337,7 +337,7 @@ func resourceCISPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewDeleteLoadBalancerPoolOptions(poolID)
-	result, resp, err := cisClient.DeleteLoadBalancerPool(opt)
+	result, resp, err := cisClient.DeleteLoadBalancerPoolWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("[WARN] Delete GLB Pools failed %s\n", resp)
 		return err
@@ -357,7 +357,7 @@ func resourceCISPoolExists(d *schema.ResourceData, meta interface{}) (bool, erro
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
-	result, response, err := cisClient.GetLoadBalancerPool(opt)
+	result, response, err := cisClient.GetLoadBalancerPoolWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("global load balancer pool does not exist.")
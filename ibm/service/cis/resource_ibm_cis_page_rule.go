@@ -219,7 +219,7 @@ func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
 		opt.SetStatus(value.(string))
 	}
 
-	result, response, err := cisClient.CreatePageRule(opt)
+	result, response, err := cisClient.CreatePageRuleWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Create page rule failed: %v", response)
 		return err
@@ -241,7 +241,7 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetPageRuleOptions(ruleID)
-	result, response, err := cisClient.GetPageRule(opt)
+	result, response, err := cisClient.GetPageRuleWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Get page rule failed: %v", response)
 		return err
@@ -283,7 +283,7 @@ func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 			opt.SetStatus(value.(string))
 		}
 
-		_, response, err := cisClient.UpdatePageRule(opt)
+		_, response, err := cisClient.UpdatePageRuleWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update page rule failed: %v", response)
 			return err
@@ -302,7 +302,7 @@ func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewDeletePageRuleOptions(ruleID)
-	_, response, err := cisClient.DeletePageRule(opt)
+	_, response, err := cisClient.DeletePageRuleWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Delete page rule failed: %v", response)
 		return err
@@ -321,7 +321,7 @@ func resourceCISPageRuleExists(d *schema.ResourceData, meta interface{}) (bool,
 	cisClient.ZoneID = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetPageRuleOptions(ruleID)
-	_, response, err := cisClient.GetPageRule(opt)
+	_, response, err := cisClient.GetPageRuleWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Page rule does not exist.")
@@ -119,7 +119,7 @@ func ResourceIBMCISRangeApp() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "Allows for the true client IP to be passed to the service.",
-				ValidateFunc: validate.InvokeValidator(ibmCISRangeApp, cisRangeAppProtocol),
+				ValidateFunc: validate.InvokeValidator(ibmCISRangeApp, cisRangeAppProxyProtocol),
 			},
 			cisRangeAppEdgeIPsType: {
 				Type:         schema.TypeString,
@@ -265,7 +265,7 @@ func ResourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) erro
 		opt.SetTls(v.(string))
 	}
 
-	result, resp, err := cisClient.CreateRangeApp(opt)
+	result, resp, err := cisClient.CreateRangeAppWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to create range application: %v", resp)
 	}
@@ -284,7 +284,7 @@ func ResourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
-	result, resp, err := cisClient.GetRangeApp(opt)
+	result, resp, err := cisClient.GetRangeAppWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to read range application: %v", resp)
 	}
@@ -370,7 +370,7 @@ func ResourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		if v, ok := d.GetOk(cisRangeAppTLS); ok {
 			opt.SetTls(v.(string))
 		}
-		_, resp, err := cisClient.UpdateRangeApp(opt)
+		_, resp, err := cisClient.UpdateRangeAppWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Failed to update range application: %v", resp)
 		}
@@ -388,7 +388,7 @@ func ResourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) erro
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteRangeAppOptions(rangeAppID)
-	_, resp, err := cisClient.DeleteRangeApp(opt)
+	_, resp, err := cisClient.DeleteRangeAppWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to delete range application: %v", resp)
 	}
@@ -404,7 +404,7 @@ func ResourceIBMCISRangeAppExists(d *schema.ResourceData, meta interface{}) (boo
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
-	_, resp, err := cisClient.GetRangeApp(opt)
+	_, resp, err := cisClient.GetRangeAppWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Println("range application is not found")
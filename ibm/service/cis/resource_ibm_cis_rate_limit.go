@@ -397,7 +397,7 @@ func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 	opt.SetBypass(byPass)
 
 	//creating rate limit rule
-	result, resp, err := cisClient.CreateZoneRateLimits(opt)
+	result, resp, err := cisClient.CreateZoneRateLimitsWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to create RateLimit: %v", resp)
 	}
@@ -418,7 +418,7 @@ func ResourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRateLimitOptions(recordID)
-	result, resp, err := cisClient.GetRateLimit(opt)
+	result, resp, err := cisClient.GetRateLimitWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to read RateLimit: %v", resp)
 	}
@@ -492,7 +492,7 @@ func ResourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("[ERROR] Error in getting bypass from expandRateLimitBypass %s", err)
 		}
 		opt.SetBypass(byPass)
-		_, resp, err := cisClient.UpdateRateLimit(opt)
+		_, resp, err := cisClient.UpdateRateLimitWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Failed to update RateLimit: %v", resp)
 		}
@@ -511,7 +511,7 @@ func ResourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) err
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewDeleteZoneRateLimitOptions(recordID)
-	_, resp, err := cisClient.DeleteZoneRateLimit(opt)
+	_, resp, err := cisClient.DeleteZoneRateLimitWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to delete RateLimit: %v", resp)
 	}
@@ -527,7 +527,7 @@ func ResourceIBMCISRateLimitExists(d *schema.ResourceData, meta interface{}) (bo
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRateLimitOptions(recordID)
-	_, resp, err := cisClient.GetRateLimit(opt)
+	_, resp, err := cisClient.GetRateLimitWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Println("ratelimit is not found")
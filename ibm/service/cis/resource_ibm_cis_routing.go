@@ -79,7 +79,7 @@ func ResourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error
 		smartRoutingValue := d.Get(cisRoutingSmartRouting).(string)
 		opt := cisClient.NewUpdateSmartRoutingOptions()
 		opt.SetValue(smartRoutingValue)
-		_, response, err := cisClient.UpdateSmartRouting(opt)
+		_, response, err := cisClient.UpdateSmartRoutingWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update smart route setting failed: %v", response)
 			return err
@@ -99,7 +99,7 @@ func ResourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetSmartRoutingOptions()
-	result, response, err := cisClient.GetSmartRouting(opt)
+	result, response, err := cisClient.GetSmartRoutingWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Get smart route setting failed: %v", response)
 		return err
@@ -4,6 +4,7 @@
 package cis_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -13,10 +14,12 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -32,6 +35,34 @@ const (
 	cisInstanceReclamation        = "pending_reclamation"
 )
 
+// The provider tags are read back in tags, the plan must suppress their removal
+func TestResourceIBMCisDefaultTagsCustomizeDiff(t *testing.T) {
+	t.Setenv("IC_ENV_TAGS", "env:dev")
+	state := map[string]string{"id": "crn:v1:cis:1", "name": "cis", "plan": "standard", "location": "global"}
+	for _, key := range []string{"tags", flex.TagsAll} {
+		state[key+".#"] = "2"
+	}
+	for _, tag := range []string{"team", "env:dev"} {
+		state[fmt.Sprintf("tags.%d", schema.HashString(tag))] = tag
+		state[fmt.Sprintf("%s.%d", flex.TagsAll, flex.ResourceIBMVPCHash(tag))] = tag
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "cis", "plan": "standard", "location": "global", "tags": []interface{}{"team"},
+	})
+
+	d, err := cis.ResourceIBMCISInstance().Diff(context.Background(), &terraform.InstanceState{ID: "crn:v1:cis:1", Attributes: state}, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d != nil {
+		for k := range d.Attributes {
+			if strings.HasPrefix(k, "tags") {
+				t.Errorf("expected the removal of the provider tags to be suppressed, got a diff of %s", k)
+			}
+		}
+	}
+}
+
 func TestAccIBMCisInstance_Basic(t *testing.T) {
 	t.Skip()
 	var cisInstanceOne string
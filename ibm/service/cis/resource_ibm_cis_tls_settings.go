@@ -107,7 +107,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if tls13, ok := d.GetOk(cisTLSSettingsTLS13); ok {
 			opt := cisClient.NewChangeTls13SettingOptions()
 			opt.SetValue(tls13.(string))
-			_, resp, err := cisClient.ChangeTls13Setting(opt)
+			_, resp, err := cisClient.ChangeTls13SettingWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update TLS 1.3 setting Failed : %v\n", resp)
 				return err
@@ -118,7 +118,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if universalSSL, ok := d.GetOkExists(cisTLSSettingsUniversalSSL); ok {
 			opt := cisClient.NewChangeUniversalCertificateSettingOptions()
 			opt.SetEnabled(universalSSL.(bool))
-			resp, err := cisClient.ChangeUniversalCertificateSetting(opt)
+			resp, err := cisClient.ChangeUniversalCertificateSettingWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update universal ssl setting Failed : %v\n", resp)
 				return err
@@ -135,7 +135,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 			opt := cisClient.NewUpdateMinTlsVersionOptions()
 			opt.SetValue(minTLSVer.(string))
-			_, resp, err := cisClient.UpdateMinTlsVersion(opt)
+			_, resp, err := cisClient.UpdateMinTlsVersionWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				log.Printf("Update minimum TLS version setting Failed : %v\n", resp)
 				return err
@@ -156,14 +156,14 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
 	// TLS 1.3 setting
-	tls13Result, resp, err := cisClient.GetTls13Setting(cisClient.NewGetTls13SettingOptions())
+	tls13Result, resp, err := cisClient.GetTls13SettingWithContext(flex.OperationContext(d), cisClient.NewGetTls13SettingOptions())
 	if err != nil {
 		log.Printf("Get TLS 1.3 setting failed : %v\n", resp)
 		return err
 	}
 
 	// Universal SSL setting
-	universalSSLResult, resp, err := cisClient.GetUniversalCertificateSetting(
+	universalSSLResult, resp, err := cisClient.GetUniversalCertificateSettingWithContext(flex.OperationContext(d),
 		cisClient.NewGetUniversalCertificateSettingOptions())
 	if err != nil {
 		log.Printf("Update TLS 1.3 setting failed : %v\n", resp)
@@ -177,7 +177,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	}
 	minTLSClient.Crn = core.StringPtr(crn)
 	minTLSClient.ZoneIdentifier = core.StringPtr(zoneID)
-	minTLSVerResult, resp, err := minTLSClient.GetMinTlsVersion(
+	minTLSVerResult, resp, err := minTLSClient.GetMinTlsVersionWithContext(flex.OperationContext(d),
 		minTLSClient.NewGetMinTlsVersionOptions())
 	if err != nil {
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
@@ -119,7 +119,7 @@ func ResourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 		mode := d.Get(cisWAFGroupMode).(string)
 		opt := cisClient.NewUpdateWafRuleGroupOptions(packageID, groupID)
 		opt.SetMode(mode)
-		_, response, err := cisClient.UpdateWafRuleGroup(opt)
+		_, response, err := cisClient.UpdateWafRuleGroupWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update waf rule group mode failed: %v", response)
 			return err
@@ -138,7 +138,7 @@ func ResourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafRuleGroupOptions(packageID, groupID)
-	result, response, err := cisClient.GetWafRuleGroup(opt)
+	result, response, err := cisClient.GetWafRuleGroupWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF group is not found!")
@@ -127,7 +127,7 @@ func ResourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) er
 		if v, ok := d.GetOk(cisWAFPackageActionMode); ok {
 			opt.SetActionMode(v.(string))
 		}
-		result, response, err := cisClient.UpdateWafPackage(opt)
+		result, response, err := cisClient.UpdateWafPackageWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update waf package setting failed: %v", response)
 			return err
@@ -147,7 +147,7 @@ func ResourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafPackageOptions(packageID)
-	result, response, err := cisClient.GetWafPackage(opt)
+	result, response, err := cisClient.GetWafPackageWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF package is not found!")
@@ -139,7 +139,7 @@ func ResourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		mode := d.Get(cisWAFRuleMode).(string)
 
 		getOpt := cisClient.NewGetWafRuleOptions(packageID, ruleID)
-		getResult, getResponse, err := cisClient.GetWafRule(getOpt)
+		getResult, getResponse, err := cisClient.GetWafRuleWithContext(flex.OperationContext(d), getOpt)
 		if err != nil {
 			log.Printf("Get WAF rule setting failed: %v", getResponse)
 			return err
@@ -159,7 +159,7 @@ func ResourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 			updateOpt.SetCis(cisOpt)
 
 		}
-		_, response, err := cisClient.UpdateWafRule(updateOpt)
+		_, response, err := cisClient.UpdateWafRuleWithContext(flex.OperationContext(d), updateOpt)
 		if err != nil {
 			log.Printf("Update WAF rule setting failed: %v", response)
 			return err
@@ -179,7 +179,7 @@ func ResourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
 	opt := cisClient.NewGetWafRuleOptions(packageID, ruleID)
-	result, response, err := cisClient.GetWafRule(opt)
+	result, response, err := cisClient.GetWafRuleWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("WAF Rule is not found!")
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -825,10 +826,10 @@ func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
-	return deleteHardware(d, meta)
+	return deleteHardware(flex.OperationContext(d), d, meta)
 }
 
-func deleteHardware(d dataRetriever, meta interface{}) error {
+func deleteHardware(ctx context.Context, d dataRetriever, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetHardwareService(sess)
 	id, err := strconv.Atoi(d.Id())
@@ -836,7 +837,7 @@ func deleteHardware(d dataRetriever, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
 	}
 
-	_, err = waitForNoBareMetalActiveTransactions(id, meta)
+	_, err = waitForNoBareMetalActiveTransactions(ctx, id, meta)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting bare metal server while waiting for zero active transactions: %s", err)
 	}
@@ -925,10 +926,10 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
-func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}, error) {
+func waitForNoBareMetalActiveTransactions(ctx context.Context, id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%d) to have zero active transactions", id)
 	service := services.GetHardwareServerService(meta.(conns.ClientSession).SoftLayerSession())
 
@@ -953,7 +954,7 @@ func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func setHardwareTags(id int, d dataRetriever, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -230,7 +232,7 @@ func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interfa
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 	if err != nil {
 		return err
 	}
@@ -217,7 +217,7 @@ func findReservedCapacityByOrderID(name string, r *schema.ResourceData, meta int
 		MinTimeout: 1 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(r), stateConf)
 }
 
 func resourceIBMComputeReservedCapacityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1766,7 +1766,7 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
@@ -1794,7 +1794,7 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
@@ -1810,7 +1810,7 @@ func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *schema.ResourceData) resource.StateRefreshFunc {
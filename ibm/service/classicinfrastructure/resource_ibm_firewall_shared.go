@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -156,7 +158,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 		if err != nil {
 			return err
 		}
@@ -216,7 +218,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 		if err != nil {
 			return err
 		}
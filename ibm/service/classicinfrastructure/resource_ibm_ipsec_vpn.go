@@ -173,7 +173,7 @@ func ResourceIBMIPSecVPN() *schema.Resource {
 						},
 						"remote_ip_cidr": {
 							Type:         schema.TypeString,
-							ValidateFunc: validate.ValidateCIDR,
+							ValidateFunc: validate.InvokeValidator("ibm_ipsec_vpn", "remote_subnet.remote_ip_cidr"),
 							Required:     true,
 						},
 						"account_id": {
@@ -196,6 +196,19 @@ const (
 	ipsecMask = "billingItem.orderItem.order.id,serviceSubnets,staticRouteSubnets"
 )
 
+func ResourceIBMIPSecVPNValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "remote_subnet.remote_ip_cidr",
+			ValidateFunctionIdentifier: validate.ValidateCIDRAddress,
+			Type:                       validate.TypeString,
+			Required:                   true})
+
+	ibmIPSecVPNValidator := validate.ResourceValidator{ResourceName: "ibm_ipsec_vpn", Schema: validateSchema}
+	return &ibmIPSecVPNValidator
+}
+
 func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	datacenter := d.Get("datacenter").(string)
@@ -278,7 +291,7 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return datatypes.Network_Tunnel_Module_Context{}, err
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -445,7 +447,7 @@ func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated boo
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, err
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -12,6 +13,8 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -136,7 +139,7 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Creating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(flex.OperationContext(d), sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating load balancer service: %s", err)
@@ -223,7 +226,7 @@ func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Updating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(flex.OperationContext(d), sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error updating load balancer service: %s", err)
@@ -293,7 +296,7 @@ func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting service: %s", err)
@@ -342,7 +345,7 @@ func getHealthCheckTypeId(sess *session.Session, healthCheckTypeName string) (in
 	return *healthCheckTypes[0].Id, nil
 }
 
-func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) error {
+func updateLoadBalancerService(ctx context.Context, sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -371,7 +374,7 @@ func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := flex.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -121,7 +123,7 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(flex.OperationContext(d), sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err)
@@ -187,7 +189,7 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(flex.OperationContext(d), sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating load balancer service group: %s", err)
@@ -259,7 +261,7 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting service: %s", err)
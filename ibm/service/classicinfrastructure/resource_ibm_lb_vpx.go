@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -13,6 +14,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -274,7 +277,7 @@ func findVPXPriceItems(version string, speed int, plan string, ipCount int, meta
 	}, nil
 }
 
-func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
+func findVPXByOrderId(ctx context.Context, orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
 	service := services.GetAccountService(meta.(conns.ClientSession).SoftLayerSession())
 
 	stateConf := &resource.StateChangeConf{
@@ -304,7 +307,7 @@ func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Applicat
 		MinTimeout: 10 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller{}, err
@@ -411,7 +414,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Wait VPX provisioning
-	VPX, err := findVPXByOrderId(*receipt.OrderId, meta)
+	VPX, err := findVPXByOrderId(flex.OperationContext(d), *receipt.OrderId, meta)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating network application delivery controller: %s", err)
@@ -127,7 +127,7 @@ func ResourceIBMLbaas() *schema.Resource {
 							Type:         schema.TypeInt,
 							Required:     true,
 							Description:  "Frontend Protocol port number. Should be in range (1, 65535)",
-							ValidateFunc: validate.ValidatePortRange(1, 65535),
+							ValidateFunc: validate.InvokeValidator("ibm_lbaas", "protocols.frontend_port"),
 						},
 						"backend_protocol": {
 							Type:         schema.TypeString,
@@ -139,7 +139,7 @@ func ResourceIBMLbaas() *schema.Resource {
 							Type:         schema.TypeInt,
 							Required:     true,
 							Description:  "Backend Protocol port number. Should be in range (1, 65535)",
-							ValidateFunc: validate.ValidatePortRange(1, 65535),
+							ValidateFunc: validate.InvokeValidator("ibm_lbaas", "protocols.backend_port"),
 						},
 						"load_balancing_method": {
 							Type:         schema.TypeString,
@@ -243,6 +243,29 @@ func ResourceIBMLbaas() *schema.Resource {
 	}
 }
 
+func ResourceIBMLbaasValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "protocols.frontend_port",
+			ValidateFunctionIdentifier: validate.PortBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "protocols.backend_port",
+			ValidateFunctionIdentifier: validate.PortBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+
+	ibmLbaasValidator := validate.ResourceValidator{ResourceName: "ibm_lbaas", Schema: validateSchema}
+	return &ibmLbaasValidator
+}
+
 func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
@@ -534,7 +557,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		NotFoundChecks: 40,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return nil, err
@@ -575,7 +598,7 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -601,7 +624,7 @@ func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMLBProtocolHash(v interface{}) int {
@@ -34,7 +34,7 @@ func ResourceIBMLbaasHealthMonitor() *schema.Resource {
 			"port": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validate.ValidatePortRange(1, 65535),
+				ValidateFunc: validate.InvokeValidator("ibm_lbaas_health_monitor", "port"),
 				Description:  "Port number",
 			},
 			"interval": {
@@ -81,6 +81,21 @@ func ResourceIBMLbaasHealthMonitor() *schema.Resource {
 	}
 }
 
+func ResourceIBMLbaasHealthMonitorValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "port",
+			ValidateFunctionIdentifier: validate.PortBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+
+	ibmLbaasHealthMonitorValidator := validate.ResourceValidator{ResourceName: "ibm_lbaas_health_monitor", Schema: validateSchema}
+	return &ibmLbaasHealthMonitorValidator
+}
+
 func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	healthMonitorService := services.GetNetworkLBaaSHealthMonitorService(sess.SetRetries(0))
@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -506,7 +507,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	gID := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[0].GlobalIdentifier
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(flex.OperationContext(d), &order.Hardware[0], meta, gID)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
 	}
@@ -527,7 +528,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	if sameOrder {
 		// If we ordered HA and then wait for other member
 		gID1 := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[1].GlobalIdentifier
-		bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[1], meta, gID1)
+		bm, err := waitForNetworkGatewayMemberProvision(flex.OperationContext(d), &order.Hardware[1], meta, gID1)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
 		}
@@ -540,7 +541,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	} else if len(members) == 2 {
 		//Add the new gateway which has different configuration than the first
-		err := addGatewayMember(id, members[1], meta)
+		err := addGatewayMember(flex.OperationContext(d), id, members[1], meta)
 		if err != nil {
 			return err
 		}
@@ -634,7 +635,7 @@ func updateGatewayName(id int, name string, meta interface{}) error {
 	return err
 }
 
-func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
+func addGatewayMember(ctx context.Context, gwID int, member gatewayMember, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	order, err := getMonthlyGatewayOrder(member, meta)
 	if err != nil {
@@ -676,7 +677,7 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 
 	gID := *orderReceipt.OrderDetails.Hardware[0].GlobalIdentifier
 
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(ctx, &order.Hardware[0], meta, gID)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for Gateway (%d) to become ready: %s", gwID, err)
 	}
@@ -711,7 +712,7 @@ func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		m := gatewayMember{
 			"member_id": *v.HardwareId,
 		}
-		err := deleteHardware(m, meta)
+		err := deleteHardware(flex.OperationContext(d), m, meta)
 		if err != nil {
 			return err
 		}
@@ -1001,7 +1002,7 @@ func setHardwareOptions(m gatewayMember, hardware *datatypes.Hardware) error {
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForNetworkGatewayMemberProvision(ctx context.Context, d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
 	hostname := *d.Hostname
 	domain := *d.Domain
 	log.Printf("Waiting for Gateway (%s.%s) to be provisioned", hostname, domain)
@@ -1031,7 +1032,7 @@ func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func setTagsAndNotes(m gatewayMember, meta interface{}) error {
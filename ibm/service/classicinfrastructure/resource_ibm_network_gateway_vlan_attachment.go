@@ -4,12 +4,15 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -76,7 +79,7 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 							return err
 						}
 					}
-					_, err = waitForNetworkGatewayActiveState(*i.NetworkGatewayId, meta)
+					_, err = waitForNetworkGatewayActiveState(flex.OperationContext(d), *i.NetworkGatewayId, meta)
 					if err != nil {
 						return err
 					}
@@ -105,7 +108,7 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 		return err
 	}
 	d.SetId(fmt.Sprintf("%d", *resp.Id))
-	_, err = waitForNetworkGatewayActiveState(gatewayID, meta)
+	_, err = waitForNetworkGatewayActiveState(flex.OperationContext(d), gatewayID, meta)
 	if err != nil {
 		return err
 	}
@@ -151,7 +154,7 @@ func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta
 			}
 		}
 		vlan, err := service.Id(id).GetObject()
-		_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+		_, err = waitForNetworkGatewayActiveState(flex.OperationContext(d), *vlan.NetworkGatewayId, meta)
 		if err != nil {
 			return err
 		}
@@ -188,7 +191,7 @@ func resourceIBMNetworkGatewayVlanAttachmentDelete(d *schema.ResourceData, meta
 		return err
 	}
 
-	_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+	_, err = waitForNetworkGatewayActiveState(flex.OperationContext(d), *vlan.NetworkGatewayId, meta)
 	if err != nil {
 		return err
 	}
@@ -220,7 +223,7 @@ func resourceIBMNetworkGatewayVlanDissociate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, error) {
+func waitForNetworkGatewayActiveState(ctx context.Context, id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for Gateway (%d) to be active", id)
 	service := services.GetNetworkGatewayService(meta.(conns.ClientSession).SoftLayerSession())
 
@@ -247,5 +250,5 @@ func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, er
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
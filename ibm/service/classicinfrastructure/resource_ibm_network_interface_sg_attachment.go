@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -53,8 +54,11 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 
 func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -62,7 +66,7 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 
 	sgID := d.Get("security_group_id").(int)
 	interfaceID := d.Get("network_interface_id").(int)
-	_, err := WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err = WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -102,7 +106,7 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 			Timeout: d.Timeout(schema.TimeoutCreate),
 			Refresh: securityGroupReadyRefreshStateFunc(sess, interfaceID),
 		}
-		_, err = stateConf.WaitForState()
+		_, err = flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 		if err != nil {
 			return err
 		}
@@ -132,8 +136,11 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
@@ -217,7 +224,7 @@ func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateRefreshFunc {
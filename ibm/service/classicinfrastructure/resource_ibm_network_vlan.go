@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -181,7 +182,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error during creation of vlan: %s", err)
 	}
 
-	vlan, err := findVlanByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	vlan, err := findVlanByOrderId(flex.OperationContext(d), sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error finding VLAN order %d: %s", *receipt.OrderId, err)
 	}
@@ -358,7 +359,7 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 	if err != nil {
 		return err
 	}
@@ -405,7 +406,7 @@ func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (boo
 	return result.Id != nil && *result.Id == vlanID, nil
 }
 
-func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
+func findVlanByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -433,7 +434,7 @@ func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, err
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -75,7 +78,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 		}
 
 		// Wait for the object storage account order to complete.
-		billingOrderItem, err := WaitForOrderCompletion(&receipt, meta)
+		billingOrderItem, err := WaitForOrderCompletion(flex.OperationContext(d), &receipt, meta)
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err)
@@ -101,7 +104,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func WaitForOrderCompletion(
+func WaitForOrderCompletion(ctx context.Context,
 	receipt *datatypes.Container_Product_Order_Receipt, meta interface{}) (datatypes.Billing_Order_Item, error) {
 
 	log.Printf("Waiting for billing order %d to have zero active transactions", receipt.OrderId)
@@ -130,7 +133,7 @@ func WaitForOrderCompletion(
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := flex.WaitForStateContext(ctx, stateConf)
 	return *billingOrderItem, err
 }
 
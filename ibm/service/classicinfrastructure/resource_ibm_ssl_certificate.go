@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	fmt "fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -473,7 +476,7 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 			return fmt.Errorf("[ERROR] Error during creation of ssl: %s", err)
 		}
 
-		ssl, err := findSSLByOrderId(sess, *receipt.OrderId)
+		ssl, err := findSSLByOrderId(flex.OperationContext(d), sess, *receipt.OrderId)
 		d.SetId(fmt.Sprintf("%d", *ssl.Id))
 		return resourceIBMSSLCertificateRead(d, m)
 	} else {
@@ -815,7 +818,7 @@ func buildSSLProductOrderContainer(d *schema.ResourceData, sess *session1.Sessio
 	return &sslContainer, nil
 }
 
-func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
+func findSSLByOrderId(ctx context.Context, sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -838,7 +841,7 @@ func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_C
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Security_Certificate_Request{}, err
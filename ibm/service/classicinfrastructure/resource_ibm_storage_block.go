@@ -304,7 +304,7 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Find the storage device
-	blockStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err := findStorageByOrderId(flex.OperationContext(d), sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
@@ -319,7 +319,7 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	blockStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err = findStorageByOrderId(flex.OperationContext(d), sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -349,7 +351,7 @@ func findEvaultStorageByOrderID(d *schema.ResourceData, meta interface{}, orderI
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 
 	if err != nil {
 		return datatypes.Network_Storage{}, err
@@ -399,7 +401,7 @@ func WaitForEvaultAvailable(d *schema.ResourceData, meta interface{}, timeout st
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMStorageEvaultExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -306,7 +307,7 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// Find the storage device
-	fileStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err := findStorageByOrderId(flex.OperationContext(d), sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
@@ -321,7 +322,7 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	fileStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err = findStorageByOrderId(flex.OperationContext(d), sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
@@ -699,7 +700,7 @@ func buildStorageProductOrderContainer(
 	return productOrderContainer, nil
 }
 
-func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
+func findStorageByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
 	filterPath := "networkStorage.billingItem.orderItem.order.id"
 
 	stateConf := &resource.StateChangeConf{
@@ -730,7 +731,7 @@ func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Durat
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := flex.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Storage{}, err
@@ -799,7 +800,7 @@ func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func getIops(storage datatypes.Network_Storage, storageType string) (float64, error) {
@@ -1556,5 +1557,5 @@ func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
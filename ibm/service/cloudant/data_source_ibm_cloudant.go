@@ -4,9 +4,11 @@
 package cloudant
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -142,7 +144,7 @@ func dataSourceIBMCloudantRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func setCloudantServerInformation(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	serverInformation, err := readCloudantServerInformation(client)
+	serverInformation, err := readCloudantServerInformation(flex.OperationContext(d), client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving server information: %s", err)
 	}
@@ -161,10 +163,10 @@ func setCloudantServerInformation(client *cloudantv1.CloudantV1, d *schema.Resou
 	return nil
 }
 
-func readCloudantServerInformation(client *cloudantv1.CloudantV1) (*cloudantv1.ServerInformation, error) {
+func readCloudantServerInformation(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.ServerInformation, error) {
 	opts := client.NewGetServerInformationOptions()
 
-	serverInformation, response, err := client.GetServerInformation(opts)
+	serverInformation, response, err := client.GetServerInformationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving server information: %s\n%s", err, response)
 	}
@@ -126,7 +126,7 @@ func ResourceIBMCloudant() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -308,7 +308,7 @@ func setCloudantLegacyCredentials(d *schema.ResourceData, meta interface{}) erro
 		ID: &instanceID,
 	}
 
-	instance, response, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving resource instance: %s\n%s", err, response)
 		return err
@@ -415,7 +415,7 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 }
 
 func setCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	activityTrackerEvents, err := readCloudantActivityTrackerEvents(client)
+	activityTrackerEvents, err := readCloudantActivityTrackerEvents(flex.OperationContext(d), client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving activity tracker events: %s", err)
 	}
@@ -431,10 +431,10 @@ func setCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schema.R
 	return nil
 }
 
-func readCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1) (*cloudantv1.ActivityTrackerEvents, error) {
+func readCloudantActivityTrackerEvents(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.ActivityTrackerEvents, error) {
 	opts := client.NewGetActivityTrackerEventsOptions()
 
-	activityTrackerEvents, response, err := client.GetActivityTrackerEvents(opts)
+	activityTrackerEvents, response, err := client.GetActivityTrackerEventsWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving activity tracker events: %s\n%s", err, response)
 	}
@@ -449,7 +449,7 @@ func updateCloudantActivityTrackerEvents(client *cloudantv1.CloudantV1, d *schem
 
 	opts := client.NewPostActivityTrackerEventsOptions(auditEventTypes)
 
-	_, response, err := client.PostActivityTrackerEvents(opts)
+	_, response, err := client.PostActivityTrackerEventsWithContext(flex.OperationContext(d), opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating activity tracker events: %s\n%s", err, response)
 	}
@@ -466,7 +466,7 @@ func validateCloudantInstanceCapacity(d *schema.ResourceData) error {
 }
 
 func setCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	capacityThroughputInformation, err := readCloudantInstanceCapacity(client)
+	capacityThroughputInformation, err := readCloudantInstanceCapacity(flex.OperationContext(d), client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving capacity throughput information: %s", err)
 	}
@@ -490,10 +490,10 @@ func setCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.Resour
 	return nil
 }
 
-func readCloudantInstanceCapacity(client *cloudantv1.CloudantV1) (*cloudantv1.CapacityThroughputInformation, error) {
+func readCloudantInstanceCapacity(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.CapacityThroughputInformation, error) {
 	opts := client.NewGetCapacityThroughputInformationOptions()
 
-	capacityThroughputInformation, response, err := client.GetCapacityThroughputInformation(opts)
+	capacityThroughputInformation, response, err := client.GetCapacityThroughputInformationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error getting capacity throughput information: %s\n%s", err, response)
 	}
@@ -505,21 +505,21 @@ func updateCloudantInstanceCapacity(client *cloudantv1.CloudantV1, d *schema.Res
 
 	putOpts := client.NewPutCapacityThroughputConfigurationOptions(blocks)
 
-	_, response, err := client.PutCapacityThroughputConfiguration(putOpts)
+	_, response, err := client.PutCapacityThroughputConfigurationWithContext(flex.OperationContext(d), putOpts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating capacity throughput: %s\n%s", err, response)
 		return err
 	}
 
-	return isWaitForCapacityUpdated(client)
+	return isWaitForCapacityUpdated(flex.OperationContext(d), client)
 }
 
-func isWaitForCapacityUpdated(client *cloudantv1.CloudantV1) error {
+func isWaitForCapacityUpdated(ctx context.Context, client *cloudantv1.CloudantV1) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry"},
 		Target:  []string{"done", "failed"},
 		Refresh: func() (interface{}, string, error) {
-			capacityThroughputInformation, err := readCloudantInstanceCapacity(client)
+			capacityThroughputInformation, err := readCloudantInstanceCapacity(ctx, client)
 			if err != nil {
 				return nil, "failed", err
 			}
@@ -539,7 +539,7 @@ func isWaitForCapacityUpdated(client *cloudantv1.CloudantV1) error {
 		MinTimeout: 2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := flex.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -558,7 +558,7 @@ func validateCloudantInstanceCors(d *schema.ResourceData) error {
 }
 
 func setCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.ResourceData) error {
-	corsInformation, err := readCloudantInstanceCors(client)
+	corsInformation, err := readCloudantInstanceCors(flex.OperationContext(d), client)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving CORS config: %s", err)
 	}
@@ -578,10 +578,10 @@ func setCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.ResourceDa
 	return nil
 }
 
-func readCloudantInstanceCors(client *cloudantv1.CloudantV1) (*cloudantv1.CorsInformation, error) {
+func readCloudantInstanceCors(ctx context.Context, client *cloudantv1.CloudantV1) (*cloudantv1.CorsInformation, error) {
 	opts := client.NewGetCorsInformationOptions()
 
-	corsInformation, response, err := client.GetCorsInformation(opts)
+	corsInformation, response, err := client.GetCorsInformationWithContext(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving CORS config: %s\n%s", err, response)
 	}
@@ -603,7 +603,7 @@ func updateCloudantInstanceCors(client *cloudantv1.CloudantV1, d *schema.Resourc
 	opts.SetEnableCors(enableCors)
 	opts.SetAllowCredentials(allowCredentials)
 
-	_, response, err := client.PutCorsConfiguration(opts)
+	_, response, err := client.PutCorsConfigurationWithContext(flex.OperationContext(d), opts)
 	if err != nil {
 		log.Printf("[DEBUG] Error updating CORS settings: %s\n%s", err, response)
 	}
@@ -350,7 +350,7 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -380,5 +380,5 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
@@ -54,7 +54,7 @@ func caseDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
 }
 
 func ResourceIBMCOSBucket() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithDeletionProtection(&schema.Resource{
 		Read:          resourceIBMCOSBucketRead,
 		Create:        resourceIBMCOSBucketCreate,
 		Update:        resourceIBMCOSBucketUpdate,
@@ -419,7 +419,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
 		},
-	}
+	})
 }
 
 func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
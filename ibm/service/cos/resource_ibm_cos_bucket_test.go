@@ -630,6 +630,36 @@ func TestAccIBMCosBucket_import(t *testing.T) {
 	})
 }
 
+func TestIBMCosBucket_replay(t *testing.T) {
+	acc.ReplayTest(t, "ibm_cos_bucket_basic", map[string]string{
+		"IBMCLOUD_COS_ENDPOINT":        "https://s3.us-south.cloud-object-storage.appdomain.cloud",
+		"IBMCLOUD_COS_CONFIG_ENDPOINT": "https://config.cloud-object-storage.cloud.ibm.com/v1",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_replay(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "bucket_name", "tf-replay-bucket"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "storage_class", "standard"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "region_location", "us-south"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_replay(1073741824),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "hard_quota", "1073741824"),
+				),
+			},
+			{
+				ResourceName:            "ibm_cos_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCosBucketDestroy(s *terraform.State) error {
 
 	var s3Conf *aws.Config
@@ -1558,3 +1588,20 @@ func TestCrossRegionLocationRegex(t *testing.T) {
 		}
 	}
 }
+
+// testAccCheckIBMCosBucket_replay is the bucket of the ibm_cos_bucket_basic cassette
+func testAccCheckIBMCosBucket_replay(hardQuota int) string {
+	quota := ""
+	if hardQuota > 0 {
+		quota = fmt.Sprintf("hard_quota           = %d", hardQuota)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "tf-replay-bucket"
+		resource_instance_id = "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:6e3a1f4c-5a2b-4d8e-9c7f-0b1d2e3f4a5b::"
+		region_location      = "us-south"
+		storage_class        = "standard"
+		%s
+	}
+	`, quota)
+}
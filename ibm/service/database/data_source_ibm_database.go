@@ -568,7 +568,7 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
 		rsInstQuery.ResourceGroupID = rsGrpID.(string)
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return err
 		}
@@ -84,7 +84,7 @@ func retryTask(f func() (icdv4.Task, error)) (task icdv4.Task, err error) {
 }
 
 func ResourceIBMDatabaseInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithDeletionProtection(&schema.Resource{
 		Create:        resourceIBMDatabaseInstanceCreate,
 		Read:          resourceIBMDatabaseInstanceRead,
 		Update:        resourceIBMDatabaseInstanceUpdate,
@@ -290,6 +290,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tag")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -413,7 +420,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							Description:  "Whitelist IP address in CIDR notation",
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validate.ValidateCIDR,
+							ValidateFunc: validate.InvokeValidator("ibm_database", "whitelist.address"),
 						},
 						"description": {
 							Description:  "Unique white list description",
@@ -751,7 +758,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about the resource",
 			},
 		},
-	}
+	})
 }
 func ResourceIBMICDValidator() *validate.ResourceValidator {
 
@@ -766,6 +773,12 @@ func ResourceIBMICDValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "whitelist.address",
+			ValidateFunctionIdentifier: validate.ValidateCIDRAddress,
+			Type:                       validate.TypeString,
+			Optional:                   true})
 
 	ibmICDResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database", Schema: validateSchema}
 	return &ibmICDResourceValidator
@@ -905,7 +918,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 
-	err := flex.ResourceTagsCustomizeDiff(diff)
+	err := flex.ResourceDefaultTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1030,7 +1043,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		rgID := rsGrpID.(string)
 		rsInst.ResourceGroup = &rgID
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return err
 		}
@@ -1093,7 +1106,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	//paramString := string(parameters[:])
 	rsInst.Parameters = raw
 
-	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response)
 	}
@@ -1119,7 +1132,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1270,7 +1283,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		if strings.Contains(err.Error(), "Object not found") ||
 			strings.Contains(err.Error(), "status code: 404") {
@@ -1292,6 +1305,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 			"Error on get of ibm Database tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -1449,7 +1463,7 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if update {
-		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+		_, response, err := rsConClient.UpdateResourceInstanceWithContext(flex.OperationContext(d), &updateReq)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
 		}
@@ -1462,7 +1476,7 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
@@ -1883,7 +1897,7 @@ func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 		Recursive: &recursive,
 		ID:        &id,
 	}
-	response, err := rsConClient.DeleteResourceInstance(&deleteReq)
+	response, err := rsConClient.DeleteResourceInstanceWithContext(flex.OperationContext(d), &deleteReq)
 	if err != nil {
 		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
 		// RC 410 with "Gone" returned as error
@@ -1915,7 +1929,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
 			if apiErr.StatusCode() == 404 {
@@ -1970,7 +1984,7 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil || instance == nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
@@ -1993,7 +2007,7 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -2010,7 +2024,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
@@ -2033,7 +2047,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
@@ -2081,7 +2095,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, databaseInstanceSuccessStatus, nil
@@ -2098,7 +2112,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
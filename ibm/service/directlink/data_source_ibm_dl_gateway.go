@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -303,7 +304,7 @@ func dataSourceIBMDLGatewayVirtualConnectionsRead(d *schema.ResourceData, meta i
 	listVcOptions := &directlinkv1.ListGatewayVirtualConnectionsOptions{}
 	dlGatewayId := d.Id()
 	listVcOptions.SetGatewayID(dlGatewayId)
-	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnections(listVcOptions)
+	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnectionsWithContext(flex.OperationContext(d), listVcOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while listing directlink gateway's virtual connections XXX %s\n%s", err, response)
 	}
@@ -349,7 +350,7 @@ func dataSourceIBMDLGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	listGatewaysOptionsModel := &directlinkv1.ListGatewaysOptions{}
-	listGateways, response, err := directLink.ListGateways(listGatewaysOptionsModel)
+	listGateways, response, err := directLink.ListGatewaysWithContext(flex.OperationContext(d), listGatewaysOptionsModel)
 	if err != nil {
 		log.Println("[WARN] Error listing dl Gateway", response, err)
 		return err
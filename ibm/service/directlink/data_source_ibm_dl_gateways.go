@@ -7,6 +7,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -263,7 +264,7 @@ func dataSourceIBMDLGatewaysRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	listGatewaysOptionsModel := &directlinkv1.ListGatewaysOptions{}
-	listGateways, response, err := directLink.ListGateways(listGatewaysOptionsModel)
+	listGateways, response, err := directLink.ListGatewaysWithContext(flex.OperationContext(d), listGatewaysOptionsModel)
 	if err != nil {
 		log.Println("[WARN] Error listing dl Gateway", response, err)
 		return err
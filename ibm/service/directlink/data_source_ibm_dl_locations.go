@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -117,7 +118,7 @@ func dataSourceIBMDLOfferingLocationsRead(d *schema.ResourceData, meta interface
 	}
 	listOfferingTypeLocationsOptions := &directlinkv1.ListOfferingTypeLocationsOptions{}
 	listOfferingTypeLocationsOptions.SetOfferingType(d.Get(dlOfferingType).(string))
-	listLocations, response, err := directLink.ListOfferingTypeLocations(listOfferingTypeLocationsOptions)
+	listLocations, response, err := directLink.ListOfferingTypeLocationsWithContext(flex.OperationContext(d), listOfferingTypeLocationsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while listing directlink gateway's locations %s\n%s", err, response)
 	}
@@ -68,7 +68,7 @@ func dataSourceIBMDLOfferingSpeedsRead(d *schema.ResourceData, meta interface{})
 	dlType := d.Get(dlOfferingType).(string)
 	listSpeedsOptionsModel := &directlinkv1.ListOfferingTypeSpeedsOptions{}
 	listSpeedsOptionsModel.OfferingType = &dlType
-	listSpeeds, detail, err := directLink.ListOfferingTypeSpeeds(listSpeedsOptionsModel)
+	listSpeeds, detail, err := directLink.ListOfferingTypeSpeedsWithContext(flex.OperationContext(d), listSpeedsOptionsModel)
 
 	if err != nil {
 		log.Printf("Error reading list of direct link offering speeds:%s\n%s", err, detail)
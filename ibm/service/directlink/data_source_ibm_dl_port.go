@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -64,7 +65,7 @@ func dataSourceIBMDirectLinkPortRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	getPortsOptions := sess.NewGetPortOptions(d.Get(dlPortID).(string))
-	response, resp, err := sess.GetPort(getPortsOptions)
+	response, resp, err := sess.GetPortWithContext(flex.OperationContext(d), getPortsOptions)
 	if err != nil {
 		log.Println("[WARN] Error getting port", resp, err)
 		return err
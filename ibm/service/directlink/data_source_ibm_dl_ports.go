@@ -109,7 +109,7 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 
 		}
 
-		response, resp, err := sess.ListPorts(listPortsOptions)
+		response, resp, err := sess.ListPortsWithContext(flex.OperationContext(d), listPortsOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl ports", resp, err)
 			return err
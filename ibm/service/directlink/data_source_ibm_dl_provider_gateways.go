@@ -143,7 +143,7 @@ func dataSourceIBMDirectLinkProviderGatewaysRead(d *schema.ResourceData, meta in
 			listProviderGatewaysOptions.Start = &start
 		}
 
-		providerGateways, resp, err := directLinkProvider.ListProviderGateways(listProviderGatewaysOptions)
+		providerGateways, resp, err := directLinkProvider.ListProviderGatewaysWithContext(flex.OperationContext(d), listProviderGatewaysOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl provider gateways", providerGateways, resp, err)
 			return err
@@ -86,7 +86,7 @@ func dataSourceIBMDirectLinkProviderPortsRead(d *schema.ResourceData, meta inter
 			listPortsProviderOptions.Start = &start
 		}
 
-		ports, resp, err := directLinkProvider.ListProviderPorts(listPortsProviderOptions)
+		ports, resp, err := directLinkProvider.ListProviderPortsWithContext(flex.OperationContext(d), listPortsProviderOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl provider ports", ports, resp, err)
 			return err
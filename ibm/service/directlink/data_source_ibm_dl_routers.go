@@ -76,7 +76,7 @@ func dataSourceIBMDLRoutersRead(d *schema.ResourceData, meta interface{}) error
 	listRoutersOptionsModel.OfferingType = &dlType
 	listRoutersOptionsModel.LocationName = &dlLocName
 
-	listRouters, detail, err := directLink.ListOfferingTypeLocationCrossConnectRouters(listRoutersOptionsModel)
+	listRouters, detail, err := directLink.ListOfferingTypeLocationCrossConnectRoutersWithContext(flex.OperationContext(d), listRoutersOptionsModel)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Direct Link Location Cross Connect Routers: %s\n%s", err, detail)
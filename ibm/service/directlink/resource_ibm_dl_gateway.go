@@ -89,7 +89,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -383,6 +383,13 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -659,7 +666,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
+	gateway, response, err := directLink.CreateGatewayWithContext(flex.OperationContext(d), createGatewayOptionsModel)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
 	}
@@ -668,12 +675,12 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Created Direct Link Gateway (%s Template) : %s", dtype, *gateway.ID)
 	if dtype == "connect" {
 		getPortOptions := directLink.NewGetPortOptions(*gateway.Port.ID)
-		port, response, err := directLink.GetPort(getPortOptions)
+		port, response, err := directLink.GetPortWithContext(flex.OperationContext(d), getPortOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting port %s %s", response, err)
 		}
 		if port != nil && port.ProviderName != nil && !strings.Contains(strings.ToLower(*port.ProviderName), "netbond") && !strings.Contains(strings.ToLower(*port.ProviderName), "megaport") {
-			_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForDirectLinkAvailable(flex.OperationContext(d), directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -682,7 +689,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -710,7 +717,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[INFO] Calling getgateway api: %s", dtype)
 
-	instance, response, err := directLink.GetGateway(getOptions)
+	instance, response, err := directLink.GetGatewayWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -851,6 +858,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(dlTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -886,24 +894,24 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
 		Target:     []string{dlGatewayProvisioningDone, ""},
-		Refresh:    isDirectLinkRefreshFunc(client, id),
+		Refresh:    isDirectLinkRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
-func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
+func isDirectLinkRefreshFunc(ctx context.Context, client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getOptions := &directlinkv1.GetGatewayOptions{
 			ID: &id,
 		}
-		instance, response, err := client.GetGateway(getOptions)
+		instance, response, err := client.GetGatewayWithContext(ctx, getOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
 		}
@@ -925,7 +933,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	getOptions := &directlinkv1.GetGatewayOptions{
 		ID: &ID,
 	}
-	instance, detail, err := directLink.GetGateway(getOptions)
+	instance, detail, err := directLink.GetGatewayWithContext(flex.OperationContext(d), getOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway :%s", detail)
@@ -936,7 +944,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	updateGatewayOptionsModel.ID = &ID
 	dtype := *instance.Type
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -1056,7 +1064,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 			updateGatewayOptionsModel.MacsecConfig = nil
 		}
 	}
-	_, response, err := directLink.UpdateGateway(updateGatewayOptionsModel)
+	_, response, err := directLink.UpdateGatewayWithContext(flex.OperationContext(d), updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway err %s\n%s", err, response)
 		return err
@@ -1076,7 +1084,7 @@ func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	delOptions := &directlinkv1.DeleteGatewayOptions{
 		ID: &ID,
 	}
-	response, err := directLink.DeleteGateway(delOptions)
+	response, err := directLink.DeleteGatewayWithContext(flex.OperationContext(d), delOptions)
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
@@ -1098,7 +1106,7 @@ func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool,
 	getOptions := &directlinkv1.GetGatewayOptions{
 		ID: &ID,
 	}
-	_, response, err := directLink.GetGateway(getOptions)
+	_, response, err := directLink.GetGatewayWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -145,7 +145,7 @@ func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) erro
 		createGatewayVCOptions.SetNetworkID(vcNetworkId)
 	}
 
-	gatewayVC, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
+	gatewayVC, response, err := directLink.CreateGatewayVirtualConnectionWithContext(flex.OperationContext(d), createGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
 		return err
@@ -173,7 +173,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayVirtualConnectionOptions := &directlinkv1.GetGatewayVirtualConnectionOptions{}
 	getGatewayVirtualConnectionOptions.SetGatewayID(gatewayId)
 	getGatewayVirtualConnectionOptions.SetID(ID)
-	instance, response, err := directLink.GetGatewayVirtualConnection(getGatewayVirtualConnectionOptions)
+	instance, response, err := directLink.GetGatewayVirtualConnectionWithContext(flex.OperationContext(d), getGatewayVirtualConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -205,7 +205,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayOptions := &directlinkv1.GetGatewayOptions{
 		ID: &gatewayId,
 	}
-	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
+	dlgw, response, err := directLink.GetGatewayWithContext(flex.OperationContext(d), getGatewayOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
 	}
@@ -231,7 +231,7 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 		ID: &ID,
 	}
 	getVCOptions.SetGatewayID(gatewayId)
-	_, detail, err := directLink.GetGatewayVirtualConnection(getVCOptions)
+	_, detail, err := directLink.GetGatewayVirtualConnectionWithContext(flex.OperationContext(d), getVCOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway (Dedicated Template) Virtual Connection:%s", detail)
@@ -248,7 +248,7 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
+	_, response, err := directLink.UpdateGatewayVirtualConnectionWithContext(flex.OperationContext(d), updateGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
 		return err
@@ -274,7 +274,7 @@ func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &ID,
 	}
 	delVCOptions.SetGatewayID(gatewayId)
-	response, err := directLink.DeleteGatewayVirtualConnection(delVCOptions)
+	response, err := directLink.DeleteGatewayVirtualConnectionWithContext(flex.OperationContext(d), delVCOptions)
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
@@ -304,7 +304,7 @@ func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (boo
 		ID: &ID,
 	}
 	getVCOptions.SetGatewayID(gatewayId)
-	_, response, err := directLink.GetGatewayVirtualConnection(getVCOptions)
+	_, response, err := directLink.GetGatewayVirtualConnectionWithContext(flex.OperationContext(d), getVCOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -29,7 +29,7 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -127,6 +127,13 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -220,7 +227,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	}
 
-	gateway, response, err := directLink.CreateProviderGateway(gatewayOptions)
+	gateway, response, err := directLink.CreateProviderGatewayWithContext(flex.OperationContext(d), gatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Provider Gateway err %s\n%s", err, response)
 		return err
@@ -230,7 +237,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -257,7 +264,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Calling getgateway api: %s", dtype)
 
-	instance, response, err := directLink.GetProviderGateway(getOptions)
+	instance, response, err := directLink.GetProviderGatewayWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -319,6 +326,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(dlTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -342,14 +350,14 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Calling getgateway provider api")
 
-	instance, response, err := directLink.GetProviderGateway(getOptions)
+	instance, response, err := directLink.GetProviderGatewayWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting provider gateway %s, %s", err, response)
 	}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -379,7 +387,7 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 		updateGatewayOptionsModel.BgpIbmCidr = &bgpIbmCidr
 	}
 
-	_, response, err = directLink.UpdateProviderGateway(updateGatewayOptionsModel)
+	_, response, err = directLink.UpdateProviderGatewayWithContext(flex.OperationContext(d), updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Provider Gateway  err %s\n%s", err, response)
 		return err
@@ -397,7 +405,7 @@ func resourceIBMdlProviderGatewayDelete(d *schema.ResourceData, meta interface{}
 
 	ID := d.Id()
 	delOptions := directLink.NewDeleteProviderGatewayOptions(ID)
-	_, response, err := directLink.DeleteProviderGateway(delOptions)
+	_, response, err := directLink.DeleteProviderGatewayWithContext(flex.OperationContext(d), delOptions)
 	if err != nil {
 		if response != nil && response.StatusCode != 404 {
 			return nil
@@ -418,7 +426,7 @@ func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}
 	ID := d.Id()
 
 	getOptions := directLink.NewGetProviderGatewayOptions(ID)
-	_, response, err := directLink.GetProviderGateway(getOptions)
+	_, response, err := directLink.GetProviderGatewayWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
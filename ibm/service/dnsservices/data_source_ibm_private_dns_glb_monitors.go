@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -137,7 +138,7 @@ func dataSourceIBMPrivateDNSGLBMonitorsRead(d *schema.ResourceData, meta interfa
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSGLBMonitorions := sess.NewListMonitorsOptions(instanceID)
-	availableGLBMonitors, detail, err := sess.ListMonitors(listDNSGLBMonitorions)
+	availableGLBMonitors, detail, err := sess.ListMonitorsWithContext(flex.OperationContext(d), listDNSGLBMonitorions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of pdns GLB monitors:%s\n%s", err, detail)
 	}
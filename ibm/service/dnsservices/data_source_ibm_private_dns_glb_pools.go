@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -147,7 +148,7 @@ func dataSourceIBMPrivateDNSGLBPoolsRead(d *schema.ResourceData, meta interface{
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSGLBPooloptions := sess.NewListPoolsOptions(instanceID)
-	availableGLBPools, detail, err := sess.ListPools(listDNSGLBPooloptions)
+	availableGLBPools, detail, err := sess.ListPoolsWithContext(flex.OperationContext(d), listDNSGLBPooloptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of pdns GLB pools:%s\n%s", err, detail)
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -129,7 +130,7 @@ func dataSourceIBMPrivateDNSGLBsRead(d *schema.ResourceData, meta interface{}) e
 	instanceID := d.Get(pdnsInstanceID).(string)
 	zoneID := d.Get(pdnsZoneID).(string)
 	listDNSGLBs := sess.NewListLoadBalancersOptions(instanceID, zoneID)
-	availableGLBs, detail, err := sess.ListLoadBalancers(listDNSGLBs)
+	availableGLBs, detail, err := sess.ListLoadBalancersWithContext(flex.OperationContext(d), listDNSGLBs)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of pdns GLB load balancers:%s\n%s", err, detail)
 	}
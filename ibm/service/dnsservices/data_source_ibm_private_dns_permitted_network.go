@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -105,7 +106,7 @@ func dataSourceIBMPrivateDNSPermittedNetworksRead(d *schema.ResourceData, meta i
 	instanceID := d.Get(pdnsInstanceID).(string)
 	dnsZoneID := d.Get(pdnsZoneID).(string)
 	listPermittedNetworkOptions := sess.NewListPermittedNetworksOptions(instanceID, dnsZoneID)
-	availablePermittedNetworks, detail, err := sess.ListPermittedNetworks(listPermittedNetworkOptions)
+	availablePermittedNetworks, detail, err := sess.ListPermittedNetworksWithContext(flex.OperationContext(d), listPermittedNetworkOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of pdns permitted networks:%s\n%s", err, detail)
 	}
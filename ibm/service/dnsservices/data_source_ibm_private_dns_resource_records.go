@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -77,7 +78,7 @@ func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta int
 	instanceID := d.Get(pdnsInstanceID).(string)
 	DnszoneID := d.Get(pdnsZoneID).(string)
 	listDNSResRecOptions := sess.NewListResourceRecordsOptions(instanceID, DnszoneID)
-	availableDNSResRecs, detail, err := sess.ListResourceRecords(listDNSResRecOptions)
+	availableDNSResRecs, detail, err := sess.ListResourceRecordsWithContext(flex.OperationContext(d), listDNSResRecOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of pdns resource records:%s\n%s", err, detail)
 	}
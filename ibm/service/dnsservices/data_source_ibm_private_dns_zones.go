@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +17,7 @@ const (
 )
 
 func DataSourceIBMPrivateDNSZones() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		Read: dataSourceIBMPrivateDNSZonesRead,
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
@@ -74,7 +75,7 @@ func DataSourceIBMPrivateDNSZones() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, pdnsZones)
 }
 
 func dataSourceIBMPrivateDNSZonesRead(d *schema.ResourceData, meta interface{}) error {
@@ -85,7 +86,7 @@ func dataSourceIBMPrivateDNSZonesRead(d *schema.ResourceData, meta interface{})
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSZonesOptions := sess.NewListDnszonesOptions(instanceID)
-	availableDNSZones, detail, err := sess.ListDnszones(listDNSZonesOptions)
+	availableDNSZones, detail, err := sess.ListDnszonesWithContext(flex.OperationContext(d), listDNSZonesOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of dns zones:%s\n%s", err, detail)
 	}
@@ -102,6 +103,10 @@ func dataSourceIBMPrivateDNSZonesRead(d *schema.ResourceData, meta interface{})
 		dnsZone[pdnsZoneState] = instance.State
 		dnsZones = append(dnsZones, dnsZone)
 	}
+	dnsZones, err = flex.FilterItems(d, dnsZones, nil)
+	if err != nil {
+		return err
+	}
 	d.SetId(dataSourceIBMPrivateDNSZonesID(d))
 	d.Set(pdnsZones, dnsZones)
 	return nil
@@ -321,7 +321,7 @@ func resouceIBMPrivateDNSCustomResolverExists(d *schema.ResourceData, meta inter
 		return false, err
 	}
 	opt := sess.NewGetCustomResolverOptions(crn, customResolverID)
-	_, response, err := sess.GetCustomResolver(opt)
+	_, response, err := sess.GetCustomResolverWithContext(flex.OperationContext(d), opt)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -383,7 +383,7 @@ func waitForPDNSCustomResolverHealthy(d *schema.ResourceData, meta interface{})
 		Pending: []string{pdnsCustomResolverCritical, "false"},
 		Target:  []string{pdnsCustomResolverDegraded, pdnsCustomResolverHealthy, "true"},
 		Refresh: func() (interface{}, string, error) {
-			res, detail, err := sess.GetCustomResolver(opt)
+			res, detail, err := sess.GetCustomResolverWithContext(flex.OperationContext(d), opt)
 			if err != nil {
 				if detail != nil && detail.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The custom resolver %s does not exist anymore: %v", customResolverID, err)
@@ -397,5 +397,5 @@ func waitForPDNSCustomResolverHealthy(d *schema.ResourceData, meta interface{})
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
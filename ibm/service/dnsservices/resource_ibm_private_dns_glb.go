@@ -177,7 +177,7 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 		createlbOptions.SetAzPools(expandedAzpools)
 	}
 
-	result, resp, err := sess.CreateLoadBalancer(createlbOptions)
+	result, resp, err := sess.CreateLoadBalancerWithContext(flex.OperationContext(d), createlbOptions)
 	if err != nil {
 		log.Printf("create global load balancer failed %s", resp)
 		return err
@@ -195,7 +195,7 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	idset := strings.Split(d.Id(), "/")
 
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
+	presponse, resp, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getlbOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching pdns GLB :%s\n%s", err, resp)
 	}
@@ -257,7 +257,7 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 			updatelbOptions.SetAzPools(expandedAzpools)
 		}
 
-		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
+		_, detail, err := sess.UpdateLoadBalancerWithContext(flex.OperationContext(d), updatelbOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating pdns GLB :%s\n%s", err, detail)
 		}
@@ -274,7 +274,7 @@ func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) er
 
 	idset := strings.Split(d.Id(), "/")
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
-	response, err := sess.DeleteLoadBalancer(deletelbOptions)
+	response, err := sess.DeleteLoadBalancerWithContext(flex.OperationContext(d), deletelbOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns GLB :%s\n%s", err, response)
 	}
@@ -296,7 +296,7 @@ func resourceIBMPrivateDNSGLBExists(d *schema.ResourceData, meta interface{}) (b
 	}
 
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-	_, detail, err := sess.GetLoadBalancer(getlbOptions)
+	_, detail, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getlbOptions)
 	if err != nil {
 		if detail != nil && detail.StatusCode == 404 {
 			log.Printf("Get GLB failed with status code 404: %v", detail)
@@ -356,14 +356,14 @@ func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.R
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		idset := strings.Split(d.Id(), "/")
 		getlbOptions := LoadBalancer.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-		_, response, err := LoadBalancer.GetLoadBalancer(getlbOptions)
+		_, response, err := LoadBalancer.GetLoadBalancerWithContext(flex.OperationContext(d), getlbOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", pdnsGLBDeleted, nil
@@ -268,7 +268,7 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 		createMonitorOptions.SetAllowInsecure((monitorallowinsecure).(bool))
 	}
 
-	response, detail, err := sess.CreateMonitor(createMonitorOptions)
+	response, detail, err := sess.CreateMonitorWithContext(flex.OperationContext(d), createMonitorOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating pdns GLB monitor:%s\n%s", err, detail)
 	}
@@ -301,7 +301,7 @@ func resourceIBMPrivateDNSGLBMonitorRead(d *schema.ResourceData, meta interface{
 	idset := strings.Split(d.Id(), "/")
 
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
-	response, detail, err := sess.GetMonitor(getMonitorOptions)
+	response, detail, err := sess.GetMonitorWithContext(flex.OperationContext(d), getMonitorOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching pdns GLB Monitor:%s\n%s", err, detail)
 	}
@@ -422,7 +422,7 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 			updateMonitorOptions.SetAllowInsecure((monitorallowinsecure).(bool))
 		}
 
-		_, detail, err := sess.UpdateMonitor(updateMonitorOptions)
+		_, detail, err := sess.UpdateMonitorWithContext(flex.OperationContext(d), updateMonitorOptions)
 
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating pdns GLB Monitor:%s\n%s", err, detail)
@@ -441,7 +441,7 @@ func resourceIBMPrivateDNSGLBMonitorDelete(d *schema.ResourceData, meta interfac
 	idset := strings.Split(d.Id(), "/")
 
 	DeleteMonitorOptions := sess.NewDeleteMonitorOptions(idset[0], idset[1])
-	response, err := sess.DeleteMonitor(DeleteMonitorOptions)
+	response, err := sess.DeleteMonitorWithContext(flex.OperationContext(d), DeleteMonitorOptions)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns GLB Monitor:%s\n%s", err, response)
@@ -463,7 +463,7 @@ func resourceIBMPrivateDNSGLBMonitorExists(d *schema.ResourceData, meta interfac
 	}
 
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
-	response, detail, err := sess.GetMonitor(getMonitorOptions)
+	response, detail, err := sess.GetMonitorWithContext(flex.OperationContext(d), getMonitorOptions)
 	if err != nil {
 		if response != nil && detail != nil && detail.StatusCode == 404 {
 			return false, nil
@@ -226,7 +226,7 @@ func resourceIBMPrivateDNSGLBPoolCreate(d *schema.ResourceData, meta interface{}
 	poolorigins := d.Get(pdnsGlbPoolOrigins).(*schema.Set)
 	CreatePoolOptions.SetOrigins(expandPDNSGlbPoolOrigins(poolorigins))
 
-	result, resp, err := sess.CreatePool(CreatePoolOptions)
+	result, resp, err := sess.CreatePoolWithContext(flex.OperationContext(d), CreatePoolOptions)
 	if err != nil {
 		log.Printf("create global load balancer pool failed %s", resp)
 		return err
@@ -244,7 +244,7 @@ func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{})
 	idset := strings.Split(d.Id(), "/")
 
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
-	presponse, resp, err := sess.GetPool(getPoolOptions)
+	presponse, resp, err := sess.GetPoolWithContext(flex.OperationContext(d), getPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching pdns GLB Pool:%s\n%s", err, resp)
 	}
@@ -332,7 +332,7 @@ func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}
 			updatePoolOptions.SetOrigins(expandPDNSGlbPoolOrigins(poolorigins))
 
 		}
-		_, detail, err := sess.UpdatePool(updatePoolOptions)
+		_, detail, err := sess.UpdatePoolWithContext(flex.OperationContext(d), updatePoolOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating pdns GLB Pool:%s\n%s", err, detail)
 		}
@@ -349,7 +349,7 @@ func resourceIBMPrivateDNSGLBPoolDelete(d *schema.ResourceData, meta interface{}
 
 	idset := strings.Split(d.Id(), "/")
 	DeletePoolOptions := sess.NewDeletePoolOptions(idset[0], idset[1])
-	response, err := sess.DeletePool(DeletePoolOptions)
+	response, err := sess.DeletePoolWithContext(flex.OperationContext(d), DeletePoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns GLB Pool:%s\n%s", err, response)
 	}
@@ -373,7 +373,7 @@ func resourceIBMPrivateDNSGLBPoolExists(d *schema.ResourceData, meta interface{}
 	}
 
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
-	response, detail, err := sess.GetPool(getPoolOptions)
+	response, detail, err := sess.GetPoolWithContext(flex.OperationContext(d), getPoolOptions)
 	if err != nil {
 		if response != nil && detail != nil && detail.StatusCode == 404 {
 			return false, nil
@@ -408,7 +408,7 @@ func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interfa
 		Pending: []string{pdnsGlbPoolDeletePending},
 		Target:  []string{pdnsGlbPoolDeleted},
 		Refresh: func() (interface{}, string, error) {
-			_, detail, err := cisClient.GetPool(getPoolOptions)
+			_, detail, err := cisClient.GetPoolWithContext(flex.OperationContext(d), getPoolOptions)
 			if err != nil {
 				if detail != nil && detail.StatusCode == 404 {
 					return detail, "deleted", nil
@@ -423,5 +423,5 @@ func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interfa
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
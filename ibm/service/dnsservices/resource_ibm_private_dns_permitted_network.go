@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -109,8 +110,11 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
 	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
@@ -120,7 +124,7 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 
 	createPermittedNetworkOptions.SetPermittedNetwork(permittedNetworkCrn)
 	createPermittedNetworkOptions.SetType(nwType)
-	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+	response, detail, err := sess.CreatePermittedNetworkWithContext(flex.OperationContext(d), createPermittedNetworkOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail)
 	}
@@ -138,7 +142,7 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 
 	idSet := strings.Split(d.Id(), "/")
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	response, detail, err := sess.GetPermittedNetworkWithContext(flex.OperationContext(d), getPermittedNetworkOptions)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail)
@@ -164,10 +168,13 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 
 	idSet := strings.Split(d.Id(), "/")
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+	_, response, err := sess.DeletePermittedNetworkWithContext(flex.OperationContext(d), deletePermittedNetworkOptions)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response)
@@ -189,10 +196,13 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.RLockResource(d, schema.TimeoutRead, mk)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	_, response, err := sess.GetPermittedNetworkWithContext(flex.OperationContext(d), getPermittedNetworkOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
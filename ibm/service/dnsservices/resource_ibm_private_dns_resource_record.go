@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -298,9 +299,12 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		createResourceRecordOptions.SetProtocol(protocol)
 	}
 	mk := "private_dns_resource_record_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	response, detail, err := sess.CreateResourceRecordWithContext(flex.OperationContext(d), createResourceRecordOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
 	}
@@ -317,7 +321,7 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 		return err
 	}
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
+	response, detail, err := sess.GetResourceRecordWithContext(flex.OperationContext(d), getResourceRecordOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail)
 	}
@@ -388,8 +392,11 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 	}
 
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := flex.LockResource(d, schema.TimeoutUpdate, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 
@@ -477,7 +484,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			updateResourceRecordOptions.SetProtocol(protocol)
 		}
 
-		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
+		_, detail, err := sess.UpdateResourceRecordWithContext(flex.OperationContext(d), updateResourceRecordOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail)
 		}
@@ -496,9 +503,12 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	response, err := sess.DeleteResourceRecordWithContext(flex.OperationContext(d), deleteResourceRecordOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
 	}
@@ -519,9 +529,12 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 	}
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
+	unlock, err := flex.RLockResource(d, schema.TimeoutRead, mk)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+	_, response, err := sess.GetResourceRecordWithContext(flex.OperationContext(d), getResourceRecordOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
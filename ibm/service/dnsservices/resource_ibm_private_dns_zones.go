@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -119,7 +120,7 @@ func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) e
 	createZoneOptions.SetName(zoneName)
 	createZoneOptions.SetDescription(zoneDescription)
 	createZoneOptions.SetLabel(zoneLabel)
-	response, detail, err := sess.CreateDnszone(createZoneOptions)
+	response, detail, err := sess.CreateDnszoneWithContext(flex.OperationContext(d), createZoneOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating pdns zone:%s\n%s", err, detail)
 	}
@@ -138,7 +139,7 @@ func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) err
 
 	idSet := strings.Split(d.Id(), "/")
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	response, detail, err := sess.GetDnszone(getZoneOptions)
+	response, detail, err := sess.GetDnszoneWithContext(flex.OperationContext(d), getZoneOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, detail)
 	}
@@ -165,7 +166,7 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 
 	// Check DNS zone is present?
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetDnszone(getZoneOptions)
+	_, response, err := sess.GetDnszoneWithContext(flex.OperationContext(d), getZoneOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, response)
 	}
@@ -179,7 +180,7 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 		updateZoneOptions.SetDescription(description)
 		updateZoneOptions.SetLabel(label)
 
-		_, detail, err := sess.UpdateDnszone(updateZoneOptions)
+		_, detail, err := sess.UpdateDnszoneWithContext(flex.OperationContext(d), updateZoneOptions)
 
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating pdns zone:%s\n%s", err, detail)
@@ -198,7 +199,7 @@ func resourceIBMPrivateDNSZoneDelete(d *schema.ResourceData, meta interface{}) e
 	idSet := strings.Split(d.Id(), "/")
 
 	deleteZoneOptions := sess.NewDeleteDnszoneOptions(idSet[0], idSet[1])
-	response, err := sess.DeleteDnszone(deleteZoneOptions)
+	response, err := sess.DeleteDnszoneWithContext(flex.OperationContext(d), deleteZoneOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns zone:%s\n%s", err, response)
 	}
@@ -219,7 +220,7 @@ func resourceIBMPrivateDNSZoneExists(d *schema.ResourceData, meta interface{}) (
 		return false, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID", d.Id())
 	}
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetDnszone(getZoneOptions)
+	_, response, err := sess.GetDnszoneWithContext(flex.OperationContext(d), getZoneOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -315,7 +315,7 @@ func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, e
 		instanceCRN = getInstanceCRN(schemaID)
 	}
 
-	instance, err := getInstanceDetails(instanceCRN, meta)
+	instance, err := getInstanceDetails(flex.OperationContext(d), instanceCRN, meta)
 	if err != nil {
 		return "", "", err
 	}
@@ -332,7 +332,7 @@ func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, e
 	return adminURL, instanceCRN, nil
 }
 
-func getInstanceDetails(crn string, meta interface{}) (*resourcecontrollerv2.ResourceInstance, error) {
+func getInstanceDetails(ctx context.Context, crn string, meta interface{}) (*resourcecontrollerv2.ResourceInstance, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		log.Printf("[DEBUG] getInstanceURL ResourceControllerAPI err %s", err)
@@ -340,7 +340,7 @@ func getInstanceDetails(crn string, meta interface{}) (*resourcecontrollerv2.Res
 	}
 
 	getResourceInstanceOptions := rsConClient.NewGetResourceInstanceOptions(crn)
-	instance, response, err := rsConClient.GetResourceInstance(getResourceInstanceOptions)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, getResourceInstanceOptions)
 	if err != nil || instance == nil {
 		log.Printf("[DEBUG]getInstanceDetails GetResourceInstance failed with error: %s and response:\n%s", err, response)
 		return nil, err
@@ -274,7 +274,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
-	instance, err := getInstanceDetails(instanceCRN, meta)
+	instance, err := getInstanceDetails(flex.OperationContext(d), instanceCRN, meta)
 	if err != nil {
 		return nil, "", err
 	}
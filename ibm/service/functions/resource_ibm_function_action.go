@@ -6,7 +6,6 @@ package functions
 import (
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 
@@ -348,7 +347,7 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	if len(temp) == 2 {
 		pkgName = temp[1]
 		d.Set("name", fmt.Sprintf("%s/%s", pkgName, action.Name))
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			Namespace:         wskClient.Namespace,
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
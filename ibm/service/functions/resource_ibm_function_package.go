@@ -6,7 +6,6 @@ package functions
 import (
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 
@@ -141,6 +140,13 @@ func ResourceIBMFuncPackageValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Default:                    "[]",
 			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 funcPkgUsrDefParams,
+			ValidateFunctionIdentifier: validate.ValidateJSONString,
+			Type:                       validate.TypeString,
+			Default:                    "[]",
+			Optional:                   true})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 funcPkgBindPkgName,
@@ -283,7 +289,7 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 
 	} else {
 		d.Set("bind_package_name", fmt.Sprintf("/%s/%s", pkg.Binding.Namespace, pkg.Binding.Name))
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			Namespace:         pkg.Binding.Namespace,
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
@@ -6,7 +6,6 @@ package functions
 import (
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -275,7 +274,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		feedPayload[feedAuthKey] = wskClient.Config.AuthToken
 		feedPayload[feedTriggerName] = fmt.Sprintf("/%s/%s", qualifiedName.GetNamespace(), name)
 
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
@@ -491,7 +490,7 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 			feedTriggerName:    fmt.Sprintf("/%s/%s", qualifiedName.GetNamespace(), triggerID),
 		}
 
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := whisk.NewClient(bxSession.Config.HTTPClient, &whisk.Config{
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
 			AdditionalHeaders: wskClient.AdditionalHeaders,
@@ -60,7 +60,7 @@ func dataSourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) erro
 		tType = t.(string)
 	}
 
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, rID, rType, tType)
+	tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, rID, rType, tType)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error on get of resource tags (%s) tags: %s", d.Id(), err)
 	}
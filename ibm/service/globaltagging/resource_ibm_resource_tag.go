@@ -40,7 +40,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -163,9 +163,13 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 			AttachTagOptions.AccountID = flex.PtrToString(accountID)
 		}
 	}
+	if tType == "" || tType == "user" {
+		add = append(add, flex.DefaultTags(meta)...)
+		AttachTagOptions.TagNames = flex.ExpandStringList(flex.NewStringSet(flex.ResourceIBMVPCHash, add).List())
+	}
 
 	if len(add) > 0 {
-		_, resp, err := gtClient.AttachTag(AttachTagOptions)
+		_, resp, err := gtClient.AttachTagWithContext(flex.OperationContext(d), AttachTagOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
 		}
@@ -221,7 +225,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	tagList, err := flex.GetGlobalTagsUsingCRN(meta, rID, resourceType, tType)
+	tagList, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, rID, resourceType, tType)
 	if err != nil {
 		if apierr, ok := err.(bmxerror.RequestFailure); ok && apierr.StatusCode() == 404 {
 			d.SetId("")
@@ -262,7 +266,7 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if _, ok := d.GetOk(tags); ok {
 		oldList, newList := d.GetChange(tags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, rID, rType, tType)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on create of resource tags: %s", err)
 		}
@@ -312,7 +316,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			TagNames:  remove,
 		}
 
-		_, resp, err := gtClient.DetachTag(detachTagOptions)
+		_, resp, err := gtClient.DetachTagWithContext(flex.OperationContext(d), detachTagOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", remove, err, resp)
 		}
@@ -320,7 +324,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
 				TagName: flex.PtrToString(v),
 			}
-			_, resp, err := gtClient.DeleteTag(delTagOptions)
+			_, resp, err := gtClient.DeleteTagWithContext(flex.OperationContext(d), delTagOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error deleting resource tag %v: %s\n%s", v, err, resp)
 			}
@@ -4,6 +4,7 @@
 package globaltagging_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -63,7 +64,7 @@ func testAccCheckResourceTagExists(n string) resource.TestCheckFunc {
 			}
 			resourceID = parts[0]
 		}
-		_, err = flex.GetGlobalTagsUsingCRN(acc.TestAccProvider.Meta(), resourceID, "", "")
+		_, err = flex.GetGlobalTagsUsingCRN(context.Background(), acc.TestAccProvider.Meta(), resourceID, "", "")
 		if err != nil {
 			log.Printf(
 				"Error on get of resource tags (%s) : %s", resourceID, err)
@@ -173,7 +173,7 @@ func dataSourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta
 	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
 		rsInstQuery.ResourceGroupID = rsGrpID.(string)
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
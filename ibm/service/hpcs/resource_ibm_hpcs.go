@@ -47,7 +47,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -103,6 +103,13 @@ func ResourceIBMHPCS() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_hpcs", "tag")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -393,7 +400,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 		rg := rsGrpID.(string)
 		rsInst.ResourceGroup = &rg
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -418,7 +425,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 	rsInst.Parameters = raw
 
 	// Create HPCS Instance
-	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, resp, err := rsConClient.CreateResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil || instance == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating HPCS instance: %s with resp code: %s", err, resp))
 	}
@@ -431,7 +438,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 
 	// Update Tags for this Resource using Global Tagging APIs
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -452,7 +459,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instance == nil {
 		if resp != nil && resp.StatusCode == 404 { // If instance doesnt not exists, empty the statefile
 			d.SetId("")
@@ -484,6 +491,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 			"[ERROR] Error on get of HPCS instance tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	// Set Location
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
@@ -629,11 +637,11 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -642,7 +650,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 	if update && !d.IsNewResource() { // Update RC API only if its not a new resource
-		_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+		_, resp, err = rsConClient.UpdateResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceUpdate)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating HPCS instance: %s with resp code: %s", err, resp))
 		}
@@ -723,7 +731,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &id,
 	}
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
 	}
@@ -753,7 +761,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 		ID:        &id,
 		Recursive: &recursive,
 	}
-	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
+	resp, error := rsConClient.DeleteResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceDelete)
 	if error != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HPCS instance: %s with resp code: %s", error, resp))
 	}
@@ -781,7 +789,7 @@ func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interf
 		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceProvisioningStatus},
 		Target:  []string{resourcecontroller.RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] HPCS instance %s does not exist anymore: %v", d.Id(), err)
@@ -798,7 +806,7 @@ func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -815,7 +823,7 @@ func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interf
 		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus},
 		Target:  []string{resourcecontroller.RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] HPCS instance %s does not exist anymore: %v", d.Id(), err)
@@ -832,7 +840,7 @@ func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -848,7 +856,7 @@ func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interf
 		Pending: []string{resourcecontroller.RsInstanceProgressStatus, resourcecontroller.RsInstanceInactiveStatus, resourcecontroller.RsInstanceSuccessStatus},
 		Target:  []string{resourcecontroller.RsInstanceRemovedStatus, resourcecontroller.RsInstanceReclamation},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return instance, resourcecontroller.RsInstanceSuccessStatus, nil
@@ -865,7 +873,7 @@ func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 func resourceIBMHPCSAdminHash(v interface{}) int {
 	var buf bytes.Buffer
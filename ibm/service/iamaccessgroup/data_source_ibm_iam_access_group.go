@@ -146,7 +146,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 			listServiceIDOptions.Pagetoken = &start
 		}
 
-		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
+		serviceIDs, resp, err := iamClient.ListServiceIdsWithContext(flex.OperationContext(d), &listServiceIDOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp)
 		}
@@ -158,7 +158,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	listAccessGroupOption := iamAccessGroupsClient.NewListAccessGroupsOptions(accountID)
-	retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
+	retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupsWithContext(flex.OperationContext(d), listAccessGroupOption)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
 	}
@@ -186,13 +186,13 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	for _, grp := range matchGroups {
 		accessGroupMembersListOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(*grp.ID)
-		members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(accessGroupMembersListOptions)
+		members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembersWithContext(flex.OperationContext(d), accessGroupMembersListOptions)
 		if err != nil {
 			log.Printf("Error retrieving access group members: %s.API Response: %s", err, detailedResponse)
 		}
 
 		accessGroupRulesListOptions := iamAccessGroupsClient.NewListAccessGroupRulesOptions(*grp.ID)
-		rules, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupRules(accessGroupRulesListOptions)
+		rules, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupRulesWithContext(flex.OperationContext(d), accessGroupRulesListOptions)
 		if err != nil {
 			log.Printf("Error retrieving access group rules: %s. API Response: %s", err, detailedResponse)
 		}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -65,7 +66,7 @@ func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.Resource
 		description := des.(string)
 		creatAccessGroupOptions.Description = &description
 	}
-	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroup(creatAccessGroupOptions)
+	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroupWithContext(flex.OperationContext(d), creatAccessGroupOptions)
 	if err != nil || agrp == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access group: %s. API Response: %s", err, detailedResponse))
 	}
@@ -82,7 +83,7 @@ func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceDa
 	}
 	agrpID := d.Id()
 	getAccessGroupOptions := iamAccessGroupsClient.NewGetAccessGroupOptions(agrpID)
-	agrp, detailedResponse, err := iamAccessGroupsClient.GetAccessGroup(getAccessGroupOptions)
+	agrp, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupWithContext(flex.OperationContext(d), getAccessGroupOptions)
 	if err != nil || agrp == nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 			d.SetId("")
@@ -122,7 +123,7 @@ func resourceIBMIAMAccessGroupUpdate(context context.Context, d *schema.Resource
 	}
 
 	if hasChange {
-		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroup(updateAccessGroupOptions)
+		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroupWithContext(flex.OperationContext(d), updateAccessGroupOptions)
 		if err != nil || agrp == nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating access group: %s. API Response: %s", err, detailedResponse))
 		}
@@ -142,7 +143,7 @@ func resourceIBMIAMAccessGroupDelete(context context.Context, d *schema.Resource
 	force := true
 	deleteAccessGroupOptions := iamAccessGroupsClient.NewDeleteAccessGroupOptions(agID)
 	deleteAccessGroupOptions.SetForce(force)
-	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroup(deleteAccessGroupOptions)
+	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroupWithContext(flex.OperationContext(d), deleteAccessGroupOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting access group: %s, API Response: %s", err, detailedResponse))
 	}
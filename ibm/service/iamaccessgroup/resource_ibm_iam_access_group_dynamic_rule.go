@@ -38,7 +38,7 @@ func ResourceIBMIAMDynamicRule() *schema.Resource {
 				Type:         schema.TypeInt,
 				Required:     true,
 				Description:  "The expiration in hours",
-				ValidateFunc: validate.ValidatePortRange(1, 24),
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_dynamic_rule", "expiration"),
 			},
 			"identity_provider": {
 				Type:        schema.TypeString,
@@ -76,6 +76,21 @@ func ResourceIBMIAMDynamicRule() *schema.Resource {
 	}
 }
 
+func ResourceIBMIAMDynamicRuleValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "expiration",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "24"})
+
+	ibmIAMDynamicRuleValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_group_dynamic_rule", Schema: validateSchema}
+	return &ibmIAMDynamicRuleValidator
+}
+
 func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -112,7 +127,7 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 		Expiration:    &expiration,
 		Conditions:    conditions,
 	}
-	rule, detailedResponse, err := iamAccessGroupsClient.AddAccessGroupRule(addAccessGroupRuleOptions)
+	rule, detailedResponse, err := iamAccessGroupsClient.AddAccessGroupRuleWithContext(flex.OperationContext(d), addAccessGroupRuleOptions)
 	if err != nil || rule == nil {
 		return fmt.Errorf("[ERROR] Error adding rule to Access Group(%s) %s. API Response: %s", grpID, err, detailedResponse)
 	}
@@ -140,7 +155,7 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 		AccessGroupID: &grpID,
 		RuleID:        &ruleID,
 	}
-	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
+	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRuleWithContext(flex.OperationContext(d), getAccessGroupRuleOptions)
 
 	if err != nil || rule == nil {
 		if detailResponse != nil && detailResponse.StatusCode == 404 {
@@ -174,7 +189,7 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	grpID := parts[0]
 	ruleID := parts[1]
 	getAccessGroupRuleOptions := iamAccessGroupsClient.NewGetAccessGroupRuleOptions(grpID, ruleID)
-	_, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
+	_, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupRuleWithContext(flex.OperationContext(d), getAccessGroupRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailedResponse)
 	}
@@ -202,7 +217,7 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	replaceAccessGroupRuleOption := iamAccessGroupsClient.NewReplaceAccessGroupRuleOptions(grpID, ruleID, etag, expiration, realm, condition)
-	rule, detailedResponse, err := iamAccessGroupsClient.ReplaceAccessGroupRule(replaceAccessGroupRuleOption)
+	rule, detailedResponse, err := iamAccessGroupsClient.ReplaceAccessGroupRuleWithContext(flex.OperationContext(d), replaceAccessGroupRuleOption)
 	if err != nil || rule == nil {
 		return fmt.Errorf("[ERROR] Error replacing group(%s) rule(%s). API response: %s", grpID, ruleID, detailedResponse)
 	}
@@ -225,7 +240,7 @@ func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) e
 	grpID := parts[0]
 	ruleID := parts[1]
 	removeAccessGroupRuleOptions := iamAccessGroupsClient.NewRemoveAccessGroupRuleOptions(grpID, ruleID)
-	detailedResponse, err := iamAccessGroupsClient.RemoveAccessGroupRule(removeAccessGroupRuleOptions)
+	detailedResponse, err := iamAccessGroupsClient.RemoveAccessGroupRuleWithContext(flex.OperationContext(d), removeAccessGroupRuleOptions)
 	if err != nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 			d.SetId("")
@@ -254,7 +269,7 @@ func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (
 	ruleID := parts[1]
 
 	getAccessGroupRuleOptions := iamAccessGroupsClient.NewGetAccessGroupRuleOptions(grpID, ruleID)
-	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
+	rule, detailResponse, err := iamAccessGroupsClient.GetAccessGroupRuleWithContext(flex.OperationContext(d), getAccessGroupRuleOptions)
 
 	if detailResponse != nil && detailResponse.StatusCode == 404 {
 		d.SetId("")
@@ -97,7 +97,7 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	serviceids, err = FlattenServiceIds(services, meta)
+	serviceids, err = FlattenServiceIds(flex.OperationContext(d), services, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -106,7 +106,7 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 
 	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
 	addMembersToAccessGroupOptions.SetMembers(members)
-	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroupWithContext(flex.OperationContext(d), addMembersToAccessGroupOptions)
 	if err != nil || membership == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
 	}
@@ -133,7 +133,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	// lets fetch 100 in a single pagination
 	limit := int64(100)
 	listAccessGroupMembersOptions.SetLimit(limit)
-	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembersWithContext(flex.OperationContext(d), listAccessGroupMembersOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
 	}
@@ -142,7 +142,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	for len(allMembers) < totalMembers {
 		offset = offset + limit
 		listAccessGroupMembersOptions.SetOffset(offset)
-		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembersWithContext(flex.OperationContext(d), listAccessGroupMembersOptions)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
 		}
@@ -185,7 +185,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 			listServiceIDOptions.Pagetoken = &start
 		}
 
-		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
+		serviceIDs, resp, err := iamClient.ListServiceIdsWithContext(flex.OperationContext(d), &listServiceIDOptions)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
 		}
@@ -249,7 +249,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			return diag.FromErr(err)
 		}
 
-		serviceids, err = FlattenServiceIds(addServiceids, meta)
+		serviceids, err = FlattenServiceIds(flex.OperationContext(d), addServiceids, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -257,7 +257,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 
 		addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
 		addMembersToAccessGroupOptions.SetMembers(members)
-		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroupWithContext(flex.OperationContext(d), addMembersToAccessGroupOptions)
 		if err != nil || membership == nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating members to group(%s). API response: %s", grpID, detailResponse))
 		}
@@ -274,7 +274,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 				return diag.FromErr(err)
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, ibmUniqueId)
-			_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+			_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroupWithContext(flex.OperationContext(d), removeMembersFromAccessGroupOptions)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -285,12 +285,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 				ID: &s,
 			}
-			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+			serviceID, resp, err := iamClient.GetServiceIDWithContext(flex.OperationContext(d), &getServiceIDOptions)
 			if err != nil || serviceID == nil {
 				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp))
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *serviceID.IamID)
-			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroupWithContext(flex.OperationContext(d), removeMembersFromAccessGroupOptions)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error removing members to group(%s). API Response: %s", grpID, detailResponse))
 			}
@@ -330,7 +330,7 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 		}
 
 		removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, ibmUniqueID)
-		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroupWithContext(flex.OperationContext(d), removeMembersFromAccessGroupOptions)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -340,7 +340,7 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	services := flex.ExpandStringList(d.Get("iam_service_ids").(*schema.Set).List())
 
 	for _, id := range services {
-		serviceID, err := getServiceID(id, meta)
+		serviceID, err := getServiceID(flex.OperationContext(d), id, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -349,7 +349,7 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 			AccessGroupID: &grpID,
 			IamID:         serviceID.IamID,
 		}
-		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroupWithContext(flex.OperationContext(d), removeMembersFromAccessGroupOptions)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -384,7 +384,7 @@ func prepareMemberAddRequest(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessG
 	}
 	return
 }
-func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error) {
+func getServiceID(ctx context.Context, id string, meta interface{}) (iamidentityv1.ServiceID, error) {
 	serviceids := iamidentityv1.ServiceID{}
 	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -393,17 +393,17 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 	getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 		ID: &id,
 	}
-	serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+	serviceID, resp, err := iamClient.GetServiceIDWithContext(ctx, &getServiceIDOptions)
 	if err != nil || serviceID == nil {
 		return serviceids, fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp)
 	}
 	return *serviceID, nil
 }
 
-func FlattenServiceIds(services []string, meta interface{}) ([]string, error) {
+func FlattenServiceIds(ctx context.Context, services []string, meta interface{}) ([]string, error) {
 	serviceids := make([]string, len(services))
 	for i, id := range services {
-		serviceID, err := getServiceID(id, meta)
+		serviceID, err := getServiceID(ctx, id, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -82,6 +82,36 @@ func TestAccIBMIAMAccessGroup_import(t *testing.T) {
 	})
 }
 
+func TestIBMIAMAccessGroup_replay(t *testing.T) {
+	var conf iamaccessgroupsv2.Group
+	resourceName := "ibm_iam_access_group.accgroup"
+
+	acc.ReplayTest(t, "ibm_iam_access_group_basic", nil, resource.TestCase{
+		CheckDestroy: testAccCheckIBMIAMAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupTag("tf-replay-access-group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMAccessGroupExists(resourceName, conf),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-replay-access-group"),
+					resource.TestCheckResourceAttr(resourceName, "description", "AccessGroup for test scenario2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupTag("tf-replay-access-group-update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-replay-access-group-update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupDestroy(s *terraform.State) error {
 	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -133,7 +134,7 @@ func dataSourceIbmIamAccountSettingsRead(context context.Context, d *schema.Reso
 
 	getAccountSettingsOptions.SetAccountID(userDetails.UserAccount)
 
-	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
+	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettingsWithContext(flex.OperationContext(d), getAccountSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
 		return diag.FromErr(err)
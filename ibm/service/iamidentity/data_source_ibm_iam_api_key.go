@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -89,7 +90,7 @@ func dataSourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData,
 
 	getApiKeyOptions.SetID(d.Get("apikey_id").(string))
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getApiKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -103,7 +103,7 @@ func dataSourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) err
 			listServiceIDOptions.Pagetoken = &start
 		}
 
-		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
+		serviceIDs, resp, err := iamClient.ListServiceIdsWithContext(flex.OperationContext(d), &listServiceIDOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp)
 		}
@@ -132,7 +132,7 @@ func dataSourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resou
 
 	getProfileOptions.SetProfileID(d.Get("profile_id").(string))
 
-	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
+	trustedProfile, response, err := iamIdentityClient.GetProfileWithContext(flex.OperationContext(d), getProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
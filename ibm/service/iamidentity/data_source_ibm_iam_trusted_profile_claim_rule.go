@@ -110,7 +110,7 @@ func dataSourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *sch
 	getClaimRuleOptions.SetProfileID(d.Get("profile_id").(string))
 	getClaimRuleOptions.SetRuleID(d.Get("rule_id").(string))
 
-	profileClaimRule, response, err := iamIdentityClient.GetClaimRule(getClaimRuleOptions)
+	profileClaimRule, response, err := iamIdentityClient.GetClaimRuleWithContext(flex.OperationContext(d), getClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("GetClaimRule failed %s\n%s", err, response))
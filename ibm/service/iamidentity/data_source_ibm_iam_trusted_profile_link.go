@@ -94,7 +94,7 @@ func dataSourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.R
 	getLinkOptions.SetProfileID(d.Get("profile_id").(string))
 	getLinkOptions.SetLinkID(d.Get("link_id").(string))
 
-	profileLink, response, err := iamIdentityClient.GetLink(getLinkOptions)
+	profileLink, response, err := iamIdentityClient.GetLinkWithContext(flex.OperationContext(d), getLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("GetLink failed %s\n%s", err, response))
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -102,7 +103,7 @@ func dataSourceIBMIamTrustedProfileLinkListRead(context context.Context, d *sche
 
 	listLinkOptions.SetProfileID(d.Get("profile_id").(string))
 
-	profileLinkList, response, err := iamIdentityClient.ListLinks(listLinkOptions)
+	profileLinkList, response, err := iamIdentityClient.ListLinksWithContext(flex.OperationContext(d), listLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLink failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLink failed %s\n%s", err, response))
@@ -184,7 +184,7 @@ func dataSourceIBMIamTrustedProfileListRead(context context.Context, d *schema.R
 			listProfileOptions.Pagetoken = &start
 		}
 
-		trustedProfiles, response, err := iamIdentityClient.ListProfiles(listProfileOptions)
+		trustedProfiles, response, err := iamIdentityClient.ListProfilesWithContext(flex.OperationContext(d), listProfileOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListProfile failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("ListProfile failed %s\n%s", err, response))
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -118,7 +119,7 @@ func dataSourceIBMIamTrustedProfileClaimRuleListRead(context context.Context, d
 
 	listClaimRulesOptions.SetProfileID(d.Get("profile_id").(string))
 
-	profileClaimRuleList, response, err := iamIdentityClient.ListClaimRules(listClaimRulesOptions)
+	profileClaimRuleList, response, err := iamIdentityClient.ListClaimRulesWithContext(flex.OperationContext(d), listClaimRulesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListClaimRules failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListClaimRules failed %s\n%s", err, response))
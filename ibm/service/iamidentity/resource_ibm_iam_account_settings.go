@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -187,7 +188,7 @@ func resourceIbmIamAccountSettingsCreate(context context.Context, d *schema.Reso
 		getAccountSettingsOptions.SetIncludeHistory(d.Get("include_history").(bool))
 	}
 
-	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
+	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettingsWithContext(flex.OperationContext(d), getAccountSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -209,7 +210,7 @@ func resourceIbmIamAccountSettingsRead(context context.Context, d *schema.Resour
 	getAccountSettingsOptions.SetAccountID(d.Id())
 	getAccountSettingsOptions.SetIncludeHistory(d.Get("include_history").(bool))
 
-	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
+	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettingsWithContext(flex.OperationContext(d), getAccountSettingsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -326,7 +327,7 @@ func resourceIbmIamAccountSettingsUpdate(context context.Context, d *schema.Reso
 	}
 
 	if hasChange {
-		_, response, err := iamIdentityClient.UpdateAccountSettings(updateAccountSettingsOptions)
+		_, response, err := iamIdentityClient.UpdateAccountSettingsWithContext(flex.OperationContext(d), updateAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettings failed %s\n%s", err, response)
 			return diag.FromErr(err)
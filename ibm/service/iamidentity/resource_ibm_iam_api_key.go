@@ -140,7 +140,7 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
 	}
 
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(flex.OperationContext(d), createApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -168,7 +168,7 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 
 	getApiKeyOptions.SetID(d.Id())
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getApiKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getApiKeyOptions)
 	if err != nil || apiKey == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -229,7 +229,7 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("description"); ok {
 		updateApiKeyOptions.SetDescription(d.Get("description").(string))
 	}
-	_, response, err := iamIdentityClient.UpdateAPIKey(updateApiKeyOptions)
+	_, response, err := iamIdentityClient.UpdateAPIKeyWithContext(flex.OperationContext(d), updateApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -248,7 +248,7 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 
 	deleteApiKeyOptions.SetID(d.Id())
 
-	response, err := iamIdentityClient.DeleteAPIKey(deleteApiKeyOptions)
+	response, err := iamIdentityClient.DeleteAPIKeyWithContext(flex.OperationContext(d), deleteApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -164,7 +164,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		createAPIKeyOptions.EntityLock = &elockstr
 	}
 
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(flex.OperationContext(d), createAPIKeyOptions)
 	if err != nil || apiKey == nil {
 		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
 	}
@@ -192,7 +192,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 		ID: &apiKeyID,
 	}
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getAPIKeyOptions)
 	if err != nil || apiKey == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -249,7 +249,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	apiKey, resp, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getAPIKeyOptions)
 	if err != nil || apiKey == nil {
 		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
 	}
@@ -273,7 +273,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 		hasChange = true
 	}
 	if hasChange {
-		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
+		_, response, err := iamIdentityClient.UpdateAPIKeyWithContext(flex.OperationContext(d), updateAPIKeyOptions)
 		if err != nil {
 			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
 		}
@@ -294,7 +294,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	_, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	_, response, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getAPIKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -307,7 +307,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
+	resp, err := iamIdentityClient.DeleteAPIKeyWithContext(flex.OperationContext(d), deleteAPIKeyOptions)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
 	}
@@ -327,7 +327,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		ID: &apiKeyID,
 	}
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(flex.OperationContext(d), getAPIKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
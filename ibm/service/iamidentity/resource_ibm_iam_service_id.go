@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -89,7 +90,7 @@ func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceDa
 		createServiceIDOptions.Description = &des
 	}
 
-	serviceID, resp, err := iamIdentityClient.CreateServiceID(&createServiceIDOptions)
+	serviceID, resp, err := iamIdentityClient.CreateServiceIDWithContext(flex.OperationContext(d), &createServiceIDOptions)
 	if err != nil || serviceID == nil {
 		log.Printf("Error creating serviceID: %s, %s", err, resp)
 		return diag.FromErr(err)
@@ -108,7 +109,7 @@ func resourceIBMIAMServiceIDRead(context context.Context, d *schema.ResourceData
 	getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 		ID: &serviceIDUUID,
 	}
-	serviceID, resp, err := iamIdentityClient.GetServiceID(&getServiceIDOptions)
+	serviceID, resp, err := iamIdentityClient.GetServiceIDWithContext(flex.OperationContext(d), &getServiceIDOptions)
 	if err != nil || serviceID == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -166,7 +167,7 @@ func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceDa
 	}
 
 	if hasChange {
-		_, resp, err := iamIdentityClient.UpdateServiceID(&updateServiceIDOptions)
+		_, resp, err := iamIdentityClient.UpdateServiceIDWithContext(flex.OperationContext(d), &updateServiceIDOptions)
 		if err != nil {
 			log.Printf("Error updating serviceID: %s, %s", err, resp)
 			return diag.FromErr(err)
@@ -187,7 +188,7 @@ func resourceIBMIAMServiceIDDelete(context context.Context, d *schema.ResourceDa
 	deleteServiceIDOptions := iamidentityv1.DeleteServiceIDOptions{
 		ID: &serviceIDUUID,
 	}
-	resp, err := iamIdentityClient.DeleteServiceID(&deleteServiceIDOptions)
+	resp, err := iamIdentityClient.DeleteServiceIDWithContext(flex.OperationContext(d), &deleteServiceIDOptions)
 	if err != nil {
 		log.Printf("Error deleting serviceID: %s %s", err, resp)
 		return diag.FromErr(err)
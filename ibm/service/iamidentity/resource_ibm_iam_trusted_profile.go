@@ -145,7 +145,7 @@ func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.Resou
 		createProfileOptions.SetDescription(d.Get("description").(string))
 	}
 
-	trustedProfile, response, err := iamIdentityClient.CreateProfile(createProfileOptions)
+	trustedProfile, response, err := iamIdentityClient.CreateProfileWithContext(flex.OperationContext(d), createProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateProfileWithContext failed %s\n%s", err, response))
@@ -166,7 +166,7 @@ func resourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resourc
 
 	getProfileOptions.SetProfileID(d.Id())
 
-	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
+	trustedProfile, response, err := iamIdentityClient.GetProfileWithContext(flex.OperationContext(d), getProfileOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -291,7 +291,7 @@ func resourceIBMIamTrustedProfileUpdate(context context.Context, d *schema.Resou
 		updateProfileOptions.SetDescription(d.Get("description").(string))
 	}
 
-	_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
+	_, response, err := iamIdentityClient.UpdateProfileWithContext(flex.OperationContext(d), updateProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
@@ -310,7 +310,7 @@ func resourceIBMIamTrustedProfileDelete(context context.Context, d *schema.Resou
 
 	deleteProfileOptions.SetProfileID(d.Id())
 
-	response, err := iamIdentityClient.DeleteProfile(deleteProfileOptions)
+	response, err := iamIdentityClient.DeleteProfileWithContext(flex.OperationContext(d), deleteProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfile failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteProfile failed %s\n%s", err, response))
@@ -144,7 +144,7 @@ func resourceIBMIamTrustedProfileClaimRuleCreate(context context.Context, d *sch
 		createClaimRuleOptions.SetExpiration(int64(d.Get("expiration").(int)))
 	}
 
-	profileClaimRule, response, err := iamIdentityClient.CreateClaimRule(createClaimRuleOptions)
+	profileClaimRule, response, err := iamIdentityClient.CreateClaimRuleWithContext(flex.OperationContext(d), createClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateClaimRule failed %s\n%s", err, response))
@@ -219,7 +219,7 @@ func resourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *schem
 	getClaimRuleOptions.SetRuleID(parts[1])
 	getClaimRuleOptions.SetProfileID(parts[0])
 
-	profileClaimRule, response, err := iamIdentityClient.GetClaimRule(getClaimRuleOptions)
+	profileClaimRule, response, err := iamIdentityClient.GetClaimRuleWithContext(flex.OperationContext(d), getClaimRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -357,7 +357,7 @@ func resourceIBMIamTrustedProfileClaimRuleUpdate(context context.Context, d *sch
 		updateClaimRuleOptions.SetExpiration(int64(d.Get("expiration").(int)))
 	}
 
-	_, response, err := iamIdentityClient.UpdateClaimRule(updateClaimRuleOptions)
+	_, response, err := iamIdentityClient.UpdateClaimRuleWithContext(flex.OperationContext(d), updateClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("UpdateClaimRule failed %s\n%s", err, response))
@@ -381,7 +381,7 @@ func resourceIBMIamTrustedProfileClaimRuleDelete(context context.Context, d *sch
 	deleteClaimRuleOptions.SetProfileID(parts[0])
 	deleteClaimRuleOptions.SetRuleID(parts[1])
 
-	response, err := iamIdentityClient.DeleteClaimRule(deleteClaimRuleOptions)
+	response, err := iamIdentityClient.DeleteClaimRuleWithContext(flex.OperationContext(d), deleteClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteClaimRule failed %s\n%s", err, response))
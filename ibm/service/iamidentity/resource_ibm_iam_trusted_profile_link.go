@@ -110,7 +110,7 @@ func resourceIBMIamTrustedProfileLinkCreate(context context.Context, d *schema.R
 		createLinkOptions.SetName(d.Get("name").(string))
 	}
 
-	profileLink, response, err := iamIdentityClient.CreateLink(createLinkOptions)
+	profileLink, response, err := iamIdentityClient.CreateLinkWithContext(flex.OperationContext(d), createLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateLink failed %s\n%s", err, response))
@@ -147,7 +147,7 @@ func resourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.Res
 	getLinkOptions.SetProfileID(parts[0])
 	getLinkOptions.SetLinkID(parts[1])
 
-	profileLink, response, err := iamIdentityClient.GetLink(getLinkOptions)
+	profileLink, response, err := iamIdentityClient.GetLinkWithContext(flex.OperationContext(d), getLinkOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -213,7 +213,7 @@ func resourceIBMIamTrustedProfileLinkDelete(context context.Context, d *schema.R
 	deleteLinkOptions.SetProfileID(parts[0])
 	deleteLinkOptions.SetLinkID(parts[1])
 
-	response, err := iamIdentityClient.DeleteLink(deleteLinkOptions)
+	response, err := iamIdentityClient.DeleteLinkWithContext(flex.OperationContext(d), deleteLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteLink failed %s\n%s", err, response))
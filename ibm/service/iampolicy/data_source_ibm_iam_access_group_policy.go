@@ -125,7 +125,7 @@ func dataSourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interfac
 		listPoliciesOptions.Sort = core.StringPtr(v.(string))
 	}
 
-	policyList, _, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), listPoliciesOptions)
 	policies := policyList.Policies
 
 	if err != nil {
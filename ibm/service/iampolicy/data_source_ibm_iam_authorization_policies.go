@@ -146,7 +146,7 @@ func dataSourceIBMIAMAuthorizationPoliciesRead(d *schema.ResourceData, meta inte
 		listPoliciesOptions.Sort = core.StringPtr(v.(string))
 	}
 
-	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	policyList, resp, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), listPoliciesOptions)
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error listing authorization policies: %s, %s", err, resp)
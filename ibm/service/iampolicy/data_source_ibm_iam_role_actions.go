@@ -70,7 +70,7 @@ func datasourceIBMIAMRoleActionRead(d *schema.ResourceData, meta interface{}) er
 		ServiceName: &serviceName,
 	}
 
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(flex.OperationContext(d), listRoleOptions)
 	if err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func datasourceIBMIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
 		serviceName = service.(string)
 		listRoleOptions.ServiceName = &serviceName
 	}
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(flex.OperationContext(d), listRoleOptions)
 	if err != nil {
 		return err
 	}
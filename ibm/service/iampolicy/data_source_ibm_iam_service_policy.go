@@ -122,7 +122,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 			ID: &serviceIDUUID,
 		}
-		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+		serviceID, resp, err := iamClient.GetServiceIDWithContext(flex.OperationContext(d), &getServiceIDOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
 		}
@@ -152,7 +152,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		listPoliciesOptions.Sort = core.StringPtr(v.(string))
 	}
 
-	policyList, _, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), listPoliciesOptions)
 	policies := policyList.Policies
 	if err != nil {
 		return err
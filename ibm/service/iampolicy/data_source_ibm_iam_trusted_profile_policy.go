@@ -122,7 +122,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		getprofileOptions := iamidentityv1.GetProfileOptions{
 			ProfileID: &profileUUID,
 		}
-		profile, resp, err := iamClient.GetProfile(&getprofileOptions)
+		profile, resp, err := iamClient.GetProfileWithContext(flex.OperationContext(d), &getprofileOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting profile ID %s %s", err, resp)
 		}
@@ -152,7 +152,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		listPoliciesOptions.Sort = core.StringPtr(v.(string))
 	}
 
-	policyList, _, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), listPoliciesOptions)
 	policies := policyList.Policies
 	if err != nil {
 		return err
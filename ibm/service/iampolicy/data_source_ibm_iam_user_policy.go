@@ -130,7 +130,7 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 		listPoliciesOptions.Sort = core.StringPtr(v.(string))
 	}
 
-	policyList, _, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), listPoliciesOptions)
 	policies := policyList.Policies
 	if err != nil {
 		return err
@@ -202,7 +202,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		[]iampolicymanagementv1.PolicyResource{*policyResource},
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(flex.OperationContext(d), createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
 	}
@@ -213,7 +213,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -224,7 +224,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -256,7 +256,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -267,7 +267,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
@@ -356,7 +356,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			[]iampolicymanagementv1.PolicyResource{*policyResource},
 		)
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, res, err := iamPolicyManagementClient.UpdatePolicyWithContext(flex.OperationContext(d), updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
 		}
@@ -382,7 +382,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 		accessGroupPolicyId,
 	)
 
-	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	res, err := iamPolicyManagementClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
 	}
@@ -411,7 +411,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		accessGroupPolicyId,
 	)
 
-	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -444,7 +444,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 		accgrpPolicyID,
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
 	}
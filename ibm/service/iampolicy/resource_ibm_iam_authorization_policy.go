@@ -213,7 +213,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 		SourceServiceName: &sourceServiceName,
 		PolicyType:        &policyType,
 	}
-	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
+	roleList, resp, err := iampapClient.ListRolesWithContext(flex.OperationContext(d), listRoleOptions)
 
 	if err != nil || roleList == nil {
 		return fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
@@ -238,7 +238,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 		createPolicyOptions.Description = &des
 	}
 
-	authPolicy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
+	authPolicy, resp, err := iampapClient.CreatePolicyWithContext(flex.OperationContext(d), createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
 	}
@@ -259,7 +259,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 		PolicyID: core.StringPtr(d.Id()),
 	}
 
-	authorizationPolicy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
+	authorizationPolicy, resp, err := iampapClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving authorizationPolicy: %s %s", err, resp)
 	}
@@ -301,7 +301,7 @@ func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interf
 	deletePolicyOptions := &iampolicymanagementv1.DeletePolicyOptions{
 		PolicyID: core.StringPtr(authorizationPolicyID),
 	}
-	resp, err := iampapClient.DeletePolicy(deletePolicyOptions)
+	resp, err := iampapClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		log.Printf(
 			"Error deleting authorization policy: %s, %s", err, resp)
@@ -321,7 +321,7 @@ func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interf
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
 		PolicyID: core.StringPtr(d.Id()),
 	}
-	authorizationPolicy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
+	authorizationPolicy, resp, err := iampapClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil || authorizationPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,7 +40,7 @@ func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta
 	deletePolicyOptions := iampapClient.NewDeletePolicyOptions(
 		policyID,
 	)
-	_, err = iampapClient.DeletePolicy(deletePolicyOptions)
+	_, err = iampapClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error detaching authorization policy: %s", err)
 	}
@@ -149,7 +149,7 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 		Description: &description,
 	}
 
-	role, response, err := iamPolicyManagementClient.CreateRole(roleOptions)
+	role, response, err := iamPolicyManagementClient.CreateRoleWithContext(flex.OperationContext(d), roleOptions)
 	if err != nil || role == nil {
 		return fmt.Errorf("[ERROR] Error creating Custom Roles: %s\n%s", err, response)
 	}
@@ -170,7 +170,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 		RoleID: &roleID,
 	}
 
-	role, response, err := iamPolicyManagementClient.GetRole(roleOptions)
+	role, response, err := iamPolicyManagementClient.GetRoleWithContext(flex.OperationContext(d), roleOptions)
 	if err != nil || role == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -215,7 +215,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 			RoleID: &roleID,
 		}
 
-		role, response, err := iamPolicyManagementClient.GetRole(roleGetOptions)
+		role, response, err := iamPolicyManagementClient.GetRoleWithContext(flex.OperationContext(d), roleGetOptions)
 		if err != nil || role == nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
@@ -233,7 +233,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 			Actions:     updatedActions,
 		}
 
-		_, response, err = iamPolicyManagementClient.UpdateRole(roleUpdateOptions)
+		_, response, err = iamPolicyManagementClient.UpdateRoleWithContext(flex.OperationContext(d), roleUpdateOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Custom Roles: %s\n%s", err, response)
 		}
@@ -253,7 +253,7 @@ func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) er
 		RoleID: &roleID,
 	}
 
-	response, err := iamPolicyManagementClient.DeleteRole(roleDeleteOptions)
+	response, err := iamPolicyManagementClient.DeleteRoleWithContext(flex.OperationContext(d), roleDeleteOptions)
 	if err != nil && !strings.Contains(err.Error(), "404") {
 		return fmt.Errorf("[ERROR] Error deleting Custom Roles: %s\n%s", err, response)
 	}
@@ -274,7 +274,7 @@ func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (b
 		RoleID: &roleID,
 	}
 
-	role, response, err := iamPolicyManagementClient.GetRole(roleGetOptions)
+	role, response, err := iamPolicyManagementClient.GetRoleWithContext(flex.OperationContext(d), roleGetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
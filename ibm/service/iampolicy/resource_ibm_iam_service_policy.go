@@ -180,7 +180,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 			ID: &serviceIDUUID,
 		}
-		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+		serviceID, resp, err := iamClient.GetServiceIDWithContext(flex.OperationContext(d), &getServiceIDOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
 		}
@@ -236,7 +236,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.Description = &des
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(flex.OperationContext(d), createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
 	}
@@ -247,7 +247,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -259,7 +259,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -302,7 +302,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -314,7 +314,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
@@ -373,7 +373,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
 				ID: &serviceIDUUID,
 			}
-			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+			serviceID, resp, err := iamClient.GetServiceIDWithContext(flex.OperationContext(d), &getServiceIDOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
 			}
@@ -419,7 +419,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 			servicePolicyID,
 		)
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -442,7 +442,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			updatePolicyOptions.Description = &des
 		}
 
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, _, err = iamPolicyManagementClient.UpdatePolicyWithContext(flex.OperationContext(d), updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
 		}
@@ -469,7 +469,7 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 		servicePolicyID,
 	)
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = iamPolicyManagementClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting service policy: %s", err)
 	}
@@ -498,7 +498,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		servicePolicyID,
 	)
 
-	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil || servicePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -529,7 +529,7 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
 	}
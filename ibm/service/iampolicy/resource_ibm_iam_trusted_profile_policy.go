@@ -180,7 +180,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		getProfileOptions := &iamidentityv1.GetProfileOptions{
 			ProfileID: &profileIDUUID,
 		}
-		profileID, resp, err := iamClient.GetProfile(getProfileOptions)
+		profileID, resp, err := iamClient.GetProfileWithContext(flex.OperationContext(d), getProfileOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
 		}
@@ -236,7 +236,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		createPolicyOptions.Description = &des
 	}
 
-	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicyWithContext(flex.OperationContext(d), createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
 	}
@@ -247,7 +247,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -259,7 +259,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -302,7 +302,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || trustedProfilePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -314,7 +314,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
@@ -373,7 +373,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			getProfileIDOptions := iamidentityv1.GetProfileOptions{
 				ProfileID: &profileIDUUID,
 			}
-			profileID, resp, err := iamClient.GetProfile(&getProfileIDOptions)
+			profileID, resp, err := iamClient.GetProfileWithContext(flex.OperationContext(d), &getProfileIDOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
 			}
@@ -419,7 +419,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 			trustedProfilePolicyID,
 		)
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -442,7 +442,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			updatePolicyOptions.Description = &des
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := iamPolicyManagementClient.UpdatePolicyWithContext(flex.OperationContext(d), updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
 		}
@@ -469,7 +469,7 @@ func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta inter
 		trustedProfilePolicyID,
 	)
 
-	resp, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	resp, err := iamPolicyManagementClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s %s", err, resp)
 	}
@@ -498,7 +498,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		trustedProfilePolicyID,
 	)
 
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil || trustedProfilePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -529,7 +529,7 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
 	)
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
 	}
@@ -577,7 +577,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		            > acees group level policies
 		********************************************/
 		//Get User level IAM policies
-		policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
+		policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), &iampolicymanagementv1.ListPoliciesOptions{
 			AccountID: core.StringPtr(accountID),
 			IamID:     core.StringPtr(user.IamID),
 			Type:      core.StringPtr("access"),
@@ -608,7 +608,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 			AccountID: &accountID,
 			IamID:     &user.IamID,
 		}
-		retreivedGroups, _, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOptions)
+		retreivedGroups, _, err := iamAccessGroupsClient.ListAccessGroupsWithContext(flex.OperationContext(d), listAccessGroupOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving access groups: %s", err)
 		}
@@ -616,7 +616,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		accGroupList := make([]map[string]interface{}, 0, len(retreivedGroups.Groups))
 		//Get the policies for each access group
 		for _, grpData := range retreivedGroups.Groups {
-			policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
+			policyList, _, err := iamPolicyManagementClient.ListPoliciesWithContext(flex.OperationContext(d), &iampolicymanagementv1.ListPoliciesOptions{
 				AccountID:     core.StringPtr(accountID),
 				AccessGroupID: core.StringPtr(user.IamID),
 			})
@@ -1018,7 +1018,7 @@ func getPolicies(d *schema.ResourceData, meta interface{}, policies []interface{
 			ServiceName: &serviceName,
 		}
 
-		roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+		roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(flex.OperationContext(d), listRoleOptions)
 		roles := flex.MapRoleListToPolicyRoles(*roleList)
 
 		if err != nil {
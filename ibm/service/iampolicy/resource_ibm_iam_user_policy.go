@@ -215,7 +215,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.Description = &des
 	}
 
-	userPolicy, _, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	userPolicy, _, err := iamPolicyManagementClient.CreatePolicyWithContext(flex.OperationContext(d), createPolicyOptions)
 
 	if err != nil {
 		return err
@@ -227,7 +227,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, res, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -239,7 +239,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -275,7 +275,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -287,7 +287,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, res, err = iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
@@ -370,7 +370,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 		getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
 			PolicyID: &userPolicyID,
 		}
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, response, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -393,7 +393,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.Description = &des
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, resp, err := iamPolicyManagementClient.UpdatePolicyWithContext(flex.OperationContext(d), updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
 		}
@@ -417,7 +417,7 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
 		userPolicyID,
 	)
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = iamPolicyManagementClient.DeletePolicyWithContext(flex.OperationContext(d), deletePolicyOptions)
 	if err != nil {
 		return err
 	}
@@ -445,7 +445,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		userPolicyID,
 	)
 
-	userPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil || userPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -477,7 +477,7 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		userPolicyID,
 	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, err := iamPolicyManagementClient.GetPolicyWithContext(flex.OperationContext(d), getPolicyOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
 	}
@@ -197,7 +197,7 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -170,7 +170,7 @@ func dataSourceIBMKMSKeyPoliciesRead(context context.Context, d *schema.Resource
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
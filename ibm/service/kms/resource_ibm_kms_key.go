@@ -31,7 +31,7 @@ func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool
 }
 
 func ResourceIBMKmskey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithDeletionProtection(&schema.Resource{
 		Create:   resourceIBMKmsKeyCreate,
 		Read:     resourceIBMKmsKeyRead,
 		Update:   resourceIBMKmsKeyUpdate,
@@ -265,7 +265,7 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about the resource",
 			},
 		},
-	}
+	})
 }
 
 func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
@@ -290,7 +290,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -387,7 +387,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -491,7 +491,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 			ID: &instanceID,
 		}
 
-		instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+		instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 		if err != nil || instanceData == nil {
 			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 		}
@@ -531,7 +531,7 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -577,7 +577,7 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 		ID: &instanceID,
 	}
 
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return false, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
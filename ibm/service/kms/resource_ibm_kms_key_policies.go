@@ -185,7 +185,7 @@ func resourceIBMKmsKeyPolicyCreate(context context.Context, d *schema.ResourceDa
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -228,7 +228,7 @@ func resourceIBMKmsKeyPolicyRead(context context.Context, d *schema.ResourceData
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil || instanceData == nil {
 		return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -312,7 +312,7 @@ func resourceIBMKmsKeyPolicyUpdate(context context.Context, d *schema.ResourceDa
 		resourceInstanceGet := rc.GetResourceInstanceOptions{
 			ID: &instanceID,
 		}
-		instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+		instanceData, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 		if err != nil || instanceData == nil {
 			return diag.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 		}
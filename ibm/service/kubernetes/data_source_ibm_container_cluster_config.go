@@ -17,6 +17,7 @@ import (
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMContainerClusterConfig() *schema.Resource {
@@ -134,8 +135,11 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	network := d.Get("network").(bool)
 
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	unlock, err := flex.LockResource(d, schema.TimeoutRead, clusterId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
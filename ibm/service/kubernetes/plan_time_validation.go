@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIBMContainerKubeVersionCustomizeDiff validates the kube_version of a cluster against
+// the supported Kubernetes and OpenShift versions when the provider plan_time_validation is
+// enabled. The patch of the version is not validated, the clusters are created with the latest
+// patch of the major and minor version.
+func resourceIBMContainerKubeVersionCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	cache := flex.PlanTimeValidation(meta)
+	if cache == nil {
+		return nil
+	}
+	kubeVersion, ok := flex.PlanTimeValidationValue(diff, "kube_version")
+	if !ok {
+		return nil
+	}
+	versions, err := cache.Load("container_versions", func() (interface{}, error) {
+		csClient, err := meta.(conns.ClientSession).ContainerAPI()
+		if err != nil {
+			return nil, err
+		}
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		return csClient.KubeVersions().ListV1(v1.ClusterTargetHeader{AccountID: userDetails.UserAccount})
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to list the supported versions, the kube_version %s is not validated: %s", kubeVersion, err)
+		return nil
+	}
+
+	platform := "kubernetes"
+	version := strings.ToLower(kubeVersion)
+	if strings.HasSuffix(version, "_openshift") {
+		platform = "openshift"
+		version = strings.TrimSuffix(version, "_openshift")
+	}
+	parts := strings.SplitN(version, ".", 3)
+	majorMinor := parts[0]
+	if len(parts) > 1 {
+		majorMinor += "." + parts[1]
+	}
+	supported := []string{}
+	for _, v := range versions.(v1.V1Version)[platform] {
+		supportedVersion := fmt.Sprintf("%d.%d", v.Major, v.Minor)
+		if supportedVersion == majorMinor {
+			return nil
+		}
+		supported = append(supported, supportedVersion)
+	}
+	return fmt.Errorf("[ERROR] The kube_version %s is not a supported %s version, the supported versions are %s", kubeVersion, platform, strings.Join(supported, ", "))
+}
+
+// resourceIBMContainerFlavorCustomizeDiff validates the worker flavor of a cluster against the
+// flavors of the zones of the provider, vpc-gen2 or classic, when the provider
+// plan_time_validation is enabled
+func resourceIBMContainerFlavorCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}, flavorKey string, zones []string, provider string) error {
+	cache := flex.PlanTimeValidation(meta)
+	if cache == nil {
+		return nil
+	}
+	flavor, ok := flex.PlanTimeValidationValue(diff, flavorKey)
+	if !ok {
+		return nil
+	}
+	flavor = strings.TrimSuffix(flavor, ".encrypted")
+	sort.Strings(zones)
+	for _, zone := range zones {
+		// the zone is unknown until apply
+		if zone == "" {
+			continue
+		}
+		flavors, err := cache.Load("container_flavors/"+provider+"/"+zone, func() (interface{}, error) {
+			csClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+			if err != nil {
+				return nil, err
+			}
+			options := csClient.NewV2GetFlavorsOptions(zone)
+			options.SetProvider(provider)
+			zoneFlavors, response, err := csClient.V2GetFlavorsWithContext(ctx, options)
+			if err != nil {
+				return nil, fmt.Errorf("%s\n%s", err, response)
+			}
+			names := make(map[string]bool, len(zoneFlavors))
+			for _, f := range zoneFlavors {
+				if f.Name != nil {
+					names[*f.Name] = true
+				}
+			}
+			return names, nil
+		})
+		if err != nil {
+			log.Printf("[WARN] Unable to list the flavors of the zone %s, the %s %s is not validated: %s", zone, flavorKey, flavor, err)
+			continue
+		}
+		if !flavors.(map[string]bool)[flavor] {
+			return fmt.Errorf("[ERROR] The %s %s is not available in the zone %s", flavorKey, flavor, zone)
+		}
+	}
+	return nil
+}
@@ -496,7 +496,7 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
@@ -14,6 +14,7 @@ import (
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func ResourceIBMContainerALB() *schema.Resource {
@@ -254,7 +255,7 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
@@ -293,7 +294,7 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
@@ -246,7 +246,7 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -366,5 +366,5 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
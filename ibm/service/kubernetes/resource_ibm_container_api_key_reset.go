@@ -60,7 +60,7 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 			return err
 		}
 		if targetEnv.ResourceGroup == "" {
-			defaultRg, err := flex.DefaultResourceGroup(meta)
+			defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 			if err != nil {
 				return err
 			}
@@ -67,7 +67,13 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerKubeVersionCustomizeDiff(diff, v)
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerFlavorCustomizeDiff(ctx, diff, v, "machine_type", []string{diff.Get("datacenter").(string)}, "classic")
 			},
 		),
 
@@ -436,6 +442,13 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -870,6 +883,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1259,7 +1273,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
@@ -1363,7 +1377,7 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
@@ -1384,7 +1398,7 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1435,7 +1449,7 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
@@ -1494,7 +1508,7 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
@@ -1515,7 +1529,7 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1569,7 +1583,7 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
@@ -1589,7 +1603,7 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1624,7 +1638,7 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
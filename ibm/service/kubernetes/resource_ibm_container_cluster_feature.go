@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -159,12 +160,12 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	if v, ok := d.GetOkExists("reload_workers"); ok {
 		if v.(bool) {
 			log.Printf("Waiting for cluster (%s) to be available.", cluster)
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", cluster, err)
 			}
 			log.Printf("Waiting for workers (%s) to be available.", cluster)
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", cluster, err)
 			}
@@ -183,11 +184,11 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 			if err != nil {
 				return err
 			}
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 			}
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 			}
@@ -210,12 +211,12 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 		return err
 	}
 	log.Printf("Waiting for cluster (%s) to be available.", cluster)
-	_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForClusterAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 	}
 	log.Printf("Waiting for workers (%s) to be available.", cluster)
-	_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForWorkerAvailableForFeatureUpdate(flex.OperationContext(d), cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 	}
@@ -308,7 +309,7 @@ func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta inter
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -325,10 +326,10 @@ func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -345,5 +346,5 @@ func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duratio
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
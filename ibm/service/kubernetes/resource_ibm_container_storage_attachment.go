@@ -282,7 +282,7 @@ func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), createStateConf)
 }
 
 func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -327,5 +327,5 @@ func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (i
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
@@ -261,7 +261,7 @@ func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMContainerVpcALBDelete(d *schema.ResourceData, meta interface{}) error {
@@ -317,5 +317,5 @@ func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID,
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	return createStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), createStateConf)
 }
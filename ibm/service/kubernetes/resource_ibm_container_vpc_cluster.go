@@ -36,7 +36,7 @@ const (
 )
 
 func ResourceIBMContainerVpcCluster() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithDeletionProtection(&schema.Resource{
 		Create:   resourceIBMContainerVpcClusterCreate,
 		Read:     resourceIBMContainerVpcClusterRead,
 		Update:   resourceIBMContainerVpcClusterUpdate,
@@ -46,7 +46,17 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerKubeVersionCustomizeDiff(diff, v)
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				zones := []string{}
+				for _, zone := range diff.Get("zones").(*schema.Set).List() {
+					zones = append(zones, zone.(map[string]interface{})["name"].(string))
+				}
+				return resourceIBMContainerFlavorCustomizeDiff(ctx, diff, v, "flavor", zones, "vpc-gen2")
 			},
 		),
 
@@ -240,6 +250,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -396,7 +413,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
 		},
-	}
+	})
 }
 
 func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
@@ -550,7 +567,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -811,7 +828,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err)
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(flex.OperationContext(d), clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
 					return fmt.Errorf(
 						"[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err)
@@ -830,7 +847,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	return resourceIBMContainerVpcClusterRead(d, meta)
 }
-func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForV2WorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -844,7 +861,7 @@ func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -949,6 +966,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1002,7 +1020,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	sess1, err := vpcClient(meta)
 	if err == nil {
 		listlbOptions := &vpcv1.ListLoadBalancersOptions{}
-		lbs, response, err1 := sess1.ListLoadBalancers(listlbOptions)
+		lbs, response, err1 := sess1.ListLoadBalancersWithContext(flex.OperationContext(d), listlbOptions)
 		if err1 != nil {
 			log.Printf("Error Retrieving vpc load balancers: %s\n%s", err, response)
 		}
@@ -1011,7 +1029,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 				if strings.Contains(*lb.Name, clusterID) {
 					log.Println("Deleting Load Balancer", *lb.Name)
 					id := *lb.ID
-					_, err = isWaitForLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+					_, err = isWaitForLBDeleted(flex.OperationContext(d), sess1, id, d.Timeout(schema.TimeoutDelete))
 					if err != nil {
 						log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1028,27 +1046,27 @@ func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	sess, err := meta.(conns.ClientSession).VpcV1API()
 	return sess, err
 }
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", "deleting"},
 		Target:     []string{"done", "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
+		Refresh:    isLBDeleteRefreshFunc(ctx, lbc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
-func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLBDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb delete function here")
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := lbc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lb, "done", nil
@@ -1088,7 +1106,7 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		PollInterval: 5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), deleteStateConf)
 }
 
 func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1129,7 +1147,7 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), createStateConf)
 }
 
 func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1163,7 +1181,7 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), createStateConf)
 }
 
 func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1197,7 +1215,7 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), createStateConf)
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.ClusterTargetHeader, error) {
@@ -1253,7 +1271,7 @@ func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1291,7 +1309,7 @@ func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
@@ -1334,7 +1352,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), deleteStateConf)
 }
 
 func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
@@ -1362,7 +1380,7 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -142,7 +143,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerPool, zone))
 
-	_, err = WaitForWorkerZoneNormal(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerZoneNormal(flex.OperationContext(d), cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err)
 	}
@@ -153,7 +154,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if waitTillALBs {
-		_, err = waitForWorkerZoneALB(cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = waitForWorkerZoneALB(flex.OperationContext(d), cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err)
 		}
@@ -261,7 +262,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData,
 	if err != nil {
 		return err
 	}
-	_, err = WaitForWorkerZoneDeleted(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForWorkerZoneDeleted(flex.OperationContext(d), cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err)
 	}
@@ -310,7 +311,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData,
 	return zone.ID == zoneID, nil
 }
 
-func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneNormal(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -325,7 +326,7 @@ func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, m
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -348,7 +349,7 @@ func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNam
 	}
 }
 
-func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -363,7 +364,7 @@ func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -384,7 +385,7 @@ func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerP
 	}
 }
 
-func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func waitForWorkerZoneALB(ctx context.Context, clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -399,7 +400,7 @@ func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
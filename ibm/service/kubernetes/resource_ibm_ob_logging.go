@@ -145,7 +145,7 @@ func waitForClusterIntegration(d *schema.ResourceData, meta interface{}, cluster
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
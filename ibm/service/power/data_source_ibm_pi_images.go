@@ -9,6 +9,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,7 +25,7 @@ Datasource to get the list of images that are available when a power instance is
 */
 func DataSourceIBMPIImages() *schema.Resource {
 
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		ReadContext: dataSourceIBMPIImagesAllRead,
 		Schema: map[string]*schema.Schema{
 
@@ -73,7 +74,7 @@ func DataSourceIBMPIImages() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "image_info")
 }
 
 func dataSourceIBMPIImagesAllRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -90,9 +91,14 @@ func dataSourceIBMPIImagesAllRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	images, err := flex.FilterItems(d, flattenStockImages(imagedata.Images), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
-	d.Set("image_info", flattenStockImages(imagedata.Images))
+	d.Set("image_info", images)
 
 	return nil
 
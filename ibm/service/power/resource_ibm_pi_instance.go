@@ -777,7 +777,7 @@ func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceCli
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
@@ -808,7 +808,7 @@ func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceC
 		Timeout:    120 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadyStatus string) resource.StateRefreshFunc {
@@ -850,7 +850,7 @@ func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceCli
 		Timeout:    30 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
@@ -955,7 +955,7 @@ func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClie
 		Timeout:    60 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id string) resource.StateRefreshFunc {
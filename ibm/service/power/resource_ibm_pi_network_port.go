@@ -187,7 +187,7 @@ func isWaitForIBMPINetworkPortAvailable(ctx context.Context, client *st.IBMPINet
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, networkname string) resource.StateRefreshFunc {
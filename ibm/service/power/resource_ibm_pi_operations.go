@@ -151,7 +151,7 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "stop" || operation == "immediate-shutdown" {
 		var targetStatus = "SHUTOFF"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(flex.OperationContext(d), client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
 			return err
 		} else {
@@ -163,7 +163,7 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "start" || operation == "soft-reboot" || operation == "hard-reboot" {
 		var targetStatus = "ACTIVE"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(flex.OperationContext(d), client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
 			return err
 		}
@@ -235,7 +235,7 @@ func resourceIBMPIOperationsExists(d *schema.ResourceData, meta interface{}) (bo
 	return instance.PvmInstanceID == &id, nil
 }
 
-func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
+func isWaitForPIInstanceOperationStatus(ctx context.Context, client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
 
 	log.Printf("Waiting for the Operation [ %s ] to be performed on the instance with name [ %s ]", operation, name)
 
@@ -248,7 +248,7 @@ func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name str
 		Timeout:    120 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 
 }
 
@@ -338,7 +338,7 @@ func isWaitForIBMPIVolumeAvailable(ctx context.Context, client *st.IBMPIVolumeCl
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id string) resource.StateRefreshFunc {
@@ -365,7 +365,7 @@ func isWaitForIBMPIVolumeDeleted(ctx context.Context, client *st.IBMPIVolumeClie
 		MinTimeout: 2 * time.Minute,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeDeleteRefreshFunc(client *st.IBMPIVolumeClient, id string) resource.StateRefreshFunc {
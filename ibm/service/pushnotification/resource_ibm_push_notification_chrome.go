@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,7 +48,7 @@ func resourceApplicationChromeCreate(d *schema.ResourceData, meta interface{}) e
 	websiteURL := d.Get("web_site_url").(string)
 	guid := d.Get("guid").(string)
 
-	_, response, err := pnClient.SaveChromeWebConf(&pushservicev1.SaveChromeWebConfOptions{
+	_, response, err := pnClient.SaveChromeWebConfWithContext(flex.OperationContext(d), &pushservicev1.SaveChromeWebConfOptions{
 		ApplicationID: &guid,
 		ApiKey:        &serverKey,
 		WebSiteURL:    &websiteURL,
@@ -78,7 +79,7 @@ func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) err
 
 	guid := d.Id()
 
-	chromeWebConf, response, err := pnClient.GetChromeWebConf(&pushservicev1.GetChromeWebConfOptions{
+	chromeWebConf, response, err := pnClient.GetChromeWebConfWithContext(flex.OperationContext(d), &pushservicev1.GetChromeWebConfOptions{
 		ApplicationID: &guid,
 	})
 
@@ -106,7 +107,7 @@ func resourceApplicationChromeDelete(d *schema.ResourceData, meta interface{}) e
 	}
 	guid := d.Get("guid").(string)
 
-	response, err := pnClient.DeleteChromeWebConf(&pushservicev1.DeleteChromeWebConfOptions{
+	response, err := pnClient.DeleteChromeWebConfWithContext(flex.OperationContext(d), &pushservicev1.DeleteChromeWebConfOptions{
 		ApplicationID: &guid,
 	})
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -89,7 +90,7 @@ func dataIBMContainerRegistryNamespacesRead(context context.Context, d *schema.R
 
 	listNamespaceDetailsOptions := &containerregistryv1.ListNamespaceDetailsOptions{}
 
-	namespaceDetailsList, _, err := containerRegistryClient.ListNamespaceDetails(listNamespaceDetailsOptions)
+	namespaceDetailsList, _, err := containerRegistryClient.ListNamespaceDetailsWithContext(flex.OperationContext(d), listNamespaceDetailsOptions)
 	if err != nil {
 		return diag.FromErr(err)
 	}
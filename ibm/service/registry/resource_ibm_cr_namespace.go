@@ -119,7 +119,7 @@ func resourceIBMCrNamespaceCreate(context context.Context, d *schema.ResourceDat
 	if _, ok := d.GetOk("resource_group_id"); ok {
 		createNamespaceOptions.SetXAuthResourceGroup(d.Get("resource_group_id").(string))
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceGroupQuota is the service instance limit of the quota of a resource group, and the
+// number of service instances of the group
+type resourceGroupQuota struct {
+	name      string
+	limit     int64
+	instances int64
+}
+
+// resourceIBMResourceInstanceQuotaCustomizeDiff checks that the quota of the resource group of a
+// new instance, listed by the ibm_resource_quota data source, is not exhausted when the provider
+// plan_time_validation is enabled. The quota is validated when the resource_group_id is known at
+// plan time.
+func resourceIBMResourceInstanceQuotaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	cache := flex.PlanTimeValidation(meta)
+	if cache == nil {
+		return nil
+	}
+	groupID, ok := flex.PlanTimeValidationValue(diff, "resource_group_id")
+	if !ok {
+		return nil
+	}
+	quota, err := cache.Load("resource_quota/"+groupID, func() (interface{}, error) {
+		return loadResourceGroupQuota(ctx, meta, groupID)
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to read the resource quota of the resource group %s, the quota is not validated: %s", groupID, err)
+		return nil
+	}
+	if q := quota.(*resourceGroupQuota); q.limit > 0 && q.instances >= q.limit {
+		return fmt.Errorf("[ERROR] The resource quota %s of the resource group %s is exhausted, the group has %d service instances out of %d", q.name, groupID, q.instances, q.limit)
+	}
+	return nil
+}
+
+// loadResourceGroupQuota reads the quota definition of the resource group and counts the service
+// instances of the group, the limit is 0 when the quota does not limit the service instances
+func loadResourceGroupQuota(ctx context.Context, meta interface{}, groupID string) (*resourceGroupQuota, error) {
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return nil, err
+	}
+	group, response, err := rMgtClient.GetResourceGroupWithContext(ctx, &rg.GetResourceGroupOptions{ID: &groupID})
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s", err, response)
+	}
+	if group.QuotaID == nil {
+		return &resourceGroupQuota{}, nil
+	}
+	definition, response, err := rMgtClient.GetQuotaDefinitionWithContext(ctx, &rg.GetQuotaDefinitionOptions{ID: group.QuotaID})
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s", err, response)
+	}
+	quota := &resourceGroupQuota{name: *group.QuotaID}
+	if definition.Name != nil {
+		quota.name = *definition.Name
+	}
+	if definition.NumberOfServiceInstances == nil || *definition.NumberOfServiceInstances <= 0 {
+		return quota, nil
+	}
+	quota.limit = int64(*definition.NumberOfServiceInstances)
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	options := rsConClient.NewListResourceInstancesOptions()
+	options.SetResourceGroupID(groupID)
+	options.SetType("service_instance")
+	options.SetLimit(100)
+	for {
+		instances, response, err := rsConClient.ListResourceInstancesWithContext(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		quota.instances += int64(len(instances.Resources))
+		start := nextStart(instances)
+		if start == "" {
+			return quota, nil
+		}
+		options.SetStart(start)
+	}
+}
+
+// nextStart returns the start token of the next page of the instances, empty on the last page
+func nextStart(instances *rc.ResourceInstancesList) string {
+	if instances.NextURL == nil {
+		return ""
+	}
+	u, err := url.Parse(*instances.NextURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("start")
+}
@@ -35,7 +35,7 @@ const (
 )
 
 func ResourceIBMResourceInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithDeletionProtection(&schema.Resource{
 		Create:   ResourceIBMResourceInstanceCreate,
 		Read:     ResourceIBMResourceInstanceRead,
 		Update:   ResourceIBMResourceInstanceUpdate,
@@ -51,7 +51,10 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMResourceInstanceQuotaCustomizeDiff(ctx, diff, v)
 			},
 		),
 
@@ -117,6 +120,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_instance", "tag")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -347,7 +357,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "The extended metadata as a map associated with the resource instance.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMResourceInstanceValidator() *validate.ResourceValidator {
@@ -429,7 +439,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		rg := rsGrpID.(string)
 		rsInst.ResourceGroup = &rg
 	} else {
-		defaultRg, err := flex.DefaultResourceGroup(meta)
+		defaultRg, err := flex.DefaultResourceGroup(flex.OperationContext(d), meta)
 		if err != nil {
 			return err
 		}
@@ -472,7 +482,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	rsInst.Parameters = params
 
 	//Start to create resource instance
-	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, resp, err := rsConClient.CreateResourceInstanceWithContext(flex.OperationContext(d), &rsInst)
 	if err != nil {
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
@@ -488,7 +498,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -510,7 +520,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		ID: &instanceID,
 	}
 
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
@@ -521,6 +531,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 			"Error on get of resource instance tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -666,7 +677,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		ID: &instanceID,
 	}
 	if d.HasChange("parameters") {
-		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+		instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 		}
@@ -712,12 +723,12 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 			resourceInstanceUpdate.Parameters = params
 		}
 	}
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -726,7 +737,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+	_, resp, err = rsConClient.UpdateResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceUpdate)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
 	}
@@ -751,7 +762,7 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		Recursive: &recursive,
 	}
 
-	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
+	resp, error := rsConClient.DeleteResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceDelete)
 	if error != nil {
 		if resp != nil && resp.StatusCode == 410 {
 			return nil
@@ -778,7 +789,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 		ID: &instanceID,
 	}
 
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -808,7 +819,7 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
@@ -825,7 +836,7 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -842,7 +853,7 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
@@ -859,7 +870,7 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -875,7 +886,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceSuccessStatus},
 		Target:  []string{RsInstanceRemovedStatus, RsInstanceReclamation},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return instance, RsInstanceSuccessStatus, nil
@@ -892,7 +903,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func FilterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
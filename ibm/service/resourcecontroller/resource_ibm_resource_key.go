@@ -4,6 +4,7 @@
 package resourcecontroller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -248,7 +249,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	if r, ok := d.GetOk("role"); ok {
 		role := r.(string)
-		serviceRole, err := getRoleFromName(role, service.Name, meta)
+		serviceRole, err := getRoleFromName(flex.OperationContext(d), role, service.Name, meta)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
 		}
@@ -256,7 +257,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		resourceKeyCreate.Role = serviceRole.RoleID
 	}
 
-	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
+	resourceKey, resp, err := rsContClient.CreateResourceKeyWithContext(flex.OperationContext(d), &resourceKeyCreate)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
 	}
@@ -280,7 +281,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 		ID: &resourceKeyID,
 	}
 
-	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
+	resourceKey, resp, err := rsContClient.GetResourceKeyWithContext(flex.OperationContext(d), &resourceKeyGet)
 	if err != nil || resourceKey == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
 	}
@@ -317,7 +318,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 					AccountID:   resourceKey.AccountID,
 					ServiceName: &resourceCRN,
 				}
-				roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+				roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(flex.OperationContext(d), listRoleOptions)
 				roles := roleList.CustomRoles
 				if err == nil && len(roles) > 0 {
 					for _, role := range roles {
@@ -381,7 +382,7 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &resourceKeyID,
 	}
 
-	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
+	resp, err := rsContClient.DeleteResourceKeyWithContext(flex.OperationContext(d), &resourceKeyDelete)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
 	}
@@ -401,7 +402,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 		ID: &resourceKeyID,
 	}
 
-	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
+	resourceKey, resp, err := rsContClient.GetResourceKeyWithContext(flex.OperationContext(d), &resourceKeyGet)
 	if err != nil {
 		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
 			return false, nil
@@ -425,7 +426,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 		resourceInstanceGet := rc.GetResourceInstanceOptions{
 			ID: &insIdString,
 		}
-		instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+		instance, resp, err := rsContClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 		if err != nil {
 			log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 			return nil, nil, err
@@ -437,7 +438,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceAliasGet := rc.GetResourceAliasOptions{
 		ID: &aliasID,
 	}
-	alias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+	alias, resp, err := rsContClient.GetResourceAliasWithContext(flex.OperationContext(d), &resourceAliasGet)
 	if err != nil {
 		log.Printf("Error when get resource alias in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -445,7 +446,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: alias.ResourceInstanceID,
 	}
-	instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsContClient.GetResourceInstanceWithContext(flex.OperationContext(d), &resourceInstanceGet)
 	if err != nil {
 		log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -454,7 +455,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 
 }
 
-func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
+func getRoleFromName(ctx context.Context, roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
 
 	role := iampolicymanagementv1.PolicyRole{}
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
@@ -472,7 +473,7 @@ func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicym
 		ServiceName: &serviceName,
 	}
 
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 	if err != nil {
 		return role, err
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -118,7 +119,7 @@ func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 	} else if name != "" {
 		resourceGroupList.Name = &name
 	}
-	rg, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
+	rg, resp, err := rMgtClient.ListResourceGroupsWithContext(flex.OperationContext(d), &resourceGroupList)
 	if err != nil || rg == nil || rg.Resources == nil {
 		return fmt.Errorf("[ERROR] Error retrieving resource group: %s %s", err, resp)
 	}
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -108,7 +109,7 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 		AccountID: &accountID,
 	}
 
-	resourceGroup, resp, err := rMgtClient.CreateResourceGroup(&resourceGroupCreate)
+	resourceGroup, resp, err := rMgtClient.CreateResourceGroupWithContext(flex.OperationContext(d), &resourceGroupCreate)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating resource group: %s with response code  %s", err, resp)
 	}
@@ -128,7 +129,7 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 		ID: &resourceGroupID,
 	}
 
-	resourceGroup, resp, err := rMgtClient.GetResourceGroup(&resourceGroupGet)
+	resourceGroup, resp, err := rMgtClient.GetResourceGroupWithContext(flex.OperationContext(d), &resourceGroupGet)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Resource Group is not found")
@@ -196,7 +197,7 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if hasChange {
-		_, resp, err := rMgtClient.UpdateResourceGroup(&resourceGroupUpdate)
+		_, resp, err := rMgtClient.UpdateResourceGroupWithContext(flex.OperationContext(d), &resourceGroupUpdate)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating resource group: %s with response code  %s", err, resp)
 		}
@@ -216,7 +217,7 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 		ID: &resourceGroupID,
 	}
 
-	resp, err := rMgtClient.DeleteResourceGroup(&resourceGroupDelete)
+	resp, err := rMgtClient.DeleteResourceGroupWithContext(flex.OperationContext(d), &resourceGroupDelete)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Resource Group is not found")
@@ -240,7 +241,7 @@ func resourceIBMResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 		ID: &resourceGroupID,
 	}
 
-	resourceGroup, resp, err := rMgtClient.GetResourceGroup(&resourceGroupGet)
+	resourceGroup, resp, err := rMgtClient.GetResourceGroupWithContext(flex.OperationContext(d), &resourceGroupGet)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -199,7 +199,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 		getSatClusterOptions.XAuthResourceGroup = &resourceGrp
 	}
 
-	clusterFields, response, err := satClient.GetCluster(getSatClusterOptions)
+	clusterFields, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving cluster: %s\n%s", err, response)
 	}
@@ -209,7 +209,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 	if resourceGrp != "" {
 		getWorkersOptions.XAuthResourceGroup = &resourceGrp
 	}
-	workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
+	workerFields, response, err := satClient.GetWorkers1WithContext(flex.OperationContext(d), getWorkersOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving workers for satellite cluster: %s, %s", err, response)
 	}
@@ -228,7 +228,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 		getSatWorkerPoolOptions.XRegion = &wpRegion
 	}
 
-	workerPools, response, err := satClient.GetWorkerPools1(getSatWorkerPoolOptions)
+	workerPools, response, err := satClient.GetWorkerPools1WithContext(flex.OperationContext(d), getSatWorkerPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s\n%s", name, err, response)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -124,7 +125,7 @@ func dataSourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta in
 		getSatWorkerPoolOptions.XRegion = &wpRegion
 	}
 
-	workerPool, response, err := satClient.GetWorkerPool(getSatWorkerPoolOptions)
+	workerPool, response, err := satClient.GetWorkerPoolWithContext(flex.OperationContext(d), getSatWorkerPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving worker pool  %s: %s\n%s", name, err, response)
 	}
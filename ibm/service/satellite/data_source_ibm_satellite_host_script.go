@@ -87,7 +87,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	}
 
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		locData, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+		locData, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 		if err != nil || locData == nil {
 			if response != nil && response.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -98,7 +98,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		locData, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+		locData, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 	}
 	if err != nil || locData == nil {
 		return fmt.Errorf("[ERROR] Error getting Satellite location (%s): %s\n%s", location, err, response)
@@ -126,7 +126,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	createRegOptions.Controller = locData.ID
 	createRegOptions.Labels = labels
 
-	resp, err := satClient.AttachSatelliteHost(createRegOptions)
+	resp, err := satClient.AttachSatelliteHostWithContext(flex.OperationContext(d), createRegOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Generating Satellite Registration Script: %s\n%s", err, resp)
 	}
@@ -152,7 +152,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 	var instance *kubernetesserviceapiv1.MultishiftGetController
 	var response *core.DetailedResponse
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+		instance, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 		if err != nil || instance == nil {
 			if response != nil && response.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -163,7 +163,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+		instance, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 	}
 	if err != nil || instance == nil {
 		return fmt.Errorf("[ERROR] Error retrieving IBM cloud satellite location %s : %s\n%s", location, err, response)
@@ -192,7 +192,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 		Controller: &location,
 	}
 
-	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
+	hostList, response, err := satClient.GetSatelliteHostsWithContext(flex.OperationContext(d), getSatHostOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
 	}
@@ -52,7 +52,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 					Cluster: &ID,
 				}
 
-				cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+				cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 				if err != nil || cluster == nil {
 					if response != nil && response.StatusCode == 404 && strings.Contains(err.Error(), "The specified cluster could not be found") {
 						return nil, fmt.Errorf("Error reading satellite cluster: %s\n%s", err, response)
@@ -70,7 +70,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -246,6 +246,13 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			"host_labels": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -372,14 +379,14 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 		copier.Copy(createRemoteClusterOptions, createClusterOptions)
 		createRemoteClusterOptions.XAuthSupplemental = &crnToken
 
-		instance, response, err := satClient.CreateSatelliteClusterRemote(createRemoteClusterOptions)
+		instance, response, err := satClient.CreateSatelliteClusterRemoteWithContext(flex.OperationContext(d), createRemoteClusterOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response)
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster for remote location: %s", clusterId)
 	} else {
-		instance, response, err := satClient.CreateSatelliteCluster(createClusterOptions)
+		instance, response, err := satClient.CreateSatelliteClusterWithContext(flex.OperationContext(d), createClusterOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response)
 		}
@@ -407,7 +414,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 						zoneOptions.Headers = pathParamsMap
 					}
 
-					response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+					response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(flex.OperationContext(d), zoneOptions)
 					if err != nil {
 						return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
 					}
@@ -435,19 +442,19 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 			wpots.XAuthResourceGroup = &resourceGrp
 		}
 
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(flex.OperationContext(d), wpots)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
 		}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster: flex.PtrToString(clusterId),
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 		if err != nil || cluster == nil {
 			log.Printf(
 				"Error in retreiving ibm satellite cluster : %s\n%s", err, response)
@@ -481,7 +488,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 		Cluster: &ID,
 	}
 
-	cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+	cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 	if err != nil || cluster == nil {
 		if response != nil && response.StatusCode == 404 && strings.Contains(err.Error(), "The specified cluster could not be found") {
 			d.SetId("")
@@ -527,7 +534,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 		Workerpool: &workerPoolID,
 	}
 
-	workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	workerPool, response, err := satClient.GetWorkerPoolWithContext(flex.OperationContext(d), getWorkerPoolOptions)
 	if err != nil || workerPool == nil {
 		log.Printf(
 			"An error occured while retrieving default workerpool : %s\n%s", err, response)
@@ -539,6 +546,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set("default_worker_pool_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	d.Set("host_labels", flex.FlattenWorkerPoolHostLabels(workerPool.HostLabels))
 
@@ -591,7 +599,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		}
 		workerOpts := satClient.NewGetWorkers1Options(clusterID)
-		workerFields, response, err := satClient.GetWorkers1(workerOpts)
+		workerFields, response, err := satClient.GetWorkers1WithContext(flex.OperationContext(d), workerOpts)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response)
 		}
@@ -601,7 +609,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response)
 		}
@@ -640,7 +648,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		response, err := satClient.V2ResizeWorkerPool(resizeOpts)
+		response, err := satClient.V2ResizeWorkerPoolWithContext(flex.OperationContext(d), resizeOpts)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
 		}
@@ -660,21 +668,21 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			Labels:             labels,
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(flex.OperationContext(d), wpots)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
 		}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster:            &clusterID,
 			XAuthResourceGroup: &targetEnv.ResourceGroup,
 		}
 
-		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
+		cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getSatClusterOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response)
 		}
@@ -706,7 +714,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					Workerpool: &workerPoolName,
 					ID:         &zID,
 				}
-				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+				response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(flex.OperationContext(d), zoneOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
 				}
@@ -725,7 +733,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					PoolidOrName: &workerPoolName,
 					Zoneid:       &zID,
 				}
-				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
+				response, err := satClient.RemoveWorkerPoolZoneWithContext(flex.OperationContext(d), zoneOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
 				}
@@ -746,7 +754,7 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	removeClusterOptions.IdOrName = &name
 
-	response, err := satClient.RemoveCluster(removeClusterOptions)
+	response, err := satClient.RemoveClusterWithContext(flex.OperationContext(d), removeClusterOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
 	}
@@ -781,7 +789,7 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 			var response *core.DetailedResponse
 			var err error
 			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				instance, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 				if err != nil || instance == nil {
 					if response != nil && response.StatusCode == 404 {
 						return resource.RetryableError(err)
@@ -792,7 +800,7 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 			})
 
 			if conns.IsResourceTimeoutError(err) {
-				instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				instance, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 			}
 
 			if instance != nil {
@@ -808,7 +816,7 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -824,7 +832,7 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 			getClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 				Cluster: &cluster,
 			}
-			instance, response, err := satClient.GetCluster(getClusterOptions)
+			instance, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getClusterOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting cluster : %s\n%s", err, response)
 			}
@@ -842,7 +850,7 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -858,7 +866,7 @@ func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interfa
 			getClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 				Cluster: &cluster,
 			}
-			cluster, response, err := satClient.GetCluster(getClusterOptions)
+			cluster, response, err := satClient.GetClusterWithContext(flex.OperationContext(d), getClusterOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return cluster, isClusterDeleteDone, nil
@@ -872,7 +880,7 @@ func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 //  WaitForSatelliteWorkerVersionUpdate Waits for worker creation
@@ -889,7 +897,7 @@ func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{
 			log.Printf("Waiting for workers of the cluster (%s) to update version.", d.Id())
 			id := d.Id()
 			workerOpts := satClient.NewGetWorkers1Options(id)
-			workerFields, _, err := satClient.GetWorkers1(workerOpts)
+			workerFields, _, err := satClient.GetWorkers1WithContext(flex.OperationContext(d), workerOpts)
 			if err != nil {
 				return nil, "", err
 			}
@@ -911,7 +919,7 @@ func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 // WaitForSatelliteClusterVersionUpdate Waits for cluster creation
@@ -933,7 +941,7 @@ func WaitForSatelliteClusterVersionUpdate(d *schema.ResourceData, meta interface
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func satelliteClusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -58,7 +59,7 @@ func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 					Cluster:    &clusterID,
 					Workerpool: &workerPoolID,
 				}
-				workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+				workerPool, response, err := satClient.GetWorkerPoolWithContext(flex.OperationContext(d), getWorkerPoolOptions)
 				if err != nil {
 					return nil, fmt.Errorf("Error reading satellite worker pool: %s\n%s", response, err)
 				}
@@ -249,7 +250,7 @@ func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta in
 		createWorkerPoolOptions.Isolation = &isolation
 	}
 
-	instance, response, err := satClient.CreateSatelliteWorkerPool(createWorkerPoolOptions)
+	instance, response, err := satClient.CreateSatelliteWorkerPoolWithContext(flex.OperationContext(d), createWorkerPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response)
 	}
@@ -283,7 +284,7 @@ func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta inte
 		Workerpool: &workerPoolID,
 	}
 
-	workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	workerPool, response, err := satClient.GetWorkerPoolWithContext(flex.OperationContext(d), getWorkerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -334,7 +335,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 			Workerpool: &workerPoolName,
 			Labels:     labels,
 		}
-		response, err := satClient.V2SetWorkerPoolLabels(wpots)
+		response, err := satClient.V2SetWorkerPoolLabelsWithContext(flex.OperationContext(d), wpots)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
 		}
@@ -384,7 +385,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 					Workerpool: &workerPoolName,
 					ID:         &zID,
 				}
-				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
+				response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(flex.OperationContext(d), zoneOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
 				}
@@ -403,7 +404,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 					PoolidOrName: &workerPoolName,
 					Zoneid:       &zID,
 				}
-				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
+				response, err := satClient.RemoveWorkerPoolZoneWithContext(flex.OperationContext(d), zoneOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
 				}
@@ -436,7 +437,7 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		PoolidOrName: &workerPoolID,
 	}
 
-	response, err := satClient.RemoveWorkerPool(wpOptions)
+	response, err := satClient.RemoveWorkerPoolWithContext(flex.OperationContext(d), wpOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -444,7 +445,7 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		return fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response)
 	}
 
-	_, err = WaitForSatelliteWorkerDelete(clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForSatelliteWorkerDelete(flex.OperationContext(d), clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterID, err)
 	}
@@ -482,7 +483,7 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 				XAuthResourceGroup: &target.ResourceGroup,
 			}
 
-			workers, response, err := satClient.GetWorkers1(getWorkersOptions)
+			workers, response, err := satClient.GetWorkers1WithContext(flex.OperationContext(d), getWorkersOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
 			}
@@ -504,10 +505,10 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
-func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return nil, err
@@ -516,16 +517,16 @@ func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, me
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"deleting"},
 		Target:     []string{workerDeleteState},
-		Refresh:    satelliteWorkerPoolDeleteStateRefreshFunc(satClient, clusterNameOrID, workerPoolNameOrID, target),
+		Refresh:    satelliteWorkerPoolDeleteStateRefreshFunc(ctx, satClient, clusterNameOrID, workerPoolNameOrID, target),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, clusterID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+func satelliteWorkerPoolDeleteStateRefreshFunc(ctx context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, clusterID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 
 	return func() (interface{}, string, error) {
 
@@ -534,7 +535,7 @@ func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1
 			XAuthResourceGroup: &target.ResourceGroup,
 		}
 
-		workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
+		workerFields, response, err := satClient.GetWorkers1WithContext(ctx, getWorkersOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
 		}
@@ -81,7 +81,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentCreate(context context.C
 		createSatelliteWorkerPoolZoneOptions.SetXAuthResourceGroup(d.Get("resource_group_id").(string))
 	}
 
-	response, err := satClient.CreateSatelliteWorkerPoolZone(createSatelliteWorkerPoolZoneOptions)
+	response, err := satClient.CreateSatelliteWorkerPoolZoneWithContext(flex.OperationContext(d), createSatelliteWorkerPoolZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response))
@@ -142,7 +142,7 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if hostStatus == rsHostReadyStatus {
-		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+		_, response, err := satClient.CreateSatelliteAssignmentWithContext(flex.OperationContext(d), hostAssignOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response)
 		}
@@ -178,7 +178,7 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
 		Controller: &location,
 	}
-	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	hostList, resp, err := satClient.GetSatelliteHostsWithContext(flex.OperationContext(d), hostOptions)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
@@ -242,7 +242,7 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 			labels = flex.FlattenHostLabels(l.List())
 			updateHostOptions.Labels = labels
 		}
-		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
+		response, err := satClient.UpdateSatelliteHostWithContext(flex.OperationContext(d), updateHostOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response)
 		}
@@ -268,7 +268,7 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 	removeSatHostOptions.Controller = &location
 	removeSatHostOptions.HostID = &hostID
 
-	response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+	response, err := satClient.RemoveSatelliteHostWithContext(flex.OperationContext(d), removeSatHostOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -293,7 +293,7 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 			attachOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
 				Controller: &location,
 			}
-			hostList, resp, err := satClient.GetSatelliteHosts(attachOptions)
+			hostList, resp, err := satClient.GetSatelliteHostsWithContext(flex.OperationContext(d), attachOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() != 404 {
 					return nil, "", fmt.Errorf("[ERROR] The satellite host (%s) failed to attached: %v\n%s", hostName, err, resp)
@@ -316,5 +316,5 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
@@ -195,7 +195,7 @@ func resourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceDat
 		Controller: flex.PtrToString(d.Id()),
 	}
 
-	locInstance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+	locInstance, response, err := satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 	if err != nil || locInstance == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
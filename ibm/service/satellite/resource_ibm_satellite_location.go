@@ -49,7 +49,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 					Controller: &ID,
 				}
 
-				instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+				instance, response, err := satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 				if err != nil || instance == nil {
 					return nil, fmt.Errorf("Error reading satellite location: %s\n%s", err, response)
 				}
@@ -61,7 +61,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ImmutableResourceCustomizeDiff([]string{satLocation, sateLocZone, "resource_group_id", "zones"}, diff)
@@ -167,6 +167,13 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags associated with resource instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			flex.ResourceGroupName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -265,7 +272,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 		createSatLocOptions.Headers = pathParamsMap
 	}
 
-	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
+	instance, response, err := satClient.CreateSatelliteLocationWithContext(flex.OperationContext(d), createSatLocOptions)
 	if err != nil || instance == nil {
 		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
 	}
@@ -274,7 +281,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created satellite location : %s", satLocation)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -303,7 +310,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 		Controller: &ID,
 	}
 
-	instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+	instance, response, err := satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 	if err != nil || instance == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -331,6 +338,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 			"Error on get of ibm satellite location tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set("crn", *instance.Crn)
 	d.Set(flex.ResourceGroupName, *instance.ResourceGroupName)
 	if instance.Hosts != nil {
@@ -354,13 +362,13 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 			Controller: &ID,
 		}
 
-		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+		instance, response, err := satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response)
 		}
@@ -383,7 +391,7 @@ func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}
 	name := d.Get(satLocation).(string)
 	removeSatLocOptions.Controller = &name
 
-	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
+	response, err := satClient.RemoveSatelliteLocationWithContext(flex.OperationContext(d), removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
 		return fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response)
 	}
@@ -409,7 +417,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		Target:  []string{isLocationDeleteDone},
 		Refresh: func() (interface{}, string, error) {
 			getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationsOptions{}
-			locations, response, err := satClient.GetSatelliteLocations(getSatLocOptions)
+			locations, response, err := satClient.GetSatelliteLocationsWithContext(flex.OperationContext(d), getSatLocOptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting locations list to delete : %s\n%s", err, response)
 			}
@@ -433,7 +441,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -454,7 +462,7 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 			var response *core.DetailedResponse
 			var err error
 			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				location, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				location, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 				if err != nil || location == nil {
 					if response != nil && response.StatusCode == 404 {
 						return resource.RetryableError(err)
@@ -465,7 +473,7 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 			})
 
 			if conns.IsResourceTimeoutError(err) {
-				location, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+				location, response, err = satClient.GetSatelliteLocationWithContext(flex.OperationContext(d), getSatLocOptions)
 			}
 
 			if location != nil && *location.State == isLocationDeployFailed {
@@ -482,5 +490,5 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
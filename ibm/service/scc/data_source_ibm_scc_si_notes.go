@@ -355,7 +355,8 @@ func DataSourceIBMSccSiNotesValidator() *validate.ResourceValidator {
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "page_size",
-			ValidateFunctionIdentifier: validate.IntBetween,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
 			Required:                   false,
 			MinValue:                   "2"})
 
@@ -301,7 +301,8 @@ func DataSourceIBMSccSiOccurrencesValidator() *validate.ResourceValidator {
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "page_size",
-			ValidateFunctionIdentifier: validate.IntBetween,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
 			Required:                   false,
 			MinValue:                   "2"})
 
@@ -104,7 +104,7 @@ func ResourceIBMSccPostureCollectorsValidator() *validate.ResourceValidator {
 		},
 	)
 
-	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_collectors", Schema: validateSchema}
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_scc_posture_collector", Schema: validateSchema}
 	return &resourceValidator
 }
 
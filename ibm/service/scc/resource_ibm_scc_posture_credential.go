@@ -137,7 +137,7 @@ func ResourceIBMSccPostureCredentialsValidator() *validate.ResourceValidator {
 		},
 	)
 
-	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_scc_posture_credentials", Schema: validateSchema}
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_scc_posture_credential", Schema: validateSchema}
 	return &resourceValidator
 }
 
@@ -61,7 +61,7 @@ func dataSourceIBMSchematicsOutputRead(d *schema.ResourceData, meta interface{})
 
 	getWorkspaceOutputsOptions.SetWID(d.Get("workspace_id").(string))
 
-	outputValuesList, response, err := schematicsClient.GetWorkspaceOutputs(getWorkspaceOutputsOptions)
+	outputValuesList, response, err := schematicsClient.GetWorkspaceOutputsWithContext(flex.OperationContext(d), getWorkspaceOutputsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetWorkspaceOutputs failed %s\n%s", err, response)
 		return err
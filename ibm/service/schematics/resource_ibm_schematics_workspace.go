@@ -1651,7 +1651,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 					Variablestore: workspaceVariableRequestModel,
 				}
 
-				_, response, err := schematicsClient.ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions)
+				_, response, err := schematicsClient.ReplaceWorkspaceInputsWithContext(flex.OperationContext(d), replaceWorkspaceInputsOptions)
 				if err != nil {
 					log.Printf("[DEBUG] ReplaceWorkspaceInputs failed %s\n%s", err, response)
 					return diag.FromErr(fmt.Errorf("ReplaceWorkspaceInputs failed %s\n%s", err, response))
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -291,7 +292,7 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 		ID:         &secretID,
 	}
 
-	getSecret, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+	getSecret, response, err := secretsManagerClient.GetSecretWithContext(flex.OperationContext(d), getSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -147,7 +148,7 @@ func dataSourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
-	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
+	listTransitGateways, response, err := client.ListTransitGatewaysWithContext(flex.OperationContext(d), listTransitGatewaysOptionsModel)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while listing transit gateways %s\n%s", err, response)
 	}
@@ -195,7 +196,7 @@ func dataSourceIBMTransitGatewayConnectionsRead(d *schema.ResourceData, meta int
 	log.Println("tgGatewayId: ", tgGatewayId)
 
 	listTransitGatewayConnectionsOptions.SetTransitGatewayID(tgGatewayId)
-	listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
+	listTGConnections, response, err := client.ListTransitGatewayConnectionsWithContext(flex.OperationContext(d), listTransitGatewayConnectionsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while listing transit gateway connections %s\n%s", err, response)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -73,7 +74,7 @@ func dataSourceIBMTransitGatewaysRead(d *schema.ResourceData, meta interface{})
 	}
 
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
-	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
+	listTransitGateways, response, err := client.ListTransitGatewaysWithContext(flex.OperationContext(d), listTransitGatewaysOptionsModel)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while listing transit gateways %s\n%s", err, response)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -74,7 +75,7 @@ func dataSourceIBMTransitGatewaysLocationRead(d *schema.ResourceData, meta inter
 	detailGatewayLocationOptionsModel := &transitgatewayapisv1.GetGatewayLocationOptions{}
 	locName := d.Get(tgLocationsName).(string)
 	detailGatewayLocationOptionsModel.Name = &locName
-	detailTransitGatewayLocation, response, err := client.GetGatewayLocation(detailGatewayLocationOptionsModel)
+	detailTransitGatewayLocation, response, err := client.GetGatewayLocationWithContext(flex.OperationContext(d), detailGatewayLocationOptionsModel)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while fetching transit gateway detailed location: %s\n%s", err, response)
 	}
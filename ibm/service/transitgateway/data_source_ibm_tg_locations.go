@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -59,7 +60,7 @@ func dataSourceIBMTransitGatewaysLocationsRead(d *schema.ResourceData, meta inte
 	}
 
 	listTransitGatewayLocationsOptionsModel := &transitgatewayapisv1.ListGatewayLocationsOptions{}
-	listTransitGatewayLocations, response, err := client.ListGatewayLocations(listTransitGatewayLocationsOptionsModel)
+	listTransitGatewayLocations, response, err := client.ListGatewayLocationsWithContext(flex.OperationContext(d), listTransitGatewayLocationsOptionsModel)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while fetching transit gateways locations: %s\n%s", err, response)
 	}
@@ -55,7 +55,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -91,6 +91,13 @@ func ResourceIBMTransitGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the transit gateway instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			tgResourceGroup: {
 				Type:     schema.TypeString,
@@ -206,7 +213,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	//log.Println("going to create tgw now with options", *createTransitGatewayOptions.ResourceGroup)
-	tgw, response, err := client.CreateTransitGateway(createTransitGatewayOptions)
+	tgw, response, err := client.CreateTransitGatewayWithContext(flex.OperationContext(d), createTransitGatewayOptions)
 
 	if err != nil {
 		log.Printf("[DEBUG] Create Transit Gateway err %s\n%s", err, response)
@@ -214,14 +221,14 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(*tgw.ID)
 
-	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayAvailable(flex.OperationContext(d), client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return err
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -232,27 +239,27 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	return resourceIBMTransitGatewayRead(d, meta)
 }
 
-func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayProvisioning},
 		Target:     []string{isTransitGatewayProvisioningDone, ""},
-		Refresh:    isTransitGatewayRefreshFunc(client, id),
+		Refresh:    isTransitGatewayRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		gettgwoptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 			ID: &id,
 		}
-		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
+		transitGateway, response, err := client.GetTransitGatewayWithContext(ctx, gettgwoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway: %s\n%s", err, response)
 		}
@@ -276,7 +283,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 		tgOptions.ID = &id
 	}
 
-	tgw, response, err := client.GetTransitGateway(tgOptions)
+	tgw, response, err := client.GetTransitGatewayWithContext(flex.OperationContext(d), tgOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -303,6 +310,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			"Error on get of transit gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(tgGatewayTags, tags)
+	d.Set(flex.TagsAll, tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -332,7 +340,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &ID,
 	}
-	tgw, resp, err := client.GetTransitGateway(tgOptions)
+	tgw, resp, err := client.GetTransitGatewayWithContext(flex.OperationContext(d), tgOptions)
 
 	if err != nil {
 		log.Printf("Error fetching Tranisit  Gateway: %s", resp)
@@ -353,7 +361,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 			updateTransitGatewayOptions.Global = &global
 		}
 	}
-	if d.HasChange(tgGatewayTags) {
+	if d.HasChange(tgGatewayTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -362,7 +370,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
+	_, response, err := client.UpdateTransitGatewayWithContext(flex.OperationContext(d), updateTransitGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
 		return err
@@ -382,7 +390,7 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 	delOptions := &transitgatewayapisv1.DeleteTransitGatewayOptions{
 		ID: &ID,
 	}
-	response, err := client.DeleteTransitGateway(delOptions)
+	response, err := client.DeleteTransitGatewayWithContext(flex.OperationContext(d), delOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -390,7 +398,7 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		}
 		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
 	}
-	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForTransitGatewayDeleted(flex.OperationContext(d), client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -398,28 +406,28 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayDeleting},
 		Target:     []string{"", isTransitGatewayDeleted},
-		Refresh:    isTransitGatewayDeleteRefreshFunc(client, id),
+		Refresh:    isTransitGatewayDeleteRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayDeleteRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] tg gateway delete function here")
 		gettgwoptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 			ID: &id,
 		}
-		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
+		transitGateway, response, err := client.GetTransitGatewayWithContext(ctx, gettgwoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return transitGateway, isTransitGatewayDeleted, nil
@@ -443,7 +451,7 @@ func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (
 	if ID != "" {
 		tgOptions.ID = &ID
 	}
-	_, response, err := client.GetTransitGateway(tgOptions)
+	_, response, err := client.GetTransitGatewayWithContext(flex.OperationContext(d), tgOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
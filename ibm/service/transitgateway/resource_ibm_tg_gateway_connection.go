@@ -4,6 +4,7 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -255,7 +256,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		createTransitGatewayConnectionOptions.SetZone(zoneIdentity)
 	}
 
-	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	tgConnections, response, err := client.CreateTransitGatewayConnectionWithContext(flex.OperationContext(d), createTransitGatewayConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
 	}
@@ -267,28 +268,28 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		d.Set(tgNetworkAccountID, *tgConnections.NetworkAccountID)
 		return resourceIBMTransitGatewayConnectionRead(d, meta)
 	}
-	_, err = isWaitForTransitGatewayConnectionAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionAvailable(flex.OperationContext(d), client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return err
 	}
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
 }
-func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayConnectionPending},
 		Target:     []string{isTransitGatewayConnectionAttached, ""},
-		Refresh:    isTransitGatewayConnectionRefreshFunc(client, id),
+		Refresh:    isTransitGatewayConnectionRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
-func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayConnectionRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -302,7 +303,7 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 		getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 		getTransitGatewayConnectionOptions.SetID(ID)
-		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
 		}
@@ -330,7 +331,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 	getTransitGatewayConnectionOptions.SetID(ID)
-	instance, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	instance, response, err := client.GetTransitGatewayConnectionWithContext(flex.OperationContext(d), getTransitGatewayConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -368,7 +369,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &gatewayId,
 	}
-	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
+	tgw, response, err := client.GetTransitGatewayWithContext(flex.OperationContext(d), getTransitGatewayOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response)
 	}
@@ -396,7 +397,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 	}
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 
-	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	_, response, err := client.GetTransitGatewayConnectionWithContext(flex.OperationContext(d), getTransitGatewayConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
 	}
@@ -411,7 +412,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+	_, response, err = client.UpdateTransitGatewayConnectionWithContext(flex.OperationContext(d), updateTransitGatewayConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
 	}
@@ -436,7 +437,7 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		ID: &ID,
 	}
 	deleteTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	response, err := client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+	response, err := client.DeleteTransitGatewayConnectionWithContext(flex.OperationContext(d), deleteTransitGatewayConnectionOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -444,7 +445,7 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		}
 		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
 	}
-	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionDeleted(flex.OperationContext(d), client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return err
@@ -454,22 +455,22 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection(%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isTransitGatewayConnectionDeleting, isTransitGatewayConnectionDetaching},
 		Target:     []string{"", isTransitGatewayConnectionDeleted},
-		Refresh:    isTransitGatewayConnectionDeleteRefreshFunc(client, id),
+		Refresh:    isTransitGatewayConnectionDeleteRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
+func isTransitGatewayConnectionDeleteRefreshFunc(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] tg gateway connection delete function here")
 		parts, err := flex.IdParts(id)
@@ -483,7 +484,7 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
 		getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 		getTransitGatewayConnectionOptions.SetID(ID)
-		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		tgConnection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
 
 		if err != nil {
 
@@ -515,7 +516,7 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 		ID: &ID,
 	}
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	_, response, err := client.GetTransitGatewayConnectionWithContext(flex.OperationContext(d), getTransitGatewayConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
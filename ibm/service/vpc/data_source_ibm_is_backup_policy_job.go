@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBackupPolicyJob() *schema.Resource {
+	jobSchema := backupPolicyJobSchema()
+	// the job identifier is the ID of the data source
+	delete(jobSchema, "id")
+	jobSchema["backup_policy_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The backup policy identifier.",
+	}
+	jobSchema["identifier"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The backup policy job identifier.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBackupPolicyJobRead,
+		Schema:      jobSchema,
+	}
+}
+
+func dataSourceIBMIsBackupPolicyJobRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get("backup_policy_id").(string)
+	id := d.Get("identifier").(string)
+	getBackupPolicyJobOptions := &vpcv1.GetBackupPolicyJobOptions{
+		BackupPolicyID: &backupPolicyID,
+		ID:             &id,
+	}
+	job, response, err := vpcClient.GetBackupPolicyJobWithContext(context, getBackupPolicyJobOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetBackupPolicyJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting job (%s) of backup policy (%s): %s\n%s", id, backupPolicyID, err, response))
+	}
+
+	d.SetId(*job.ID)
+	for k, v := range dataSourceBackupPolicyJobFlatten(job) {
+		if k == "id" {
+			continue
+		}
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", k, err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBackupPolicyJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBackupPolicyJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The backup policy identifier.",
+			},
+			"backup_policy_plan_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the jobs to those run by the backup policy plan with this identifier.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the jobs to those with this status: `failed`, `running` or `succeeded`.",
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the jobs to those with this source identifier.",
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of backup policy jobs.",
+				Elem: &schema.Resource{
+					Schema: backupPolicyJobSchema(),
+				},
+			},
+		},
+	}
+}
+
+// backupPolicyJobSchema returns the attributes of a backup policy job, shared by the job data sources
+func backupPolicyJobSchema() map[string]*schema.Schema {
+	referenceSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"crn": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The CRN of the resource.",
+					},
+					"href": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The URL of the resource.",
+					},
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier of the resource.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the resource.",
+					},
+					"resource_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The resource type.",
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for this backup policy job.",
+		},
+		"auto_delete": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether this backup policy job will be automatically deleted after it completes.",
+		},
+		"auto_delete_after": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "If auto_delete is true, the days after completion that this backup policy job will be deleted.",
+		},
+		"backup_policy_plan": referenceSchema("The backup policy plan operated this backup policy job."),
+		"completed_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time that the backup policy job was completed.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time that the backup policy job was created.",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL for this backup policy job.",
+		},
+		"job_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of backup policy job: `creation` or `deletion`.",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type.",
+		},
+		"source_volume": referenceSchema("The source volume this backup was created from."),
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the backup policy job: `failed`, `running` or `succeeded`.",
+		},
+		"status_reasons": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The reasons for the current status (if any).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"code": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "A snake case string succinctly identifying the status reason.",
+					},
+					"message": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "An explanation of the status reason.",
+					},
+					"more_info": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Link to documentation about this status reason.",
+					},
+				},
+			},
+		},
+		"target_snapshots": referenceSchema("The snapshots operated on by this backup policy job."),
+	}
+}
+
+func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get("backup_policy_id").(string)
+	listBackupPolicyJobsOptions := &vpcv1.ListBackupPolicyJobsOptions{
+		BackupPolicyID: &backupPolicyID,
+	}
+	if v, ok := d.GetOk("backup_policy_plan_id"); ok {
+		planID := v.(string)
+		listBackupPolicyJobsOptions.BackupPolicyPlanID = &planID
+	}
+	if v, ok := d.GetOk("status"); ok {
+		status := v.(string)
+		listBackupPolicyJobsOptions.Status = &status
+	}
+	if v, ok := d.GetOk("source_id"); ok {
+		sourceID := v.(string)
+		listBackupPolicyJobsOptions.SourceID = &sourceID
+	}
+
+	start := ""
+	allrecs := []vpcv1.BackupPolicyJob{}
+	for {
+		if start != "" {
+			listBackupPolicyJobsOptions.Start = &start
+		}
+		backupPolicyJobCollection, response, err := vpcClient.ListBackupPolicyJobsWithContext(context, listBackupPolicyJobsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListBackupPolicyJobsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing jobs of backup policy (%s): %s\n%s", backupPolicyID, err, response))
+		}
+		start = flex.GetNext(backupPolicyJobCollection.Next)
+		allrecs = append(allrecs, backupPolicyJobCollection.Jobs...)
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(dataSourceIBMIsBackupPolicyJobsID(d))
+
+	jobs := make([]map[string]interface{}, 0, len(allrecs))
+	for i := range allrecs {
+		jobs = append(jobs, dataSourceBackupPolicyJobFlatten(&allrecs[i]))
+	}
+	if err = d.Set("jobs", jobs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting jobs %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsBackupPolicyJobsID returns a reasonable ID for the list.
+func dataSourceIBMIsBackupPolicyJobsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceBackupPolicyJobFlatten(job *vpcv1.BackupPolicyJob) map[string]interface{} {
+	jobMap := map[string]interface{}{
+		"id":                job.ID,
+		"auto_delete":       job.AutoDelete,
+		"auto_delete_after": flex.IntValue(job.AutoDeleteAfter),
+		"created_at":        job.CreatedAt.String(),
+		"href":              job.Href,
+		"job_type":          job.JobType,
+		"resource_type":     job.ResourceType,
+		"status":            job.Status,
+	}
+	if job.CompletedAt != nil {
+		jobMap["completed_at"] = job.CompletedAt.String()
+	}
+	if job.BackupPolicyPlan != nil {
+		jobMap["backup_policy_plan"] = []map[string]interface{}{{
+			"href":          job.BackupPolicyPlan.Href,
+			"id":            job.BackupPolicyPlan.ID,
+			"name":          job.BackupPolicyPlan.Name,
+			"resource_type": job.BackupPolicyPlan.ResourceType,
+		}}
+	}
+	if source, ok := job.Source.(*vpcv1.BackupPolicyJobSource); ok && source != nil {
+		jobMap["source_volume"] = []map[string]interface{}{{
+			"crn":           source.CRN,
+			"href":          source.Href,
+			"id":            source.ID,
+			"name":          source.Name,
+			"resource_type": source.ResourceType,
+		}}
+	}
+	statusReasons := make([]map[string]interface{}, 0, len(job.StatusReasons))
+	for _, sr := range job.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      sr.Code,
+			"message":   sr.Message,
+			"more_info": sr.MoreInfo,
+		})
+	}
+	jobMap["status_reasons"] = statusReasons
+	targetSnapshots := make([]map[string]interface{}, 0, len(job.TargetSnapshots))
+	for _, snapshot := range job.TargetSnapshots {
+		targetSnapshots = append(targetSnapshots, map[string]interface{}{
+			"crn":           snapshot.CRN,
+			"href":          snapshot.Href,
+			"id":            snapshot.ID,
+			"name":          snapshot.Name,
+			"resource_type": snapshot.ResourceType,
+		})
+	}
+	jobMap["target_snapshots"] = targetSnapshots
+	return jobMap
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBackupPolicyJobsDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_backup_policy_jobs.test1"
+	policyname := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyJobsDataSourceConfig(policyname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "backup_policy_id", "ibm_is_backup_policy.testacc_backup_policy", "id"),
+					resource.TestCheckResourceAttrSet(resName, "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBackupPolicyJobsDataSourceConfig(policyname, name string) string {
+	return testAccCheckIBMISBackupPolicyPlanConfig(policyname, name, "30 09 * * *", 7) + `
+
+	data "ibm_is_backup_policy_jobs" "test1" {
+		backup_policy_id      = ibm_is_backup_policy_plan.testacc_backup_policy_plan.backup_policy_id
+		backup_policy_plan_id = ibm_is_backup_policy_plan.testacc_backup_policy_plan.backup_policy_plan_id
+	}`
+}
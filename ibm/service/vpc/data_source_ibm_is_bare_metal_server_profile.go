@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBareMetalServerProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBareMetalServerProfileRead,
+
+		Schema: bareMetalServerProfileSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name for this bare metal server profile.",
+			},
+		}),
+	}
+}
+
+// bareMetalServerProfileSchema returns the schema of the attributes of a bare metal server profile,
+// along with the arguments of the data source
+func bareMetalServerProfileSchema(arguments map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name for this bare metal server profile.",
+		},
+		"family": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The product family this bare metal server profile belongs to.",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL for this bare metal server profile.",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type.",
+		},
+		"bandwidth":               profileValueSchema("The total bandwidth (in megabits per second) shared across the network interfaces of a bare metal server with this profile."),
+		"cpu_core_count":          profileValueSchema("The number of CPU cores of a bare metal server with this profile."),
+		"cpu_socket_count":        profileValueSchema("The number of CPU sockets of a bare metal server with this profile."),
+		"memory":                  profileValueSchema("The memory (in gibibytes) of a bare metal server with this profile."),
+		"network_interface_count": profileValueSchema("The number of network interfaces supported on a bare metal server with this profile."),
+		"cpu_architecture": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The CPU architecture of a bare metal server with this profile.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The default CPU architecture for a bare metal server with this profile.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type for this profile field.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The CPU architecture for a bare metal server with this profile.",
+					},
+				},
+			},
+		},
+		"os_architecture":                         bareMetalServerProfileEnumSchema("The supported OS architecture(s) for a bare metal server with this profile."),
+		"supported_trusted_platform_module_modes": bareMetalServerProfileEnumSchema("The supported trusted platform module (TPM) modes for this bare metal server profile."),
+		"console_types":                           bareMetalServerProfileEnumSchema("The console type configuration for a bare metal server with this profile."),
+		"disks": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The disks of a bare metal server with this profile.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"quantity":                  profileValueSchema("The number of disks of this configuration for a bare metal server with this profile."),
+					"size":                      profileValueSchema("The size of the disk in GB (gigabytes)."),
+					"supported_interface_types": bareMetalServerProfileEnumSchema("The disk interface used for attaching the disk."),
+				},
+			},
+		},
+	}
+	for key, argument := range arguments {
+		s[key] = argument
+	}
+	return s
+}
+
+// profileValueSchema returns the schema of an integer field of a profile, which is a fixed
+// value, a range or a list of values depending on its type
+func profileValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field: `fixed`, `range`, `enum` or `dependent`.",
+				},
+				"value": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The value for this profile field, when its type is `fixed`.",
+				},
+				"default": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The default value for this profile field.",
+				},
+				"max": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The maximum value for this profile field, when its type is `range`.",
+				},
+				"min": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The minimum value for this profile field, when its type is `range`.",
+				},
+				"step": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The increment step value for this profile field, when its type is `range`.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+					Description: "The permitted values for this profile field, when its type is `enum`.",
+				},
+			},
+		},
+	}
+}
+
+// bareMetalServerProfileEnumSchema returns the schema of a string field of a profile, which is
+// one of a list of values
+func bareMetalServerProfileEnumSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The default value for this profile field.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The permitted values for this profile field.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsBareMetalServerProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	getBareMetalServerProfileOptions := &vpcv1.GetBareMetalServerProfileOptions{
+		Name: &name,
+	}
+	profile, response, err := vpcClient.GetBareMetalServerProfileWithContext(context, getBareMetalServerProfileOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetBareMetalServerProfileWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting bare metal server profile (%s): %s\n%s", name, err, response))
+	}
+
+	d.SetId(*profile.Name)
+	for key, value := range dataSourceBareMetalServerProfileToMap(*profile) {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+// profileValue is the integer field of a profile, of any of its types. The fields of the
+// profiles are the variants of different interfaces, which have the same JSON form.
+type profileValue struct {
+	Type    *string `json:"type,omitempty"`
+	Value   *int64  `json:"value,omitempty"`
+	Default *int64  `json:"default,omitempty"`
+	Max     *int64  `json:"max,omitempty"`
+	Min     *int64  `json:"min,omitempty"`
+	Step    *int64  `json:"step,omitempty"`
+	Values  []int64 `json:"values,omitempty"`
+}
+
+func dataSourceProfileFlattenValue(field interface{}) []map[string]interface{} {
+	finalList := []map[string]interface{}{}
+	b, err := json.Marshal(field)
+	if field == nil || err != nil {
+		return finalList
+	}
+	value := profileValue{}
+	if err = json.Unmarshal(b, &value); err != nil {
+		log.Printf("[DEBUG] Error reading the profile field %s: %s", b, err)
+		return finalList
+	}
+	values := make([]int, 0, len(value.Values))
+	for _, v := range value.Values {
+		values = append(values, int(v))
+	}
+	finalList = append(finalList, map[string]interface{}{
+		"type":    value.Type,
+		"value":   flex.IntValue(value.Value),
+		"default": flex.IntValue(value.Default),
+		"max":     flex.IntValue(value.Max),
+		"min":     flex.IntValue(value.Min),
+		"step":    flex.IntValue(value.Step),
+		"values":  values,
+	})
+	return finalList
+}
+
+func dataSourceBareMetalServerProfileFlattenEnum(defaultValue, enumType *string, values []string) []map[string]interface{} {
+	if enumType == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"default": defaultValue,
+		"type":    enumType,
+		"values":  values,
+	}}
+}
+
+// dataSourceBareMetalServerProfileToMap returns the attributes of the profile, shared by the
+// profile and the profiles data sources
+func dataSourceBareMetalServerProfileToMap(profile vpcv1.BareMetalServerProfile) map[string]interface{} {
+	profileMap := map[string]interface{}{
+		"name":                    profile.Name,
+		"family":                  profile.Family,
+		"href":                    profile.Href,
+		"resource_type":           profile.ResourceType,
+		"bandwidth":               dataSourceProfileFlattenValue(profile.Bandwidth),
+		"cpu_core_count":          dataSourceProfileFlattenValue(profile.CpuCoreCount),
+		"cpu_socket_count":        dataSourceProfileFlattenValue(profile.CpuSocketCount),
+		"memory":                  dataSourceProfileFlattenValue(profile.Memory),
+		"network_interface_count": dataSourceProfileFlattenValue(profile.NetworkInterfaceCount),
+	}
+	cpuArchitecture := []map[string]interface{}{}
+	if profile.CpuArchitecture != nil {
+		cpuArchitecture = append(cpuArchitecture, map[string]interface{}{
+			"default": profile.CpuArchitecture.Default,
+			"type":    profile.CpuArchitecture.Type,
+			"value":   profile.CpuArchitecture.Value,
+		})
+	}
+	profileMap["cpu_architecture"] = cpuArchitecture
+	if profile.OsArchitecture != nil {
+		profileMap["os_architecture"] = dataSourceBareMetalServerProfileFlattenEnum(profile.OsArchitecture.Default, profile.OsArchitecture.Type, profile.OsArchitecture.Values)
+	}
+	if profile.SupportedTrustedPlatformModuleModes != nil {
+		profileMap["supported_trusted_platform_module_modes"] = dataSourceBareMetalServerProfileFlattenEnum(nil, profile.SupportedTrustedPlatformModuleModes.Type, profile.SupportedTrustedPlatformModuleModes.Values)
+	}
+	if profile.ConsoleTypes != nil {
+		profileMap["console_types"] = dataSourceBareMetalServerProfileFlattenEnum(nil, profile.ConsoleTypes.Type, profile.ConsoleTypes.Values)
+	}
+	disks := []map[string]interface{}{}
+	for _, disk := range profile.Disks {
+		diskMap := map[string]interface{}{
+			"quantity": dataSourceProfileFlattenValue(disk.Quantity),
+			"size":     dataSourceProfileFlattenValue(disk.Size),
+		}
+		if disk.SupportedInterfaceTypes != nil {
+			diskMap["supported_interface_types"] = dataSourceBareMetalServerProfileFlattenEnum(disk.SupportedInterfaceTypes.Default, disk.SupportedInterfaceTypes.Type, disk.SupportedInterfaceTypes.Values)
+		}
+		disks = append(disks, diskMap)
+	}
+	profileMap["disks"] = disks
+	return profileMap
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerProfileDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_bare_metal_server_profile.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerProfileDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "name", acc.IsBareMetalServerProfileName),
+					resource.TestCheckResourceAttrSet(resName, "family"),
+					resource.TestCheckResourceAttrSet(resName, "cpu_core_count.#"),
+					resource.TestCheckResourceAttrSet(resName, "memory.#"),
+					resource.TestCheckResourceAttrSet(resName, "disks.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerProfileDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_bare_metal_server_profile" "test1" {
+		name = "%s"
+	}`, acc.IsBareMetalServerProfileName)
+}
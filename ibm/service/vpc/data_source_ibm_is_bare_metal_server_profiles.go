@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBareMetalServerProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBareMetalServerProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of bare metal server profiles.",
+				Elem: &schema.Resource{
+					Schema: bareMetalServerProfileSchema(nil),
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources across all pages.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listBareMetalServerProfilesOptions := &vpcv1.ListBareMetalServerProfilesOptions{}
+
+	start := ""
+	allrecs := []vpcv1.BareMetalServerProfile{}
+	for {
+		if start != "" {
+			listBareMetalServerProfilesOptions.Start = &start
+		}
+		bareMetalServerProfileCollection, response, err := vpcClient.ListBareMetalServerProfilesWithContext(context, listBareMetalServerProfilesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListBareMetalServerProfilesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing bare metal server profiles: %s\n%s", err, response))
+		}
+		start = flex.GetNext(bareMetalServerProfileCollection.Next)
+		allrecs = append(allrecs, bareMetalServerProfileCollection.Profiles...)
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(dataSourceIBMIsBareMetalServerProfilesID(d))
+
+	profiles := make([]map[string]interface{}, 0, len(allrecs))
+	for _, profile := range allrecs {
+		profiles = append(profiles, dataSourceBareMetalServerProfileToMap(profile))
+	}
+	if err = d.Set("profiles", profiles); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profiles %s", err))
+	}
+	if err = d.Set("total_count", len(allrecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsBareMetalServerProfilesID returns a reasonable ID for the list.
+func dataSourceIBMIsBareMetalServerProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -96,7 +96,7 @@ func floatingIPGet(d *schema.ResourceData, meta interface{}, name string) error
 		if start != "" {
 			floatingIPOptions.Start = &start
 		}
-		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
+		floatingIPs, response, err := sess.ListFloatingIpsWithContext(flex.OperationContext(d), floatingIPOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching floating IPs %s\n%s", err, response)
 		}
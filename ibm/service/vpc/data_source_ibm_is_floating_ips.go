@@ -182,7 +182,7 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 		if start != "" {
 			floatingIPOptions.Start = &start
 		}
-		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
+		floatingIPs, response, err := sess.ListFloatingIpsWithContext(flex.OperationContext(d), floatingIPOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Error Fetching floating IPs  %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching floating IPs %s\n%s", err, response))
@@ -338,8 +338,8 @@ func dataSourceFloatingIPCollectionFloatingIpsTargetToMap(targetItemIntf vpcv1.F
 	if targetItem.Name != nil {
 		targetMap["name"] = targetItem.Name
 	}
-	if targetItem.PrimaryIpv4Address != nil {
-		targetMap["primary_ipv4_address"] = targetItem.PrimaryIpv4Address
+	if targetItem.PrimaryIP != nil {
+		targetMap["primary_ipv4_address"] = targetItem.PrimaryIP.Address
 	}
 	if targetItem.ResourceType != nil {
 		targetMap["resource_type"] = targetItem.ResourceType
@@ -214,7 +214,7 @@ func dataSourceIBMIsFlowLogRead(context context.Context, d *schema.ResourceData,
 			if start != "" {
 				listOptions.Start = &start
 			}
-			flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
+			flowlogCollectors, response, err := sess.ListFlowLogCollectorsWithContext(flex.OperationContext(d), listOptions)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Flow Logs for VPC %s\n%s", err, response))
 			}
@@ -329,7 +329,7 @@ func dataSourceFlowLogCollectorResourceGroupToMap(resourceGroupItem vpcv1.Resour
 	return resourceGroupMap
 }
 
-func dataSourceFlowLogCollectorFlattenStorageBucket(result vpcv1.CloudObjectStorageBucketReference) (finalList []map[string]interface{}) {
+func dataSourceFlowLogCollectorFlattenStorageBucket(result vpcv1.LegacyCloudObjectStorageBucketReference) (finalList []map[string]interface{}) {
 	finalList = []map[string]interface{}{}
 	finalMap := dataSourceFlowLogCollectorStorageBucketToMap(result)
 	finalList = append(finalList, finalMap)
@@ -337,7 +337,7 @@ func dataSourceFlowLogCollectorFlattenStorageBucket(result vpcv1.CloudObjectStor
 	return finalList
 }
 
-func dataSourceFlowLogCollectorStorageBucketToMap(storageBucketItem vpcv1.CloudObjectStorageBucketReference) (storageBucketMap map[string]interface{}) {
+func dataSourceFlowLogCollectorStorageBucketToMap(storageBucketItem vpcv1.LegacyCloudObjectStorageBucketReference) (storageBucketMap map[string]interface{}) {
 	storageBucketMap = map[string]interface{}{}
 
 	if storageBucketItem.Name != nil {
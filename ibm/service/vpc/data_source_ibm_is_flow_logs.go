@@ -103,7 +103,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		if start != "" {
 			listOptions.Start = &start
 		}
-		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
+		flowlogCollectors, response, err := sess.ListFlowLogCollectorsWithContext(flex.OperationContext(d), listOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Flow Logs for VPC %s\n%s", err, response)
 		}
@@ -122,7 +122,7 @@ func imageGetByName(d *schema.ResourceData, meta interface{}, name, visibility s
 		if visibility != "" {
 			listImagesOptions.Visibility = &visibility
 		}
-		availableImages, response, err := sess.ListImages(listImagesOptions)
+		availableImages, response, err := sess.ListImagesWithContext(flex.OperationContext(d), listImagesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
 		}
@@ -173,7 +173,7 @@ func imageGetById(d *schema.ResourceData, meta interface{}, identifier string) e
 		ID: &identifier,
 	}
 
-	image, response, err := sess.GetImage(getImageOptions)
+	image, response, err := sess.GetImageWithContext(flex.OperationContext(d), getImageOptions)
 	if err != nil {
 		if response.StatusCode == 404 {
 			return fmt.Errorf("[ERROR] No image found with id  %s", identifier)
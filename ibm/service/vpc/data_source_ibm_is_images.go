@@ -19,7 +19,7 @@ const (
 )
 
 func DataSourceIBMISImages() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		Read: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -105,7 +105,7 @@ func DataSourceIBMISImages() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, isImages)
 }
 
 func dataSourceIBMISImagesRead(d *schema.ResourceData, meta interface{}) error {
@@ -155,7 +155,7 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			listImagesOptions.Start = &start
 		}
-		availableImages, response, err := sess.ListImages(listImagesOptions)
+		availableImages, response, err := sess.ListImagesWithContext(flex.OperationContext(d), listImagesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
 		}
@@ -191,6 +191,10 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		}
 		imagesInfo = append(imagesInfo, l)
 	}
+	imagesInfo, err = flex.FilterItems(d, imagesInfo, flex.CRNTags(meta))
+	if err != nil {
+		return err
+	}
 	d.SetId(dataSourceIBMISSubnetsID(d))
 	d.Set(isImages, imagesInfo)
 	return nil
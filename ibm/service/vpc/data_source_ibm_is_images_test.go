@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -63,3 +64,37 @@ func testAccCheckIBMISImagesDataSourceWithVisibilityPublic(visibility string) st
 	}
 	`, visibility)
 }
+
+func TestAccIBMISImagesDataSource_Filter(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceWithFilter("ibm-ubuntu-.*", "amd64"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resName, "images.0.name", regexp.MustCompile("^ibm-ubuntu-")),
+					resource.TestCheckResourceAttr(resName, "images.0.architecture", "amd64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImagesDataSourceWithFilter(name, architecture string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_images" "test1" {
+		visibility = "public"
+		filter {
+			name   = "name"
+			values = ["%s"]
+			regex  = true
+		}
+		filter {
+			name   = "architecture"
+			values = ["%s"]
+		}
+	}
+	`, name, architecture)
+}
@@ -535,7 +535,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 		if start != "" {
 			listInstancesOptions.Start = &start
 		}
-		instances, response, err := sess.ListInstances(listInstancesOptions)
+		instances, response, err := sess.ListInstancesWithContext(flex.OperationContext(d), listInstancesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
 		}
@@ -601,12 +601,14 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 				currentPrimNic := map[string]interface{}{}
 				currentPrimNic["id"] = *instance.PrimaryNetworkInterface.ID
 				currentPrimNic[isInstanceNicName] = *instance.PrimaryNetworkInterface.Name
-				currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+				if instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+					currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIP.Address
+				}
 				getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 					InstanceID: &id,
 					ID:         instance.PrimaryNetworkInterface.ID,
 				}
-				insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+				insnic, response, err := sess.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 				}
@@ -630,12 +632,14 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 						currentNic := map[string]interface{}{}
 						currentNic["id"] = *intfc.ID
 						currentNic[isInstanceNicName] = *intfc.Name
-						currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+						if intfc.PrimaryIP != nil && intfc.PrimaryIP.Address != nil {
+							currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIP.Address
+						}
 						getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 							InstanceID: &id,
 							ID:         intfc.ID,
 						}
-						insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+						insnic, response, err := sess.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 						}
@@ -716,7 +720,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 			getInstanceInitializationOptions := &vpcv1.GetInstanceInitializationOptions{
 				ID: &id,
 			}
-			initParms, response, err := sess.GetInstanceInitialization(getInstanceInitializationOptions)
+			initParms, response, err := sess.GetInstanceInitializationWithContext(flex.OperationContext(d), getInstanceInitializationOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Getting instance Initialization: %s\n%s", err, response)
 			}
@@ -104,7 +104,7 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 		if start != "" {
 			listInstanceGroupOptions.Start = &start
 		}
-		instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
+		instanceGroupsCollection, response, err := sess.ListInstanceGroupsWithContext(flex.OperationContext(d), &listInstanceGroupOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
 		}
@@ -115,7 +115,7 @@ func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 		if start != "" {
 			listInstanceGroupManagerOptions.Start = &start
 		}
-		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
+		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagersWithContext(flex.OperationContext(d), &listInstanceGroupManagerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
 		}
@@ -169,7 +169,7 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(d *schema.ResourceData, meta
 		if start != "" {
 			listInstanceGroupManagerActionsOptions.Start = &start
 		}
-		instanceGroupManagerActionsCollection, response, err := sess.ListInstanceGroupManagerActions(&listInstanceGroupManagerActionsOptions)
+		instanceGroupManagerActionsCollection, response, err := sess.ListInstanceGroupManagerActionsWithContext(flex.OperationContext(d), &listInstanceGroupManagerActionsOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Actions %s\n%s", err, response)
 		}
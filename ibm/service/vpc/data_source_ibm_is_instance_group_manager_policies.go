@@ -99,7 +99,7 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 		if start != "" {
 			listInstanceGroupManagerPoliciesOptions.Start = &start
 		}
-		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
+		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPoliciesWithContext(flex.OperationContext(d), &listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
 		}
@@ -83,7 +83,7 @@ func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta
 		if start != "" {
 			listInstanceGroupManagerPoliciesOptions.Start = &start
 		}
-		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
+		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPoliciesWithContext(flex.OperationContext(d), &listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
 		}
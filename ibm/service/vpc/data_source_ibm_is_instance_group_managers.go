@@ -132,7 +132,7 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 		if start != "" {
 			listInstanceGroupManagerOptions.Start = &start
 		}
-		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
+		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagersWithContext(flex.OperationContext(d), &listInstanceGroupManagerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
 		}
@@ -114,7 +114,7 @@ func dataSourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta int
 		if start != "" {
 			listInstanceGroupMembershipsOptions.Start = &start
 		}
-		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMembershipsWithContext(flex.OperationContext(d), &listInstanceGroupMembershipsOptions)
 		if err != nil || instanceGroupMembershipCollection == nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
 		}
@@ -129,7 +129,7 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 		if start != "" {
 			listInstanceGroupMembershipsOptions.Start = &start
 		}
-		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMembershipsWithContext(flex.OperationContext(d), &listInstanceGroupMembershipsOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
 		}
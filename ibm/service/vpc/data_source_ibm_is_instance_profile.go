@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -570,7 +571,7 @@ func instanceProfileGet(d *schema.ResourceData, meta interface{}, name string) e
 	getInstanceProfileOptions := &vpcv1.GetInstanceProfileOptions{
 		Name: &name,
 	}
-	profile, _, err := sess.GetInstanceProfile(getInstanceProfileOptions)
+	profile, _, err := sess.GetInstanceProfileWithContext(flex.OperationContext(d), getInstanceProfileOptions)
 	if err != nil {
 		return err
 	}
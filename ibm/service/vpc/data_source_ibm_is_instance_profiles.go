@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -572,7 +573,7 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	listInstanceProfilesOptions := &vpcv1.ListInstanceProfilesOptions{}
-	availableProfiles, response, err := sess.ListInstanceProfiles(listInstanceProfilesOptions)
+	availableProfiles, response, err := sess.ListInstanceProfilesWithContext(flex.OperationContext(d), listInstanceProfilesOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
 	}
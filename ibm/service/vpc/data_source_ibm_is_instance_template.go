@@ -282,7 +282,7 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 		getInstanceTemplatesOptions := &vpcv1.GetInstanceTemplateOptions{
 			ID: &id,
 		}
-		instTempl, _, err := instanceC.GetInstanceTemplate(getInstanceTemplatesOptions)
+		instTempl, _, err := instanceC.GetInstanceTemplateWithContext(flex.OperationContext(d), getInstanceTemplatesOptions)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -322,8 +322,8 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 			interfaceList := make([]map[string]interface{}, 0)
 			currentPrimNic := map[string]interface{}{}
 			currentPrimNic[isInstanceTemplateNicName] = *instance.PrimaryNetworkInterface.Name
-			if instance.PrimaryNetworkInterface.PrimaryIpv4Address != nil {
-				currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+			if address := networkInterfaceIPPrototypeAddress(instance.PrimaryNetworkInterface.PrimaryIP); address != nil {
+				currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 			}
 			subInf := instance.PrimaryNetworkInterface.Subnet
 			subnetIdentity := subInf.(*vpcv1.SubnetIdentity)
@@ -347,8 +347,8 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 			for _, intfc := range instance.NetworkInterfaces {
 				currentNic := map[string]interface{}{}
 				currentNic[isInstanceTemplateNicName] = *intfc.Name
-				if intfc.PrimaryIpv4Address != nil {
-					currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+				if address := networkInterfaceIPPrototypeAddress(intfc.PrimaryIP); address != nil {
+					currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 				}
 				//currentNic[isInstanceTemplateNicAllowIpSpoofing] = intfc.AllowIpSpoofing
 				subInf := intfc.Subnet
@@ -394,7 +394,7 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 				volumeAttach[isInstanceTemplateVolAttName] = *volume.Name
 				volumeAttach[isInstanceTemplateDeleteVolume] = *volume.DeleteVolumeOnInstanceDelete
 				volumeIntf := volume.Volume
-				volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentVolumePrototypeInstanceContext)
+				volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentPrototypeVolume)
 				newVolumeArr := []map[string]interface{}{}
 				newVolume := map[string]interface{}{}
 
@@ -452,7 +452,7 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 	} else if nameOk, ok := d.GetOk(isInstanceTemplateName); ok {
 		name := nameOk.(string)
 		listInstanceTemplatesOptions := &vpcv1.ListInstanceTemplatesOptions{}
-		availableTemplates, _, err := instanceC.ListInstanceTemplates(listInstanceTemplatesOptions)
+		availableTemplates, _, err := instanceC.ListInstanceTemplatesWithContext(flex.OperationContext(d), listInstanceTemplatesOptions)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -492,8 +492,8 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 					interfaceList := make([]map[string]interface{}, 0)
 					currentPrimNic := map[string]interface{}{}
 					currentPrimNic[isInstanceTemplateNicName] = *instance.PrimaryNetworkInterface.Name
-					if instance.PrimaryNetworkInterface.PrimaryIpv4Address != nil {
-						currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+					if address := networkInterfaceIPPrototypeAddress(instance.PrimaryNetworkInterface.PrimaryIP); address != nil {
+						currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 					}
 					subInf := instance.PrimaryNetworkInterface.Subnet
 					subnetIdentity := subInf.(*vpcv1.SubnetIdentity)
@@ -517,8 +517,8 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 					for _, intfc := range instance.NetworkInterfaces {
 						currentNic := map[string]interface{}{}
 						currentNic[isInstanceTemplateNicName] = *intfc.Name
-						if intfc.PrimaryIpv4Address != nil {
-							currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+						if address := networkInterfaceIPPrototypeAddress(intfc.PrimaryIP); address != nil {
+							currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 						}
 						//currentNic[isInstanceTemplateNicAllowIpSpoofing] = intfc.AllowIpSpoofing
 						subInf := intfc.Subnet
@@ -568,7 +568,7 @@ func dataSourceIBMISInstanceTemplateRead(context context.Context, d *schema.Reso
 						volumeAttach[isInstanceTemplateVolAttName] = *volume.Name
 						volumeAttach[isInstanceTemplateDeleteVolume] = *volume.DeleteVolumeOnInstanceDelete
 						volumeIntf := volume.Volume
-						volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentVolumePrototypeInstanceContext)
+						volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentPrototypeVolume)
 						newVolumeArr := []map[string]interface{}{}
 						newVolume := map[string]interface{}{}
 
@@ -310,7 +310,7 @@ func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface
 		return err
 	}
 	listInstanceTemplatesOptions := &vpcv1.ListInstanceTemplatesOptions{}
-	availableTemplates, _, err := instanceC.ListInstanceTemplates(listInstanceTemplatesOptions)
+	availableTemplates, _, err := instanceC.ListInstanceTemplatesWithContext(flex.OperationContext(d), listInstanceTemplatesOptions)
 	if err != nil {
 		return err
 	}
@@ -358,8 +358,8 @@ func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface
 			interfaceList := make([]map[string]interface{}, 0)
 			currentPrimNic := map[string]interface{}{}
 			currentPrimNic[isInstanceTemplateNicName] = *instance.PrimaryNetworkInterface.Name
-			if instance.PrimaryNetworkInterface.PrimaryIpv4Address != nil {
-				currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+			if address := networkInterfaceIPPrototypeAddress(instance.PrimaryNetworkInterface.PrimaryIP); address != nil {
+				currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 			}
 			subInf := instance.PrimaryNetworkInterface.Subnet
 			subnetIdentity := subInf.(*vpcv1.SubnetIdentity)
@@ -383,8 +383,8 @@ func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface
 			for _, intfc := range instance.NetworkInterfaces {
 				currentNic := map[string]interface{}{}
 				currentNic[isInstanceTemplateNicName] = *intfc.Name
-				if intfc.PrimaryIpv4Address != nil {
-					currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+				if address := networkInterfaceIPPrototypeAddress(intfc.PrimaryIP); address != nil {
+					currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 				}
 				//currentNic[isInstanceTemplateNicAllowIpSpoofing] = intfc.AllowIpSpoofing
 				subInf := intfc.Subnet
@@ -430,7 +430,7 @@ func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface
 				volumeAttach[isInstanceTemplateVolAttName] = *volume.Name
 				volumeAttach[isInstanceTemplateDeleteVolume] = *volume.DeleteVolumeOnInstanceDelete
 				volumeIntf := volume.Volume
-				volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentVolumePrototypeInstanceContext)
+				volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentPrototypeVolume)
 				newVolumeArr := []map[string]interface{}{}
 				newVolume := map[string]interface{}{}
 
@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -133,7 +134,7 @@ func instanceVolumeAttachmentGetByName(d *schema.ResourceData, meta interface{},
 	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 		InstanceID: &instanceId,
 	}
-	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
+	volumeAtts, response, err := sess.ListInstanceVolumeAttachmentsWithContext(flex.OperationContext(d), listInstanceVolumeAttOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error fetching Instance volume attachments %s\n%s", err, response)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -128,7 +129,7 @@ func instanceGetVolumeAttachments(d *schema.ResourceData, meta interface{}, inst
 	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 		InstanceID: &instanceId,
 	}
-	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
+	volumeAtts, response, err := sess.ListInstanceVolumeAttachmentsWithContext(flex.OperationContext(d), listInstanceVolumeAttOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
 	}
@@ -18,7 +18,7 @@ const (
 )
 
 func DataSourceIBMISInstances() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		Read: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
@@ -473,7 +473,7 @@ func DataSourceIBMISInstances() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, isInstances)
 }
 
 func dataSourceIBMISInstancesRead(d *schema.ResourceData, meta interface{}) error {
@@ -536,7 +536,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			if start != "" {
 				listInstanceGroupOptions.Start = &start
 			}
-			instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
+			instanceGroupsCollection, response, err := sess.ListInstanceGroupsWithContext(flex.OperationContext(d), &listInstanceGroupOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
 			}
@@ -596,7 +596,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			listInstancesOptions.Start = &start
 		}
 
-		instances, response, err := sess.ListInstances(listInstancesOptions)
+		instances, response, err := sess.ListInstancesWithContext(flex.OperationContext(d), listInstancesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
 		}
@@ -617,7 +617,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			if start != "" {
 				listInstanceGroupMembershipsOptions.Start = &start
 			}
-			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMembershipsWithContext(flex.OperationContext(d), &listInstanceGroupMembershipsOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
 			}
@@ -722,12 +722,14 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			currentPrimNic := map[string]interface{}{}
 			currentPrimNic["id"] = *instance.PrimaryNetworkInterface.ID
 			currentPrimNic[isInstanceNicName] = *instance.PrimaryNetworkInterface.Name
-			currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+			if instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+				currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIP.Address
+			}
 			getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 				InstanceID: &id,
 				ID:         instance.PrimaryNetworkInterface.ID,
 			}
-			insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+			insnic, response, err := sess.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 			}
@@ -751,12 +753,14 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 					currentNic := map[string]interface{}{}
 					currentNic["id"] = *intfc.ID
 					currentNic[isInstanceNicName] = *intfc.Name
-					currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+					if intfc.PrimaryIP != nil && intfc.PrimaryIP.Address != nil {
+						currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIP.Address
+					}
 					getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 						InstanceID: &id,
 						ID:         intfc.ID,
 					}
-					insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+					insnic, response, err := sess.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 					if err != nil {
 						return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 					}
@@ -807,6 +811,10 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 
 		instancesInfo = append(instancesInfo, l)
 	}
+	instancesInfo, err = flex.FilterItems(d, instancesInfo, flex.CRNTags(meta))
+	if err != nil {
+		return err
+	}
 	d.SetId(dataSourceIBMISInstancesID(d))
 	d.Set(isInstances, instancesInfo)
 	return nil
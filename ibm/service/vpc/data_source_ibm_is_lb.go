@@ -268,7 +268,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		if start != "" {
 			listLoadBalancersOptions.Start = &start
 		}
-		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
+		lbs, response, err := sess.ListLoadBalancersWithContext(flex.OperationContext(d), listLoadBalancersOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
 		}
@@ -353,7 +353,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			}
 			listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{}
 			listLoadBalancerPoolsOptions.SetLoadBalancerID(*lb.ID)
-			poolsResult, _, _ := sess.ListLoadBalancerPools(listLoadBalancerPoolsOptions)
+			poolsResult, _, _ := sess.ListLoadBalancerPoolsWithContext(flex.OperationContext(d), listLoadBalancerPoolsOptions)
 			if poolsResult != nil {
 				poolsInfo := make([]map[string]interface{}, 0)
 
@@ -74,7 +74,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		if start != "" {
 			listOptions.Start = &start
 		}
-		profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
+		profileCollectors, response, err := sess.ListLoadBalancerProfilesWithContext(flex.OperationContext(d), listOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
 		}
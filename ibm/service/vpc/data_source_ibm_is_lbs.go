@@ -239,7 +239,7 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			listLoadBalancersOptions.Start = &start
 		}
-		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
+		lbs, response, err := sess.ListLoadBalancersWithContext(flex.OperationContext(d), listLoadBalancersOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
 		}
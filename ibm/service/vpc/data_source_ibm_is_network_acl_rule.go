@@ -187,7 +187,7 @@ func nawaclRuleDataGet(d *schema.ResourceData, meta interface{}, name, nwACLID s
 			listNetworkACLRulesOptions.Start = &start
 		}
 
-		ruleList, response, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		ruleList, response, err := sess.ListNetworkACLRulesWithContext(flex.OperationContext(d), listNetworkACLRulesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching network acl ruless %s\n%s", err, response)
 		}
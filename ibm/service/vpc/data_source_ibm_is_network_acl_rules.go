@@ -193,7 +193,7 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 			listNetworkACLRulesOptions.Start = &start
 		}
 
-		ruleList, response, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		ruleList, response, err := sess.ListNetworkACLRulesWithContext(flex.OperationContext(d), listNetworkACLRulesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching network acl ruless %s\n%s", err, response)
 		}
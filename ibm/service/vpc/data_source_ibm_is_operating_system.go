@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -91,7 +92,7 @@ func osGet(d *schema.ResourceData, meta interface{}, name string) error {
 	getOperatingSystemOptions := &vpcv1.GetOperatingSystemOptions{
 		Name: &name,
 	}
-	os, response, err := sess.GetOperatingSystem(getOperatingSystemOptions)
+	os, response, err := sess.GetOperatingSystemWithContext(flex.OperationContext(d), getOperatingSystemOptions)
 	if err != nil || os == nil {
 		return fmt.Errorf("[ERROR] Error Getting Operating System Details %s , %s", err, response)
 	}
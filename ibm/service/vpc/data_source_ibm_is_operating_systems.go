@@ -98,7 +98,7 @@ func osList(d *schema.ResourceData, meta interface{}) error {
 			listOperatingSystemsOptions.Start = &start
 		}
 
-		osList, response, err := sess.ListOperatingSystems(listOperatingSystemsOptions)
+		osList, response, err := sess.ListOperatingSystemsWithContext(flex.OperationContext(d), listOperatingSystemsOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching operating systems %s\n%s", err, response)
 		}
@@ -154,13 +154,13 @@ func dataSourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resour
 			if err = d.Set("strategy", placementGroup.Strategy); err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error setting strategy: %s", err))
 			}
-			tags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroup.CRN, "", isUserTagType)
+			tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *placementGroup.CRN, "", isUserTagType)
 			if err != nil {
 				log.Printf(
 					"An error getting placement group (%s) tags : %s", d.Id(), err)
 			}
 
-			accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroup.CRN, "", isAccessTagType)
+			accesstags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *placementGroup.CRN, "", isAccessTagType)
 			if err != nil {
 				log.Printf(
 					"Error getting placement group (%s) access tags: %s", d.Id(), err)
@@ -145,7 +145,7 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 
 	d.SetId(dataSourceIbmIsPlacementGroupsID(d))
 	if len(allrecs) > 0 {
-		err = d.Set("placement_groups", dataSourcePlacementGroupCollectionFlattenPlacementGroups(meta, allrecs))
+		err = d.Set("placement_groups", dataSourcePlacementGroupCollectionFlattenPlacementGroups(flex.OperationContext(d), meta, allrecs))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting placement_groups %s", err))
 		}
@@ -161,15 +161,15 @@ func dataSourceIbmIsPlacementGroupsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
 
-func dataSourcePlacementGroupCollectionFlattenPlacementGroups(meta interface{}, result []vpcv1.PlacementGroup) (placementGroups []map[string]interface{}) {
+func dataSourcePlacementGroupCollectionFlattenPlacementGroups(ctx context.Context, meta interface{}, result []vpcv1.PlacementGroup) (placementGroups []map[string]interface{}) {
 	for _, placementGroupsItem := range result {
-		placementGroups = append(placementGroups, dataSourcePlacementGroupCollectionPlacementGroupsToMap(meta, placementGroupsItem))
+		placementGroups = append(placementGroups, dataSourcePlacementGroupCollectionPlacementGroupsToMap(ctx, meta, placementGroupsItem))
 	}
 
 	return placementGroups
 }
 
-func dataSourcePlacementGroupCollectionPlacementGroupsToMap(meta interface{}, placementGroupsItem vpcv1.PlacementGroup) (placementGroupsMap map[string]interface{}) {
+func dataSourcePlacementGroupCollectionPlacementGroupsToMap(ctx context.Context, meta interface{}, placementGroupsItem vpcv1.PlacementGroup) (placementGroupsMap map[string]interface{}) {
 	placementGroupsMap = map[string]interface{}{}
 
 	if placementGroupsItem.CreatedAt != nil {
@@ -202,13 +202,13 @@ func dataSourcePlacementGroupCollectionPlacementGroupsToMap(meta interface{}, pl
 	if placementGroupsItem.Strategy != nil {
 		placementGroupsMap["strategy"] = placementGroupsItem.Strategy
 	}
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroupsItem.CRN, "", isUserTagType)
+	tags, err := flex.GetGlobalTagsUsingCRN(ctx, meta, *placementGroupsItem.CRN, "", isUserTagType)
 	if err != nil {
 		log.Printf(
 			"An error getting placement group (%s) tags : %s", *placementGroupsItem.ID, err)
 	}
 
-	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroupsItem.CRN, "", isAccessTagType)
+	accesstags, err := flex.GetGlobalTagsUsingCRN(ctx, meta, *placementGroupsItem.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error getting placement group (%s) access tags: %s", *placementGroupsItem.ID, err)
@@ -147,7 +147,7 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 		if rgroup != "" {
 			listPublicGatewaysOptions.ResourceGroupID = &rgroup
 		}
-		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
+		publicgws, response, err := sess.ListPublicGatewaysWithContext(flex.OperationContext(d), listPublicGatewaysOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
 		}
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,7 +53,7 @@ func regionGet(d *schema.ResourceData, meta interface{}, name string) error {
 	getRegionOptions := &vpcv1.GetRegionOptions{
 		Name: &name,
 	}
-	region, _, err := sess.GetRegion(getRegionOptions)
+	region, _, err := sess.GetRegionWithContext(flex.OperationContext(d), getRegionOptions)
 	if err != nil {
 		return err
 	}
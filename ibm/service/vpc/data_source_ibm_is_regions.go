@@ -6,6 +6,7 @@ package vpc
 import (
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -58,7 +59,7 @@ func dataSourceIBMISRegionsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	listRegionOptions := &vpcv1.ListRegionsOptions{}
-	regioncollection, _, err := sess.ListRegions(listRegionOptions)
+	regioncollection, _, err := sess.ListRegionsWithContext(flex.OperationContext(d), listRegionOptions)
 	if err != nil {
 		return err
 	}
@@ -175,7 +175,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 		if start != "" {
 			listSgOptions.Start = &start
 		}
-		sgs, response, err := sess.ListSecurityGroups(listSgOptions)
+		sgs, response, err := sess.ListSecurityGroupsWithContext(flex.OperationContext(d), listSgOptions)
 		if err != nil || sgs == nil {
 			return fmt.Errorf("[ERROR] Error Getting Security Groups %s\n%s", err, response)
 		}
@@ -76,7 +76,7 @@ func dataSourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interfa
 		if start != "" {
 			listSecurityGroupTargetsOptions.Start = &start
 		}
-		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
+		groups, response, err := sess.ListSecurityGroupTargetsWithContext(flex.OperationContext(d), listSecurityGroupTargetsOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
 		}
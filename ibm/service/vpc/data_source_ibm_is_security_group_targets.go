@@ -85,7 +85,7 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 		if start != "" {
 			listSecurityGroupTargetsOptions.Start = &start
 		}
-		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
+		groups, response, err := sess.ListSecurityGroupTargetsWithContext(flex.OperationContext(d), listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
 			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
 		}
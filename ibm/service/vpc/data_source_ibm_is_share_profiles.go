@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsShareProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsShareProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of share profiles.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The globally unique name for this share profile.",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product family this share profile belongs to.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share profile.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+						"capacity": profileValueSchema("The permitted capacity range (in gigabytes) for a share with this profile."),
+						"iops":     profileValueSchema("The permitted IOPS range for a share with this profile."),
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources across all pages.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsShareProfilesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listShareProfilesOptions := &vpcv1.ListShareProfilesOptions{}
+
+	start := ""
+	allrecs := []vpcv1.ShareProfile{}
+	for {
+		if start != "" {
+			listShareProfilesOptions.Start = &start
+		}
+		shareProfileCollection, response, err := vpcClient.ListShareProfilesWithContext(context, listShareProfilesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListShareProfilesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing share profiles: %s\n%s", err, response))
+		}
+		start = flex.GetNext(shareProfileCollection.Next)
+		allrecs = append(allrecs, shareProfileCollection.Profiles...)
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(dataSourceIBMIsShareProfilesID(d))
+
+	profiles := make([]map[string]interface{}, 0, len(allrecs))
+	for _, profile := range allrecs {
+		profiles = append(profiles, map[string]interface{}{
+			"name":          profile.Name,
+			"family":        profile.Family,
+			"href":          profile.Href,
+			"resource_type": profile.ResourceType,
+			"capacity":      dataSourceProfileFlattenValue(profile.Capacity),
+			"iops":          dataSourceProfileFlattenValue(profile.Iops),
+		})
+	}
+	if err = d.Set("profiles", profiles); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profiles %s", err))
+	}
+	if err = d.Set("total_count", len(allrecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsShareProfilesID returns a reasonable ID for the list.
+func dataSourceIBMIsShareProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
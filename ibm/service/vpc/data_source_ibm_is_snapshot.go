@@ -144,7 +144,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 			if start != "" {
 				listSnapshotOptions.Start = &start
 			}
-			snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
+			snapshots, response, err := sess.ListSnapshotsWithContext(flex.OperationContext(d), listSnapshotOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Fetching snapshots %s\n%s", err, response)
 			}
@@ -186,7 +186,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(flex.OperationContext(d), getSnapshotOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching snapshot %s\n%s", err, response)
 		}
@@ -174,7 +174,7 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 			listSnapshotOptions.SourceVolumeID = &sourceVolumeFilter
 		}
 
-		snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
+		snapshots, response, err := sess.ListSnapshotsWithContext(flex.OperationContext(d), listSnapshotOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response)
 		}
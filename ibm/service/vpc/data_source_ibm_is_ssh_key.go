@@ -109,7 +109,7 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			listKeysOptions.Start = &start
 		}
 
-		keys, response, err := sess.ListKeys(listKeysOptions)
+		keys, response, err := sess.ListKeysWithContext(flex.OperationContext(d), listKeysOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching Keys %s\n%s", err, response)
 		}
@@ -175,7 +175,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnetinfo, response, err := sess.GetSubnet(getSubnetOptions)
+		subnetinfo, response, err := sess.GetSubnetWithContext(flex.OperationContext(d), getSubnetOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
 		}
@@ -190,7 +190,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 			if start != "" {
 				getSubnetsListOptions.Start = &start
 			}
-			subnetsCollection, response, err := sess.ListSubnets(getSubnetsListOptions)
+			subnetsCollection, response, err := sess.ListSubnetsWithContext(flex.OperationContext(d), getSubnetsListOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Fetching subnets List %s\n%s", err, response)
 			}
@@ -235,13 +235,13 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, *subnet.CRN, "", isUserTagType)
+	tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *subnet.CRN, "", isUserTagType)
 	if err != nil {
 		log.Printf(
 			"An error occured during reading of subnet (%s) tags : %s", d.Id(), err)
 	}
 
-	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *subnet.CRN, "", isAccessTagType)
+	accesstags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *subnet.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource subnet (%s) access tags: %s", d.Id(), err)
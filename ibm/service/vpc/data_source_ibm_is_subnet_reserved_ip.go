@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -109,7 +110,7 @@ func dataSdataSourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}
 	reservedIPID := d.Get(isReservedIPID).(string)
 
 	options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
-	reserveIP, response, err := sess.GetSubnetReservedIP(options)
+	reserveIP, response, err := sess.GetSubnetReservedIPWithContext(flex.OperationContext(d), options)
 
 	if err != nil || response == nil || reserveIP == nil {
 		return fmt.Errorf("[ERROR] Error fetching the reserved IP %s\n%s", err, response)
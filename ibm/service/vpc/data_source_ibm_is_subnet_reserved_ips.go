@@ -123,7 +123,7 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 			options.Start = &start
 		}
 
-		result, response, err := sess.ListSubnetReservedIps(options)
+		result, response, err := sess.ListSubnetReservedIpsWithContext(flex.OperationContext(d), options)
 		if err != nil || response == nil || result == nil {
 			return fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
 		}
@@ -20,7 +20,7 @@ const (
 )
 
 func DataSourceIBMISSubnets() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithFilter(&schema.Resource{
 		Read: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
@@ -100,7 +100,7 @@ func DataSourceIBMISSubnets() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, isSubnets)
 }
 
 func dataSourceIBMISSubnetsRead(d *schema.ResourceData, meta interface{}) error {
@@ -149,7 +149,7 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			options.Start = &start
 		}
-		subnets, response, err := sess.ListSubnets(options)
+		subnets, response, err := sess.ListSubnetsWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
 		}
@@ -184,6 +184,10 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnetsInfo = append(subnetsInfo, l)
 	}
+	subnetsInfo, err = flex.FilterItems(d, subnetsInfo, flex.CRNTags(meta))
+	if err != nil {
+		return err
+	}
 	d.SetId(dataSourceIBMISSubnetsID(d))
 	d.Set(isSubnets, subnetsInfo)
 	return nil
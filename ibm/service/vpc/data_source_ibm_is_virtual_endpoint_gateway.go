@@ -126,7 +126,7 @@ func dataSourceIBMISEndpointGatewayRead(
 		if start != "" {
 			options.Start = &start
 		}
-		result, response, err := sess.ListEndpointGateways(options)
+		result, response, err := sess.ListEndpointGatewaysWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
 		}
@@ -101,7 +101,7 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 		if start != "" {
 			options.Start = &start
 		}
-		result, response, err := sess.ListEndpointGatewayIps(options)
+		result, response, err := sess.ListEndpointGatewayIpsWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error fetching endpoint gateway ips %s\n%s", err, response)
 		}
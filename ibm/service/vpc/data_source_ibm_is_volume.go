@@ -197,7 +197,7 @@ func volumeGet(d *schema.ResourceData, meta interface{}, name string) error {
 			listVolumesOptions.ZoneName = &zone
 		}
 		listVolumesOptions.Name = &name
-		vols, response, err := sess.ListVolumes(listVolumesOptions)
+		vols, response, err := sess.ListVolumesWithContext(flex.OperationContext(d), listVolumesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching volumes %s\n%s", err, response)
 		}
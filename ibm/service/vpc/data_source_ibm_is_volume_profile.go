@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -53,7 +54,7 @@ func volumeProfileGet(d *schema.ResourceData, meta interface{}, name string) err
 	getVolumeProfileOptions := &vpcv1.GetVolumeProfileOptions{
 		Name: &name,
 	}
-	profile, _, err := sess.GetVolumeProfile(getVolumeProfileOptions)
+	profile, _, err := sess.GetVolumeProfileWithContext(flex.OperationContext(d), getVolumeProfileOptions)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func volumeProfilesList(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			listVolumeProfilesOptions.Start = &start
 		}
-		availableProfiles, response, err := sess.ListVolumeProfiles(listVolumeProfilesOptions)
+		availableProfiles, response, err := sess.ListVolumeProfilesWithContext(flex.OperationContext(d), listVolumeProfilesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching Volume Profiles %s\n%s", err, response)
 		}
@@ -308,7 +308,7 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		if start != "" {
 			listVpcsOptions.Start = &start
 		}
-		vpcs, response, err := sess.ListVpcs(listVpcsOptions)
+		vpcs, response, err := sess.ListVpcsWithContext(flex.OperationContext(d), listVpcsOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching vpcs %s\n%s", err, response)
 		}
@@ -388,7 +388,7 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 				if startSub != "" {
 					options.Start = &start
 				}
-				s, response, err := sess.ListSubnets(options)
+				s, response, err := sess.ListSubnetsWithContext(flex.OperationContext(d), options)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response)
 				}
@@ -429,7 +429,7 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 				if startSg != "" {
 					listSgOptions.Start = &start
 				}
-				sgs, response, err := sess.ListSecurityGroups(listSgOptions)
+				sgs, response, err := sess.ListSecurityGroupsWithContext(flex.OperationContext(d), listSgOptions)
 				if err != nil || sgs == nil {
 					return fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response)
 				}
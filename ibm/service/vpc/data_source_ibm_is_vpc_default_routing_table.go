@@ -6,6 +6,7 @@ package vpc
 import (
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -136,7 +137,7 @@ func dataSourceIBMISVPCDefaultRoutingTableGet(d *schema.ResourceData, meta inter
 	vpcID := d.Get(isDefaultRTVpcID).(string)
 
 	getVpcDefaultRoutingTableOptions := sess.NewGetVPCDefaultRoutingTableOptions(vpcID)
-	result, detail, err := sess.GetVPCDefaultRoutingTable(getVpcDefaultRoutingTableOptions)
+	result, detail, err := sess.GetVPCDefaultRoutingTableWithContext(flex.OperationContext(d), getVpcDefaultRoutingTableOptions)
 	if err != nil || result == nil {
 		log.Printf("Error reading details of VPC Default Routing Table:%s", detail)
 		return err
@@ -114,7 +114,7 @@ func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta inter
 		if start != "" {
 			listVpcRoutingTablesRoutesOptions.Start = &start
 		}
-		result, detail, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
+		result, detail, err := sess.ListVPCRoutingTableRoutesWithContext(flex.OperationContext(d), listVpcRoutingTablesRoutesOptions)
 		if err != nil {
 			log.Printf("Error reading list of VPC Routing Table Routes:%s\n%s", err, detail)
 			return err
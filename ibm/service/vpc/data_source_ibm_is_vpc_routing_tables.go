@@ -155,7 +155,7 @@ func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{
 		if start != "" {
 			listOptions.Start = &start
 		}
-		result, detail, err := sess.ListVPCRoutingTables(listOptions)
+		result, detail, err := sess.ListVPCRoutingTablesWithContext(flex.OperationContext(d), listOptions)
 		if err != nil {
 			log.Printf("Error reading list of VPC Routing Tables:%s\n%s", err, detail)
 			return err
@@ -162,7 +162,7 @@ func dataSourceIBMVPNGatewayConnectionsRead(d *schema.ResourceData, meta interfa
 	vpngatewayID := d.Get(isVPNGatewayID).(string)
 	listvpnGWConnectionOptions := sess.NewListVPNGatewayConnectionsOptions(vpngatewayID)
 
-	availableVPNGatewayConnections, detail, err := sess.ListVPNGatewayConnections(listvpnGWConnectionOptions)
+	availableVPNGatewayConnections, detail, err := sess.ListVPNGatewayConnectionsWithContext(flex.OperationContext(d), listvpnGWConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of VPN Gateway Connections:%s\n%s", err, detail)
 	}
@@ -127,7 +127,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		if start != "" {
 			listvpnGWOptions.Start = &start
 		}
-		availableVPNGateways, detail, err := sess.ListVPNGateways(listvpnGWOptions)
+		availableVPNGateways, detail, err := sess.ListVPNGatewaysWithContext(flex.OperationContext(d), listvpnGWOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error reading list of VPN Gateways:%s\n%s", err, detail)
 		}
@@ -145,7 +145,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		gateway[isVPNGatewayName] = *data.Name
 		gateway[isVPNGatewayCreatedAt] = data.CreatedAt.String()
 		gateway[isVPNGatewayResourceType] = *data.ResourceType
-		gateway[isVPNGatewayStatus] = *data.Status
+		gateway[isVPNGatewayStatus] = *data.LifecycleState
 		gateway[isVPNGatewayMode] = *data.Mode
 		gateway[isVPNGatewayResourceGroup] = *data.ResourceGroup.ID
 		gateway[isVPNGatewaySubnet] = *data.Subnet.ID
@@ -158,7 +158,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 				if memberIP.PublicIP != nil {
 					currentMemberIP["address"] = *memberIP.PublicIP.Address
 					currentMemberIP["role"] = *memberIP.Role
-					currentMemberIP["status"] = *memberIP.Status
+					currentMemberIP["status"] = *memberIP.LifecycleState
 					vpcMembersIpsList = append(vpcMembersIpsList, currentMemberIP)
 				}
 				if memberIP.PrivateIP != nil {
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVPNServerClientConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNServerClientConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN server identifier.",
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_private_key"},
+				Description:  "The VPN client certificate, encoded in PEM format, to embed in the client configuration.",
+			},
+			"client_private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "The private key of the VPN client certificate, encoded in PEM format, to embed in the client configuration.",
+			},
+			"vpn_server_client_configuration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OpenVPN client configuration file for the VPN server.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNServerClientConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get("vpn_server").(string)
+	getVPNServerClientConfigurationOptions := &vpcv1.GetVPNServerClientConfigurationOptions{
+		ID: &vpnServerID,
+	}
+	configuration, response, err := vpcClient.GetVPNServerClientConfigurationWithContext(context, getVPNServerClientConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPNServerClientConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting client configuration of VPN server (%s): %s\n%s", vpnServerID, err, response))
+	}
+
+	d.SetId(vpnServerID)
+	d.Set("vpn_server_client_configuration", vpnServerRenderClientConfiguration(*configuration, d.Get("client_certificate").(string), d.Get("client_private_key").(string)))
+	return nil
+}
+
+// vpnServerRenderClientConfiguration embeds the client certificate and its key, when set, in the
+// OpenVPN client configuration, so that it can be used as a single file
+func vpnServerRenderClientConfiguration(configuration, certificate, privateKey string) string {
+	if certificate == "" || privateKey == "" {
+		return configuration
+	}
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(configuration, "\n"))
+	b.WriteString("\n<cert>\n")
+	b.WriteString(strings.TrimSpace(certificate))
+	b.WriteString("\n</cert>\n<key>\n")
+	b.WriteString(strings.TrimSpace(privateKey))
+	b.WriteString("\n</key>\n")
+	return b.String()
+}
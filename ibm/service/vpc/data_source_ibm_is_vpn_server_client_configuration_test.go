@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPNServerClientConfigurationDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_vpn_server_client_configuration.test1"
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckVPNServer(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerClientConfigurationDataSourceConfig(vpcname, subnetname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "vpn_server", "ibm_is_vpn_server.testacc_vpn_server", "id"),
+					resource.TestCheckResourceAttrSet(resName, "vpn_server_client_configuration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNServerClientConfigurationDataSourceConfig(vpcname, subnetname, name string) string {
+	return testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name, false) + `
+
+	data "ibm_is_vpn_server_client_configuration" "test1" {
+		vpn_server = ibm_is_vpn_server.testacc_vpn_server.id
+	}`
+}
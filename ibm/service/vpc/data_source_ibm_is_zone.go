@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -55,7 +56,7 @@ func zoneGet(d *schema.ResourceData, meta interface{}, regionName, zoneName stri
 		RegionName: &regionName,
 		Name:       &zoneName,
 	}
-	zone, _, err := sess.GetRegionZone(getRegionZoneOptions)
+	zone, _, err := sess.GetRegionZoneWithContext(flex.OperationContext(d), getRegionZoneOptions)
 	if err != nil {
 		return err
 	}
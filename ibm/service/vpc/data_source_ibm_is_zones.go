@@ -6,6 +6,7 @@ package vpc
 import (
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -53,7 +54,7 @@ func zonesList(d *schema.ResourceData, meta interface{}, regionName string) erro
 	listRegionZonesOptions := &vpcv1.ListRegionZonesOptions{
 		RegionName: &regionName,
 	}
-	availableZones, _, err := sess.ListRegionZones(listRegionZonesOptions)
+	availableZones, _, err := sess.ListRegionZonesWithContext(flex.OperationContext(d), listRegionZonesOptions)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,426 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBackupPolicyName               = "name"
+	isBackupPolicyMatchUserTags      = "match_user_tags"
+	isBackupPolicyMatchResourceTypes = "match_resource_types"
+	isBackupPolicyResourceGroup      = "resource_group"
+	isBackupPolicyPlans              = "plans"
+	isBackupPolicyHealthState        = "health_state"
+	isBackupPolicyHealthReasons      = "health_reasons"
+	isBackupPolicyLastJobCompletedAt = "last_job_completed_at"
+	isBackupPolicyLifecycleState     = "lifecycle_state"
+	isBackupPolicyDeleted            = "deleted"
+)
+
+func ResourceIBMIsBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBackupPolicyCreate,
+		ReadContext:   resourceIBMIsBackupPolicyRead,
+		UpdateContext: resourceIBMIsBackupPolicyUpdate,
+		DeleteContext: resourceIBMIsBackupPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBackupPolicyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", isBackupPolicyName),
+				Description:  "The user-defined name for this backup policy. Names must be unique within the region this backup policy resides in.",
+			},
+			isBackupPolicyMatchUserTags: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", "tag")},
+				Set:         schema.HashString,
+				Description: "The user tags this backup policy applies to. Resources that have both a matching user tag and a matching type will be subject to the backup policy.",
+			},
+			isBackupPolicyMatchResourceTypes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", isBackupPolicyMatchResourceTypes)},
+				Set:         schema.HashString,
+				Description: "The resource types this backup policy applies to. Resources that have both a matching type and a matching user tag will be subject to the backup policy.",
+			},
+			isBackupPolicyResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this backup policy.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy.",
+			},
+			isBackupPolicyHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of this backup policy.",
+			},
+			isBackupPolicyHealthReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current health state (if any).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the reason for this health state.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the reason for this health state.",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link to documentation about the reason for this health state.",
+						},
+					},
+				},
+			},
+			isBackupPolicyLastJobCompletedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the most recent job for this backup policy completed.",
+			},
+			isBackupPolicyLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the backup policy.",
+			},
+			isBackupPolicyPlans: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plans for the backup policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this backup policy plan.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this backup policy plan.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this backup policy plan.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBackupPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyMatchResourceTypes,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "volume"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmISBackupPolicyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_backup_policy", Schema: validateSchema}
+	return &ibmISBackupPolicyResourceValidator
+}
+
+func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(isBackupPolicyName).(string)
+	createBackupPolicyOptions := &vpcv1.CreateBackupPolicyOptions{
+		Name:          &name,
+		MatchUserTags: flex.ExpandStringList(d.Get(isBackupPolicyMatchUserTags).(*schema.Set).List()),
+	}
+	if resourceTypes, ok := d.GetOk(isBackupPolicyMatchResourceTypes); ok {
+		createBackupPolicyOptions.MatchResourceTypes = flex.ExpandStringList(resourceTypes.(*schema.Set).List())
+	}
+	if rg, ok := d.GetOk(isBackupPolicyResourceGroup); ok {
+		rgID := rg.(string)
+		createBackupPolicyOptions.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rgID,
+		}
+	}
+
+	backupPolicy, response, err := vpcClient.CreateBackupPolicyWithContext(context, createBackupPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating backup policy: %s\n%s", err, response))
+	}
+	d.SetId(*backupPolicy.ID)
+	log.Printf("[INFO] Backup policy : %s", *backupPolicy.ID)
+
+	_, err = isWaitForBackupPolicyAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{
+		ID: &id,
+	}
+	backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting backup policy (%s): %s\n%s", id, err, response))
+	}
+
+	d.Set(isBackupPolicyName, backupPolicy.Name)
+	d.Set(isBackupPolicyMatchUserTags, flex.NewStringSet(schema.HashString, backupPolicy.MatchUserTags))
+	d.Set(isBackupPolicyMatchResourceTypes, flex.NewStringSet(schema.HashString, backupPolicy.MatchResourceTypes))
+	if backupPolicy.ResourceGroup != nil {
+		d.Set(isBackupPolicyResourceGroup, backupPolicy.ResourceGroup.ID)
+	}
+	d.Set("created_at", backupPolicy.CreatedAt.String())
+	d.Set("crn", backupPolicy.CRN)
+	d.Set("href", backupPolicy.Href)
+	d.Set(isBackupPolicyHealthState, backupPolicy.HealthState)
+	healthReasons := make([]map[string]interface{}, 0, len(backupPolicy.HealthReasons))
+	for _, hr := range backupPolicy.HealthReasons {
+		healthReasons = append(healthReasons, map[string]interface{}{
+			"code":      hr.Code,
+			"message":   hr.Message,
+			"more_info": hr.MoreInfo,
+		})
+	}
+	if err = d.Set(isBackupPolicyHealthReasons, healthReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting health_reasons: %s", err))
+	}
+	if backupPolicy.LastJobCompletedAt != nil {
+		d.Set(isBackupPolicyLastJobCompletedAt, backupPolicy.LastJobCompletedAt.String())
+	}
+	d.Set(isBackupPolicyLifecycleState, backupPolicy.LifecycleState)
+	plans := make([]map[string]interface{}, 0, len(backupPolicy.Plans))
+	for _, plan := range backupPolicy.Plans {
+		plans = append(plans, map[string]interface{}{
+			"id":            plan.ID,
+			"name":          plan.Name,
+			"href":          plan.Href,
+			"resource_type": plan.ResourceType,
+		})
+	}
+	if err = d.Set(isBackupPolicyPlans, plans); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting plans: %s", err))
+	}
+	d.Set("resource_type", backupPolicy.ResourceType)
+
+	return nil
+}
+
+func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	backupPolicyPatchModel := &vpcv1.BackupPolicyPatch{}
+	hasChange := false
+	if d.HasChange(isBackupPolicyName) {
+		name := d.Get(isBackupPolicyName).(string)
+		backupPolicyPatchModel.Name = &name
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyMatchUserTags) {
+		backupPolicyPatchModel.MatchUserTags = flex.ExpandStringList(d.Get(isBackupPolicyMatchUserTags).(*schema.Set).List())
+		hasChange = true
+	}
+	if hasChange {
+		backupPolicyPatch, err := backupPolicyPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BackupPolicyPatch: %s", err))
+		}
+		updateBackupPolicyOptions := &vpcv1.UpdateBackupPolicyOptions{
+			ID:                &id,
+			BackupPolicyPatch: backupPolicyPatch,
+		}
+		_, response, err := vpcClient.UpdateBackupPolicyWithContext(context, updateBackupPolicyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating backup policy (%s): %s\n%s", id, err, response))
+		}
+		_, err = isWaitForBackupPolicyAvailable(vpcClient, id, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	deleteBackupPolicyOptions := &vpcv1.DeleteBackupPolicyOptions{
+		ID: &id,
+	}
+	_, response, err := vpcClient.DeleteBackupPolicyWithContext(context, deleteBackupPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting backup policy (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForBackupPolicyDeleted(vpcClient, id, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBackupPolicyAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.BackupPolicyLifecycleStatePendingConst, vpcv1.BackupPolicyLifecycleStateUpdatingConst, vpcv1.BackupPolicyLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.BackupPolicyLifecycleStateStableConst, vpcv1.BackupPolicyLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{
+				ID: &id,
+			}
+			backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(flex.OperationContext(d), getBackupPolicyOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy: %s\n%s", err, response)
+			}
+			d.Set(isBackupPolicyLifecycleState, *backupPolicy.LifecycleState)
+			if *backupPolicy.LifecycleState == vpcv1.BackupPolicyLifecycleStateFailedConst {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("[ERROR] The backup policy %s failed", id)
+			}
+			return backupPolicy, *backupPolicy.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForBackupPolicyDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.BackupPolicyLifecycleStateDeletingConst, vpcv1.BackupPolicyLifecycleStateStableConst, vpcv1.BackupPolicyLifecycleStateUpdatingConst},
+		Target:  []string{isBackupPolicyDeleted, vpcv1.BackupPolicyLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{
+				ID: &id,
+			}
+			backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(flex.OperationContext(d), getBackupPolicyOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isBackupPolicyDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy: %s\n%s", err, response)
+			}
+			if *backupPolicy.LifecycleState == vpcv1.BackupPolicyLifecycleStateFailedConst {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("[ERROR] The backup policy %s failed to delete", id)
+			}
+			return backupPolicy, *backupPolicy.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
@@ -0,0 +1,619 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBackupPolicyPlanBackupPolicyID     = "backup_policy_id"
+	isBackupPolicyPlanID                 = "backup_policy_plan_id"
+	isBackupPolicyPlanName               = "name"
+	isBackupPolicyPlanCronSpec           = "cron_spec"
+	isBackupPolicyPlanActive             = "active"
+	isBackupPolicyPlanAttachUserTags     = "attach_user_tags"
+	isBackupPolicyPlanCopyUserTags       = "copy_user_tags"
+	isBackupPolicyPlanDeleteAfter        = "delete_after"
+	isBackupPolicyPlanDeleteOverCount    = "delete_over_count"
+	isBackupPolicyPlanClonePolicy        = "clone_policy"
+	isBackupPolicyPlanRemoteRegionPolicy = "remote_region_policy"
+	isBackupPolicyPlanLifecycleState     = "lifecycle_state"
+	isBackupPolicyPlanDeleted            = "deleted"
+)
+
+func ResourceIBMIsBackupPolicyPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBackupPolicyPlanCreate,
+		ReadContext:   resourceIBMIsBackupPolicyPlanRead,
+		UpdateContext: resourceIBMIsBackupPolicyPlanUpdate,
+		DeleteContext: resourceIBMIsBackupPolicyPlanDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBackupPolicyPlanBackupPolicyID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The backup policy identifier.",
+			},
+			isBackupPolicyPlanCronSpec: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanCronSpec),
+				Description:  "The cron specification for the backup schedule, in UTC.",
+			},
+			isBackupPolicyPlanName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanName),
+				Description:  "The user-defined name for this backup policy plan. Names must be unique within the backup policy this plan resides in.",
+			},
+			isBackupPolicyPlanActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the plan is active.",
+			},
+			isBackupPolicyPlanAttachUserTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "tag")},
+				Set:         schema.HashString,
+				Description: "User tags to attach to each backup (snapshot) created by this plan.",
+			},
+			isBackupPolicyPlanCopyUserTags: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether to copy the source's user tags to the created backups (snapshots).",
+			},
+			isBackupPolicyPlanDeleteAfter: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteAfter),
+				Description:  "The maximum number of days to keep each backup after creation.",
+			},
+			isBackupPolicyPlanDeleteOverCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteOverCount),
+				Description:  "The maximum number of recent backups to keep. If unspecified, there will be no maximum.",
+			},
+			isBackupPolicyPlanClonePolicy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The policy for the fast restore clones of the backups created by this plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_snapshots": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "max_snapshots"),
+							Description:  "The maximum number of recent snapshots (per source) that will keep clones.",
+						},
+						"zones": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The zone names this backup policy plan will create snapshot clones in.",
+						},
+					},
+				},
+			},
+			isBackupPolicyPlanRemoteRegionPolicy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The policies for copying the backups created by this plan to other regions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the region to copy the backups to.",
+						},
+						"delete_over_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteOverCount),
+							Description:  "The maximum number of recent remote copies to keep in this region.",
+						},
+						"encryption_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The CRN of the root key to use to encrypt the remote copies, in the remote region.",
+						},
+					},
+				},
+			},
+			isBackupPolicyPlanID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this backup policy plan.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy plan was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy plan.",
+			},
+			isBackupPolicyPlanLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of this backup policy plan.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBackupPolicyPlanValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyPlanName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyPlanCronSpec,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^((((\d+,)+\d+|([\d\*]+(\/|-)\d+)|\d+|\*) ?){5,7})$`,
+			MinValueLength:             9,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyPlanDeleteAfter,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "1",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBackupPolicyPlanDeleteOverCount,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "1",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "max_snapshots",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "1",
+			MaxValue:                   "5"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmISBackupPolicyPlanResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_backup_policy_plan", Schema: validateSchema}
+	return &ibmISBackupPolicyPlanResourceValidator
+}
+
+func resourceIBMIsBackupPolicyPlanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get(isBackupPolicyPlanBackupPolicyID).(string)
+	cronSpec := d.Get(isBackupPolicyPlanCronSpec).(string)
+	copyUserTags := d.Get(isBackupPolicyPlanCopyUserTags).(bool)
+	createBackupPolicyPlanOptions := &vpcv1.CreateBackupPolicyPlanOptions{
+		BackupPolicyID: &backupPolicyID,
+		CronSpec:       &cronSpec,
+		CopyUserTags:   &copyUserTags,
+	}
+	if v, ok := d.GetOk(isBackupPolicyPlanName); ok {
+		name := v.(string)
+		createBackupPolicyPlanOptions.Name = &name
+	}
+	if v, ok := d.GetOkExists(isBackupPolicyPlanActive); ok {
+		active := v.(bool)
+		createBackupPolicyPlanOptions.Active = &active
+	}
+	if v, ok := d.GetOk(isBackupPolicyPlanAttachUserTags); ok {
+		createBackupPolicyPlanOptions.AttachUserTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	deletionTrigger := &vpcv1.BackupPolicyPlanDeletionTriggerPrototype{}
+	if v, ok := d.GetOk(isBackupPolicyPlanDeleteAfter); ok {
+		deletionTrigger.DeleteAfter = optionalInt64(v.(int))
+	}
+	if v, ok := d.GetOk(isBackupPolicyPlanDeleteOverCount); ok {
+		deletionTrigger.DeleteOverCount = optionalInt64(v.(int))
+	}
+	if deletionTrigger.DeleteAfter != nil || deletionTrigger.DeleteOverCount != nil {
+		createBackupPolicyPlanOptions.DeletionTrigger = deletionTrigger
+	}
+	if v, ok := d.GetOk(isBackupPolicyPlanClonePolicy); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		clonePolicy := v.([]interface{})[0].(map[string]interface{})
+		createBackupPolicyPlanOptions.ClonePolicy = &vpcv1.BackupPolicyPlanClonePolicyPrototype{
+			MaxSnapshots: optionalInt64(clonePolicy["max_snapshots"].(int)),
+			Zones:        backupPolicyPlanExpandZones(clonePolicy["zones"].(*schema.Set)),
+		}
+	}
+	if v, ok := d.GetOk(isBackupPolicyPlanRemoteRegionPolicy); ok {
+		createBackupPolicyPlanOptions.RemoteRegionPolicies = backupPolicyPlanExpandRemoteRegionPolicies(v.([]interface{}))
+	}
+
+	// the plans of a policy are changed one at a time, while the policy is stable
+	isBackupPolicyKey := "backup_policy_key_" + backupPolicyID
+	unlock, err := conns.ResourceLocks.Lock(context, isBackupPolicyKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	backupPolicyPlan, response, err := vpcClient.CreateBackupPolicyPlanWithContext(context, createBackupPolicyPlanOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating plan of backup policy (%s): %s\n%s", backupPolicyID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", backupPolicyID, *backupPolicyPlan.ID))
+	log.Printf("[INFO] Backup policy plan : %s", d.Id())
+
+	_, err = isWaitForBackupPolicyPlanAvailable(vpcClient, backupPolicyID, *backupPolicyPlan.ID, d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsBackupPolicyPlanRead(context, d, meta)
+}
+
+func backupPolicyPlanExpandZones(zones *schema.Set) []vpcv1.ZoneIdentityIntf {
+	zoneNames := flex.ExpandStringList(zones.List())
+	zoneIdentities := make([]vpcv1.ZoneIdentityIntf, 0, len(zoneNames))
+	for i := range zoneNames {
+		zoneIdentities = append(zoneIdentities, &vpcv1.ZoneIdentityByName{
+			Name: &zoneNames[i],
+		})
+	}
+	return zoneIdentities
+}
+
+func backupPolicyPlanExpandRemoteRegionPolicies(policies []interface{}) []vpcv1.BackupPolicyPlanRemoteRegionPolicyPrototype {
+	remoteRegionPolicies := make([]vpcv1.BackupPolicyPlanRemoteRegionPolicyPrototype, 0, len(policies))
+	for _, p := range policies {
+		policy := p.(map[string]interface{})
+		region := policy["region"].(string)
+		remoteRegionPolicy := vpcv1.BackupPolicyPlanRemoteRegionPolicyPrototype{
+			Region:          &vpcv1.RegionIdentityByName{Name: &region},
+			DeleteOverCount: optionalInt64(policy["delete_over_count"].(int)),
+		}
+		if key := policy["encryption_key"].(string); key != "" {
+			remoteRegionPolicy.EncryptionKey = &vpcv1.EncryptionKeyIdentityByCRN{
+				CRN: &key,
+			}
+		}
+		remoteRegionPolicies = append(remoteRegionPolicies, remoteRegionPolicy)
+	}
+	return remoteRegionPolicies
+}
+
+func resourceIBMIsBackupPolicyPlanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{
+		BackupPolicyID: &parts[0],
+		ID:             &parts[1],
+	}
+	backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(context, getBackupPolicyPlanOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting plan (%s) of backup policy: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set(isBackupPolicyPlanBackupPolicyID, parts[0])
+	d.Set(isBackupPolicyPlanID, backupPolicyPlan.ID)
+	d.Set(isBackupPolicyPlanName, backupPolicyPlan.Name)
+	d.Set(isBackupPolicyPlanCronSpec, backupPolicyPlan.CronSpec)
+	d.Set(isBackupPolicyPlanActive, backupPolicyPlan.Active)
+	d.Set(isBackupPolicyPlanAttachUserTags, flex.NewStringSet(schema.HashString, backupPolicyPlan.AttachUserTags))
+	d.Set(isBackupPolicyPlanCopyUserTags, backupPolicyPlan.CopyUserTags)
+	if backupPolicyPlan.DeletionTrigger != nil {
+		d.Set(isBackupPolicyPlanDeleteAfter, flex.IntValue(backupPolicyPlan.DeletionTrigger.DeleteAfter))
+		d.Set(isBackupPolicyPlanDeleteOverCount, flex.IntValue(backupPolicyPlan.DeletionTrigger.DeleteOverCount))
+	}
+	// a plan without clones reports a clone policy without zones
+	clonePolicy := []map[string]interface{}{}
+	if backupPolicyPlan.ClonePolicy != nil && len(backupPolicyPlan.ClonePolicy.Zones) > 0 {
+		zones := make([]string, 0, len(backupPolicyPlan.ClonePolicy.Zones))
+		for _, zone := range backupPolicyPlan.ClonePolicy.Zones {
+			zones = append(zones, *zone.Name)
+		}
+		clonePolicy = append(clonePolicy, map[string]interface{}{
+			"max_snapshots": flex.IntValue(backupPolicyPlan.ClonePolicy.MaxSnapshots),
+			"zones":         flex.NewStringSet(schema.HashString, zones),
+		})
+	}
+	if err = d.Set(isBackupPolicyPlanClonePolicy, clonePolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting clone_policy: %s", err))
+	}
+	remoteRegionPolicies := make([]map[string]interface{}, 0, len(backupPolicyPlan.RemoteRegionPolicies))
+	for _, policy := range backupPolicyPlan.RemoteRegionPolicies {
+		remoteRegionPolicy := map[string]interface{}{
+			"region":            policy.Region.Name,
+			"delete_over_count": flex.IntValue(policy.DeleteOverCount),
+		}
+		if policy.EncryptionKey != nil {
+			remoteRegionPolicy["encryption_key"] = policy.EncryptionKey.CRN
+		}
+		remoteRegionPolicies = append(remoteRegionPolicies, remoteRegionPolicy)
+	}
+	if err = d.Set(isBackupPolicyPlanRemoteRegionPolicy, remoteRegionPolicies); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting remote_region_policy: %s", err))
+	}
+	d.Set("created_at", backupPolicyPlan.CreatedAt.String())
+	d.Set("href", backupPolicyPlan.Href)
+	d.Set(isBackupPolicyPlanLifecycleState, backupPolicyPlan.LifecycleState)
+	d.Set("resource_type", backupPolicyPlan.ResourceType)
+
+	return nil
+}
+
+func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backupPolicyID := parts[0]
+	planID := parts[1]
+
+	backupPolicyPlanPatchModel := &vpcv1.BackupPolicyPlanPatch{}
+	hasChange := false
+	if d.HasChange(isBackupPolicyPlanName) {
+		name := d.Get(isBackupPolicyPlanName).(string)
+		backupPolicyPlanPatchModel.Name = &name
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanCronSpec) {
+		cronSpec := d.Get(isBackupPolicyPlanCronSpec).(string)
+		backupPolicyPlanPatchModel.CronSpec = &cronSpec
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanActive) {
+		active := d.Get(isBackupPolicyPlanActive).(bool)
+		backupPolicyPlanPatchModel.Active = &active
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanAttachUserTags) {
+		backupPolicyPlanPatchModel.AttachUserTags = flex.ExpandStringList(d.Get(isBackupPolicyPlanAttachUserTags).(*schema.Set).List())
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanCopyUserTags) {
+		copyUserTags := d.Get(isBackupPolicyPlanCopyUserTags).(bool)
+		backupPolicyPlanPatchModel.CopyUserTags = &copyUserTags
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanDeleteAfter) || d.HasChange(isBackupPolicyPlanDeleteOverCount) {
+		backupPolicyPlanPatchModel.DeletionTrigger = &vpcv1.BackupPolicyPlanDeletionTriggerPatch{
+			DeleteAfter:     optionalInt64(d.Get(isBackupPolicyPlanDeleteAfter).(int)),
+			DeleteOverCount: optionalInt64(d.Get(isBackupPolicyPlanDeleteOverCount).(int)),
+		}
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanClonePolicy) {
+		clonePolicyPatch := &vpcv1.BackupPolicyPlanClonePolicyPatch{}
+		if v := d.Get(isBackupPolicyPlanClonePolicy).([]interface{}); len(v) > 0 && v[0] != nil {
+			clonePolicy := v[0].(map[string]interface{})
+			clonePolicyPatch.MaxSnapshots = optionalInt64(clonePolicy["max_snapshots"].(int))
+			clonePolicyPatch.Zones = backupPolicyPlanExpandZones(clonePolicy["zones"].(*schema.Set))
+		}
+		backupPolicyPlanPatchModel.ClonePolicy = clonePolicyPatch
+		hasChange = true
+	}
+	if d.HasChange(isBackupPolicyPlanRemoteRegionPolicy) {
+		backupPolicyPlanPatchModel.RemoteRegionPolicies = backupPolicyPlanExpandRemoteRegionPolicies(d.Get(isBackupPolicyPlanRemoteRegionPolicy).([]interface{}))
+		hasChange = true
+	}
+	if hasChange {
+		backupPolicyPlanPatch, err := backupPolicyPlanPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BackupPolicyPlanPatch: %s", err))
+		}
+		// the patch model omits the empty values, which are needed to remove the count
+		// retention, the clones and the remote copies
+		if d.HasChange(isBackupPolicyPlanDeleteOverCount) && d.Get(isBackupPolicyPlanDeleteOverCount).(int) == 0 {
+			deletionTrigger, _ := backupPolicyPlanPatch["deletion_trigger"].(map[string]interface{})
+			if deletionTrigger == nil {
+				deletionTrigger = map[string]interface{}{}
+			}
+			deletionTrigger["delete_over_count"] = nil
+			backupPolicyPlanPatch["deletion_trigger"] = deletionTrigger
+		}
+		if d.HasChange(isBackupPolicyPlanClonePolicy) && len(d.Get(isBackupPolicyPlanClonePolicy).([]interface{})) == 0 {
+			backupPolicyPlanPatch["clone_policy"] = map[string]interface{}{
+				"zones": []interface{}{},
+			}
+		}
+		if d.HasChange(isBackupPolicyPlanRemoteRegionPolicy) && len(d.Get(isBackupPolicyPlanRemoteRegionPolicy).([]interface{})) == 0 {
+			backupPolicyPlanPatch["remote_region_policies"] = []interface{}{}
+		}
+
+		isBackupPolicyKey := "backup_policy_key_" + backupPolicyID
+		unlock, err := conns.ResourceLocks.Lock(context, isBackupPolicyKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
+
+		updateBackupPolicyPlanOptions := &vpcv1.UpdateBackupPolicyPlanOptions{
+			BackupPolicyID:        &backupPolicyID,
+			ID:                    &planID,
+			BackupPolicyPlanPatch: backupPolicyPlanPatch,
+		}
+		_, response, err := vpcClient.UpdateBackupPolicyPlanWithContext(context, updateBackupPolicyPlanOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicyPlanWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating plan (%s) of backup policy: %s\n%s", d.Id(), err, response))
+		}
+		_, err = isWaitForBackupPolicyPlanAvailable(vpcClient, backupPolicyID, planID, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsBackupPolicyPlanRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyPlanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backupPolicyID := parts[0]
+	planID := parts[1]
+
+	isBackupPolicyKey := "backup_policy_key_" + backupPolicyID
+	unlock, err := conns.ResourceLocks.Lock(context, isBackupPolicyKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	deleteBackupPolicyPlanOptions := &vpcv1.DeleteBackupPolicyPlanOptions{
+		BackupPolicyID: &backupPolicyID,
+		ID:             &planID,
+	}
+	_, response, err := vpcClient.DeleteBackupPolicyPlanWithContext(context, deleteBackupPolicyPlanOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting plan (%s) of backup policy: %s\n%s", d.Id(), err, response))
+	}
+	_, err = isWaitForBackupPolicyPlanDeleted(vpcClient, backupPolicyID, planID, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBackupPolicyPlanAvailable(vpcClient *vpcv1.VpcV1, backupPolicyID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for plan (%s) of backup policy (%s) to be available.", id, backupPolicyID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.BackupPolicyPlanLifecycleStatePendingConst, vpcv1.BackupPolicyPlanLifecycleStateUpdatingConst, vpcv1.BackupPolicyPlanLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.BackupPolicyPlanLifecycleStateStableConst, vpcv1.BackupPolicyPlanLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{
+				BackupPolicyID: &backupPolicyID,
+				ID:             &id,
+			}
+			backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(flex.OperationContext(d), getBackupPolicyPlanOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy plan: %s\n%s", err, response)
+			}
+			d.Set(isBackupPolicyPlanLifecycleState, *backupPolicyPlan.LifecycleState)
+			if *backupPolicyPlan.LifecycleState == vpcv1.BackupPolicyPlanLifecycleStateFailedConst {
+				return backupPolicyPlan, *backupPolicyPlan.LifecycleState, fmt.Errorf("[ERROR] The plan %s of backup policy %s failed", id, backupPolicyID)
+			}
+			return backupPolicyPlan, *backupPolicyPlan.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForBackupPolicyPlanDeleted(vpcClient *vpcv1.VpcV1, backupPolicyID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for plan (%s) of backup policy (%s) to be deleted.", id, backupPolicyID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.BackupPolicyPlanLifecycleStateDeletingConst, vpcv1.BackupPolicyPlanLifecycleStateStableConst, vpcv1.BackupPolicyPlanLifecycleStateUpdatingConst},
+		Target:  []string{isBackupPolicyPlanDeleted, vpcv1.BackupPolicyPlanLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{
+				BackupPolicyID: &backupPolicyID,
+				ID:             &id,
+			}
+			backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(flex.OperationContext(d), getBackupPolicyPlanOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isBackupPolicyPlanDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy plan: %s\n%s", err, response)
+			}
+			if *backupPolicyPlan.LifecycleState == vpcv1.BackupPolicyPlanLifecycleStateFailedConst {
+				return backupPolicyPlan, *backupPolicyPlan.LifecycleState, fmt.Errorf("[ERROR] The plan %s of backup policy %s failed to delete", id, backupPolicyID)
+			}
+			return backupPolicyPlan, *backupPolicyPlan.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISBackupPolicyPlan_basic(t *testing.T) {
+	policyname := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyname, name, "30 09 * * *", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBackupPolicyPlanExists("ibm_is_backup_policy_plan.testacc_backup_policy_plan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "cron_spec", "30 09 * * *"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "delete_after", "7"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "delete_over_count", "5"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "backup_policy_plan_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyname, name, "0 0 * * 0", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBackupPolicyPlanExists("ibm_is_backup_policy_plan.testacc_backup_policy_plan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "cron_spec", "0 0 * * 0"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "delete_after", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISBackupPolicyPlan_remoteRegion(t *testing.T) {
+	policyname := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanRemoteRegionConfig(policyname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBackupPolicyPlanExists("ibm_is_backup_policy_plan.testacc_backup_policy_plan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "remote_region_policy.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "remote_region_policy.0.region", "us-east"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy_plan.testacc_backup_policy_plan", "remote_region_policy.0.delete_over_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBackupPolicyPlanDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy_plan" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{
+			BackupPolicyID: &parts[0],
+			ID:             &parts[1],
+		}
+		_, response, err := vpcClient.GetBackupPolicyPlan(getBackupPolicyPlanOptions)
+		if err == nil {
+			return fmt.Errorf("Backup policy plan still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for backup policy plan (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISBackupPolicyPlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{
+			BackupPolicyID: &parts[0],
+			ID:             &parts[1],
+		}
+		_, _, err = vpcClient.GetBackupPolicyPlan(getBackupPolicyPlanOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISBackupPolicyPlanConfig(policyname, name, cronSpec string, deleteAfter int) string {
+	return testAccCheckIBMISBackupPolicyConfig(policyname, "tf-backup") + fmt.Sprintf(`
+
+	resource "ibm_is_backup_policy_plan" "testacc_backup_policy_plan" {
+		backup_policy_id  = ibm_is_backup_policy.testacc_backup_policy.id
+		name              = "%s"
+		cron_spec         = "%s"
+		attach_user_tags  = ["tf-backup-plan"]
+		delete_after      = %d
+		delete_over_count = 5
+	}`, name, cronSpec, deleteAfter)
+}
+
+func testAccCheckIBMISBackupPolicyPlanRemoteRegionConfig(policyname, name string) string {
+	return testAccCheckIBMISBackupPolicyConfig(policyname, "tf-backup") + fmt.Sprintf(`
+
+	resource "ibm_is_backup_policy_plan" "testacc_backup_policy_plan" {
+		backup_policy_id = ibm_is_backup_policy.testacc_backup_policy.id
+		name             = "%s"
+		cron_spec        = "30 09 * * *"
+		remote_region_policy {
+			region            = "us-east"
+			delete_over_count = 2
+		}
+	}`, name)
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISBackupPolicy_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	nameupdate := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyConfig(name, "tf-backup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBackupPolicyExists("ibm_is_backup_policy.testacc_backup_policy"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy.testacc_backup_policy", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy.testacc_backup_policy", "match_user_tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy.testacc_backup_policy", "match_resource_types.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_backup_policy.testacc_backup_policy", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBackupPolicyConfig(nameupdate, "tf-backup-daily"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBackupPolicyExists("ibm_is_backup_policy.testacc_backup_policy"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_backup_policy.testacc_backup_policy", "name", nameupdate),
+					resource.TestCheckTypeSetElemAttr(
+						"ibm_is_backup_policy.testacc_backup_policy", "match_user_tags.*", "tf-backup-daily"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBackupPolicyDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy" {
+			continue
+		}
+
+		getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, response, err := vpcClient.GetBackupPolicy(getBackupPolicyOptions)
+		if err == nil {
+			return fmt.Errorf("Backup policy still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for backup policy (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISBackupPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err = vpcClient.GetBackupPolicy(getBackupPolicyOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISBackupPolicyConfig(name, tag string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_backup_policy" "testacc_backup_policy" {
+		name            = "%s"
+		match_user_tags = ["%s"]
+	}`, name, tag)
+}
@@ -0,0 +1,1224 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerName                    = "name"
+	isBareMetalServerProfile                 = "profile"
+	isBareMetalServerZone                    = "zone"
+	isBareMetalServerVPC                     = "vpc"
+	isBareMetalServerImage                   = "image"
+	isBareMetalServerKeys                    = "keys"
+	isBareMetalServerUserData                = "user_data"
+	isBareMetalServerResourceGroup           = "resource_group"
+	isBareMetalServerEnableSecureBoot        = "enable_secure_boot"
+	isBareMetalServerTrustedPlatformModule   = "trusted_platform_module"
+	isBareMetalServerPrimaryNetworkInterface = "primary_network_interface"
+	isBareMetalServerNetworkInterfaces       = "network_interfaces"
+	isBareMetalServerTags                    = "tags"
+	isBareMetalServerAccessTags              = "access_tags"
+	isBareMetalServerStatus                  = "status"
+	isBareMetalServerStatusReasons           = "status_reasons"
+
+	isBareMetalServerStatusPending    = "pending"
+	isBareMetalServerStatusStarting   = "starting"
+	isBareMetalServerStatusRunning    = "running"
+	isBareMetalServerStatusRestarting = "restarting"
+	isBareMetalServerStatusStopping   = "stopping"
+	isBareMetalServerStatusStopped    = "stopped"
+	isBareMetalServerStatusDeleting   = "deleting"
+	isBareMetalServerStatusFailed     = "failed"
+	isBareMetalServerDeleteDone       = "done"
+)
+
+func ResourceIBMIsBareMetalServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBareMetalServerCreate,
+		ReadContext:   resourceIBMIsBareMetalServerRead,
+		UpdateContext: resourceIBMIsBareMetalServerUpdate,
+		DeleteContext: resourceIBMIsBareMetalServerDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerName),
+				Description:  "The unique user-defined name for this bare metal server. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			isBareMetalServerProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the profile to use for this bare metal server.",
+			},
+			isBareMetalServerZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone to provision the bare metal server in.",
+			},
+			isBareMetalServerVPC: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The VPC the bare metal server is to be a part of. If specified, it must match the VPC of the subnet of the primary network interface.",
+			},
+			isBareMetalServerImage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the image to use when provisioning the bare metal server.",
+			},
+			isBareMetalServerKeys: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The identifiers of the SSH keys to install on the bare metal server.",
+			},
+			isBareMetalServerUserData: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "User data to be made available when initializing the bare metal server.",
+			},
+			isBareMetalServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			isBareMetalServerEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled. If enabled, the image must support secure boot or the server will fail to boot. Changing it stops and starts the bare metal server.",
+			},
+			isBareMetalServerTrustedPlatformModule: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The trusted platform module (TPM) configuration of the bare metal server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "trusted_platform_module_mode"),
+							Description:  "The mode of the trusted platform module. Changing it stops and starts the bare metal server.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the trusted platform module is enabled.",
+						},
+						"supported_modes": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The supported trusted platform module modes.",
+						},
+					},
+				},
+			},
+			isBareMetalServerPrimaryNetworkInterface: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The primary network interface of the bare metal server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this network interface.",
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerName),
+							Description:  "The user-defined name for this network interface.",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The identifier of the subnet of the network interface.",
+						},
+						"interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "interface_type"),
+							Description:  "The network interface type: `pci` or `hipersocket`. If unspecified, the type supported by the profile is used.",
+						},
+						"primary_ip": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: "The primary IP address to bind to the network interface.",
+							Elem:        bareMetalServerPrimaryIPSchema(),
+						},
+						"allow_ip_spoofing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether source IP spoofing is allowed on this interface.",
+						},
+						"enable_infrastructure_nat": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If true, the VPC infrastructure performs any needed NAT operations. If false, the packet is passed unmodified to or from the network interface.",
+						},
+						"allowed_vlans": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Set:         schema.HashInt,
+							Description: "The VLAN IDs allowed for `vlan` interfaces using this PCI interface.",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The identifiers of the security groups of the network interface.",
+						},
+						"port_speed": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The network interface port speed in Mbps.",
+						},
+					},
+				},
+			},
+			isBareMetalServerNetworkInterfaces: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The secondary network interfaces of the bare metal server, managed with ibm_is_bare_metal_server_network_interface.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this network interface.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this network interface.",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the subnet of the network interface.",
+						},
+						"primary_ip": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The primary IP address of the network interface.",
+							Elem:        bareMetalServerPrimaryIPSchema(),
+						},
+					},
+				},
+			},
+			isBareMetalServerTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "tag")},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags",
+			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
+			isBareMetalServerAccessTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "accesstag")},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags",
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total bandwidth (in megabits per second) shared across the network interfaces of the bare metal server.",
+			},
+			"boot_target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the disk the bare metal server boots from.",
+			},
+			"cpu": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bare metal server CPU configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CPU architecture.",
+						},
+						"core_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of cores.",
+						},
+						"socket_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of CPU sockets.",
+						},
+						"threads_per_core": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of hardware threads per core.",
+						},
+					},
+				},
+			},
+			"disks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The disks of the bare metal server, renamed with ibm_is_bare_metal_server_disk.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this bare metal server disk.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this bare metal server disk.",
+						},
+						"interface_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The disk interface used for attaching the disk.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this disk.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the disk in GB (gigabytes).",
+						},
+					},
+				},
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory, truncated to whole gibibytes.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the bare metal server was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this bare metal server.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this bare metal server.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the bare metal server.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			isBareMetalServerStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bare metal server.",
+			},
+			isBareMetalServerStatusReasons: bareMetalServerStatusReasonsSchema(),
+		},
+	}
+}
+
+// bareMetalServerPrimaryIPSchema returns the schema of the primary ip of a bare metal server
+// network interface, whose address can be chosen on create
+func bareMetalServerPrimaryIPSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The IP address. If unspecified, an available address on the subnet is selected.",
+			},
+			"reserved_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this reserved IP.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this reserved IP.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user-defined or system-provided name for this reserved IP.",
+			},
+		},
+	}
+}
+
+// bareMetalServerStatusReasonsSchema returns the schema of the reasons of the status of a bare
+// metal server
+func bareMetalServerStatusReasonsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The reasons for the current status (if any).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "A snake case string succinctly identifying the status reason",
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "An explanation of the status reason",
+				},
+				"more_info": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Link to documentation about this status reason",
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBareMetalServerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "interface_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "pci, hipersocket",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "trusted_platform_module_mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, tpm_2",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "accesstag",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([ ]*[A-Za-z0-9:_.-]+[ ]*)+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsBareMetalServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createBareMetalServerOptions := &vpcv1.CreateBareMetalServerOptions{}
+
+	image := d.Get(isBareMetalServerImage).(string)
+	initialization := &vpcv1.BareMetalServerInitializationPrototype{
+		Image: &vpcv1.ImageIdentity{
+			ID: &image,
+		},
+	}
+	keys := flex.ExpandStringList(d.Get(isBareMetalServerKeys).(*schema.Set).List())
+	initialization.Keys = make([]vpcv1.KeyIdentityIntf, 0, len(keys))
+	for i := range keys {
+		initialization.Keys = append(initialization.Keys, &vpcv1.KeyIdentity{
+			ID: &keys[i],
+		})
+	}
+	if userData, ok := d.GetOk(isBareMetalServerUserData); ok {
+		userDataStr := userData.(string)
+		initialization.UserData = &userDataStr
+	}
+	createBareMetalServerOptions.SetInitialization(initialization)
+
+	profile := d.Get(isBareMetalServerProfile).(string)
+	createBareMetalServerOptions.SetProfile(&vpcv1.BareMetalServerProfileIdentity{
+		Name: &profile,
+	})
+	zone := d.Get(isBareMetalServerZone).(string)
+	createBareMetalServerOptions.SetZone(&vpcv1.ZoneIdentity{
+		Name: &zone,
+	})
+
+	primaryNetworkInterface := d.Get(isBareMetalServerPrimaryNetworkInterface + ".0").(map[string]interface{})
+	subnet := primaryNetworkInterface["subnet"].(string)
+	primaryNetworkInterfacePrototype := &vpcv1.BareMetalServerPrimaryNetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnet,
+		},
+	}
+	if name := primaryNetworkInterface["name"].(string); name != "" {
+		primaryNetworkInterfacePrototype.Name = &name
+	}
+	if interfaceType := primaryNetworkInterface["interface_type"].(string); interfaceType != "" {
+		primaryNetworkInterfacePrototype.InterfaceType = &interfaceType
+	}
+	if address := bareMetalServerPrimaryIPAddress(primaryNetworkInterface["primary_ip"]); address != "" {
+		primaryNetworkInterfacePrototype.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+			Address: &address,
+		}
+	}
+	allowIPSpoofing := primaryNetworkInterface["allow_ip_spoofing"].(bool)
+	primaryNetworkInterfacePrototype.AllowIPSpoofing = &allowIPSpoofing
+	enableInfrastructureNat := primaryNetworkInterface["enable_infrastructure_nat"].(bool)
+	primaryNetworkInterfacePrototype.EnableInfrastructureNat = &enableInfrastructureNat
+	if allowedVlans := primaryNetworkInterface["allowed_vlans"].(*schema.Set); allowedVlans.Len() > 0 {
+		primaryNetworkInterfacePrototype.AllowedVlans = bareMetalServerExpandVlans(allowedVlans)
+	}
+	if securityGroups := primaryNetworkInterface["security_groups"].(*schema.Set); securityGroups.Len() > 0 {
+		primaryNetworkInterfacePrototype.SecurityGroups = bareMetalServerExpandSecurityGroups(securityGroups)
+	}
+	createBareMetalServerOptions.SetPrimaryNetworkInterface(primaryNetworkInterfacePrototype)
+
+	if name, ok := d.GetOk(isBareMetalServerName); ok {
+		createBareMetalServerOptions.SetName(name.(string))
+	}
+	if vpc, ok := d.GetOk(isBareMetalServerVPC); ok {
+		vpcID := vpc.(string)
+		createBareMetalServerOptions.SetVPC(&vpcv1.VPCIdentity{
+			ID: &vpcID,
+		})
+	}
+	if resourceGroup, ok := d.GetOk(isBareMetalServerResourceGroup); ok {
+		resourceGroupID := resourceGroup.(string)
+		createBareMetalServerOptions.SetResourceGroup(&vpcv1.ResourceGroupIdentity{
+			ID: &resourceGroupID,
+		})
+	}
+	if enableSecureBoot, ok := d.GetOkExists(isBareMetalServerEnableSecureBoot); ok {
+		createBareMetalServerOptions.SetEnableSecureBoot(enableSecureBoot.(bool))
+	}
+	if mode, ok := d.GetOk(isBareMetalServerTrustedPlatformModule + ".0.mode"); ok {
+		modeStr := mode.(string)
+		createBareMetalServerOptions.SetTrustedPlatformModule(&vpcv1.BareMetalServerTrustedPlatformModulePrototype{
+			Mode: &modeStr,
+		})
+	}
+
+	bareMetalServer, response, err := vpcClient.CreateBareMetalServerWithContext(context, createBareMetalServerOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBareMetalServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating bare metal server: %s\n%s", err, response))
+	}
+
+	d.SetId(*bareMetalServer.ID)
+
+	_, err = isWaitForBareMetalServerAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || len(flex.DefaultTags(meta)) > 0 {
+		oldList, newList := d.GetChange(isBareMetalServerTags)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *bareMetalServer.CRN, "", isUserTagType)
+		if err != nil {
+			log.Printf(
+				"Error creating bare metal server (%s) tags: %s", d.Id(), err)
+		}
+	}
+	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
+		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *bareMetalServer.CRN, "", isAccessTagType)
+		if err != nil {
+			log.Printf(
+				"Error creating bare metal server (%s) access tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMIsBareMetalServerRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{}
+	getBareMetalServerOptions.SetID(d.Id())
+
+	bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(context, getBareMetalServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting bare metal server (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	if err = d.Set(isBareMetalServerName, bareMetalServer.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set(isBareMetalServerProfile, *bareMetalServer.Profile.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profile: %s", err))
+	}
+	if err = d.Set(isBareMetalServerZone, *bareMetalServer.Zone.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting zone: %s", err))
+	}
+	if err = d.Set(isBareMetalServerVPC, *bareMetalServer.VPC.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc: %s", err))
+	}
+	if bareMetalServer.ResourceGroup != nil {
+		if err = d.Set(isBareMetalServerResourceGroup, *bareMetalServer.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+		}
+	}
+	if err = d.Set(isBareMetalServerEnableSecureBoot, bareMetalServer.EnableSecureBoot); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_secure_boot: %s", err))
+	}
+	if bareMetalServer.TrustedPlatformModule != nil {
+		trustedPlatformModule := map[string]interface{}{
+			"enabled":         bareMetalServer.TrustedPlatformModule.Enabled,
+			"mode":            bareMetalServer.TrustedPlatformModule.Mode,
+			"supported_modes": bareMetalServer.TrustedPlatformModule.SupportedModes,
+		}
+		if err = d.Set(isBareMetalServerTrustedPlatformModule, []map[string]interface{}{trustedPlatformModule}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting trusted_platform_module: %s", err))
+		}
+	}
+
+	initialization, response, err := vpcClient.GetBareMetalServerInitializationWithContext(context, &vpcv1.GetBareMetalServerInitializationOptions{
+		ID: bareMetalServer.ID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the initialization of bare metal server (%s): %s\n%s", d.Id(), err, response))
+	}
+	if initialization.Image != nil {
+		if err = d.Set(isBareMetalServerImage, *initialization.Image.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting image: %s", err))
+		}
+	}
+	keys := make([]string, 0, len(initialization.Keys))
+	for _, key := range initialization.Keys {
+		keys = append(keys, *key.ID)
+	}
+	if err = d.Set(isBareMetalServerKeys, keys); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting keys: %s", err))
+	}
+
+	if bareMetalServer.PrimaryNetworkInterface != nil {
+		getNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID: bareMetalServer.ID,
+			ID:                bareMetalServer.PrimaryNetworkInterface.ID,
+		}
+		nicIntf, response, err := vpcClient.GetBareMetalServerNetworkInterfaceWithContext(context, getNetworkInterfaceOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting the primary network interface of bare metal server (%s): %s\n%s", d.Id(), err, response))
+		}
+		nic, err := bareMetalServerNetworkInterface(nicIntf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		primaryNetworkInterface := map[string]interface{}{
+			"id":                        nic.ID,
+			"name":                      nic.Name,
+			"subnet":                    *nic.Subnet.ID,
+			"interface_type":            nic.InterfaceType,
+			"primary_ip":                bareMetalServerFlattenPrimaryIP(nic.PrimaryIP),
+			"allow_ip_spoofing":         nic.AllowIPSpoofing,
+			"enable_infrastructure_nat": nic.EnableInfrastructureNat,
+			"allowed_vlans":             bareMetalServerFlattenVlans(nic.AllowedVlans),
+			"security_groups":           bareMetalServerFlattenSecurityGroups(nic.SecurityGroups),
+			"port_speed":                flex.IntValue(nic.PortSpeed),
+		}
+		if err = d.Set(isBareMetalServerPrimaryNetworkInterface, []map[string]interface{}{primaryNetworkInterface}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_network_interface: %s", err))
+		}
+	}
+
+	networkInterfaces := []map[string]interface{}{}
+	for _, nic := range bareMetalServer.NetworkInterfaces {
+		if bareMetalServer.PrimaryNetworkInterface != nil && *nic.ID == *bareMetalServer.PrimaryNetworkInterface.ID {
+			continue
+		}
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"id":         *nic.ID,
+			"name":       nic.Name,
+			"subnet":     *nic.Subnet.ID,
+			"primary_ip": bareMetalServerFlattenPrimaryIP(nic.PrimaryIP),
+		})
+	}
+	if err = d.Set(isBareMetalServerNetworkInterfaces, networkInterfaces); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting network_interfaces: %s", err))
+	}
+
+	if err = d.Set("bandwidth", flex.IntValue(bareMetalServer.Bandwidth)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting bandwidth: %s", err))
+	}
+	if bootTarget, ok := bareMetalServer.BootTarget.(*vpcv1.BareMetalServerBootTarget); ok && bootTarget.ID != nil {
+		if err = d.Set("boot_target", *bootTarget.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting boot_target: %s", err))
+		}
+	}
+	if bareMetalServer.Cpu != nil {
+		if err = d.Set("cpu", []map[string]interface{}{resourceIBMIsBareMetalServerCPUToMap(*bareMetalServer.Cpu)}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting cpu: %s", err))
+		}
+	}
+	disks := []map[string]interface{}{}
+	for _, disk := range bareMetalServer.Disks {
+		disks = append(disks, resourceIBMIsBareMetalServerDiskToMap(disk))
+	}
+	if err = d.Set("disks", disks); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting disks: %s", err))
+	}
+	if err = d.Set("memory", flex.IntValue(bareMetalServer.Memory)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting memory: %s", err))
+	}
+	if err = d.Set("created_at", bareMetalServer.CreatedAt.String()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", bareMetalServer.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("href", bareMetalServer.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", bareMetalServer.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", bareMetalServer.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if err = d.Set(isBareMetalServerStatus, bareMetalServer.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+	if err = d.Set(isBareMetalServerStatusReasons, bareMetalServerFlattenStatusReasons(bareMetalServer.StatusReasons)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status_reasons: %s", err))
+	}
+
+	tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *bareMetalServer.CRN, "", isUserTagType)
+	if err != nil {
+		log.Printf(
+			"Error getting bare metal server (%s) tags: %s", d.Id(), err)
+	}
+	accesstags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *bareMetalServer.CRN, "", isAccessTagType)
+	if err != nil {
+		log.Printf(
+			"Error getting bare metal server (%s) access tags: %s", d.Id(), err)
+	}
+	d.Set(isBareMetalServerTags, tags)
+	d.Set(flex.TagsAll, tags)
+	d.Set(isBareMetalServerAccessTags, accesstags)
+
+	return nil
+}
+
+func resourceIBMIsBareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	bareMetalServerPatch := map[string]interface{}{}
+	if d.HasChange(isBareMetalServerName) {
+		bareMetalServerPatch["name"] = d.Get(isBareMetalServerName).(string)
+	}
+	if len(bareMetalServerPatch) > 0 {
+		updateBareMetalServerOptions := &vpcv1.UpdateBareMetalServerOptions{
+			ID:                   &id,
+			BareMetalServerPatch: bareMetalServerPatch,
+		}
+		_, response, err := vpcClient.UpdateBareMetalServerWithContext(context, updateBareMetalServerOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBareMetalServerWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s): %s\n%s", id, err, response))
+		}
+	}
+
+	// the secure boot and the trusted platform module can only be changed while the server is stopped
+	bootPatch := map[string]interface{}{}
+	if d.HasChange(isBareMetalServerEnableSecureBoot) {
+		bootPatch["enable_secure_boot"] = d.Get(isBareMetalServerEnableSecureBoot).(bool)
+	}
+	if d.HasChange(isBareMetalServerTrustedPlatformModule + ".0.mode") {
+		if mode, ok := d.GetOk(isBareMetalServerTrustedPlatformModule + ".0.mode"); ok {
+			bootPatch["trusted_platform_module"] = map[string]interface{}{
+				"mode": mode.(string),
+			}
+		}
+	}
+	if len(bootPatch) > 0 {
+		running := d.Get(isBareMetalServerStatus).(string) == isBareMetalServerStatusRunning
+		if running {
+			if err = bareMetalServerStop(context, vpcClient, d, id, vpcv1.StopBareMetalServerOptionsTypeSoftConst); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		updateBareMetalServerOptions := &vpcv1.UpdateBareMetalServerOptions{
+			ID:                   &id,
+			BareMetalServerPatch: bootPatch,
+		}
+		_, response, err := vpcClient.UpdateBareMetalServerWithContext(context, updateBareMetalServerOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBareMetalServerWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s): %s\n%s", id, err, response))
+		}
+		if running {
+			response, err = vpcClient.StartBareMetalServerWithContext(context, &vpcv1.StartBareMetalServerOptions{
+				ID: &id,
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s): %s\n%s", id, err, response))
+			}
+			_, err = isWaitForBareMetalServerActionStart(vpcClient, d.Timeout(schema.TimeoutUpdate), id, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	nicID := d.Get(isBareMetalServerPrimaryNetworkInterface + ".0.id").(string)
+	nicPatch := map[string]interface{}{}
+	for _, key := range []string{"name", "allow_ip_spoofing", "enable_infrastructure_nat"} {
+		if d.HasChange(isBareMetalServerPrimaryNetworkInterface + ".0." + key) {
+			nicPatch[key] = d.Get(isBareMetalServerPrimaryNetworkInterface + ".0." + key)
+		}
+	}
+	if d.HasChange(isBareMetalServerPrimaryNetworkInterface + ".0.allowed_vlans") {
+		nicPatch["allowed_vlans"] = bareMetalServerExpandVlans(d.Get(isBareMetalServerPrimaryNetworkInterface + ".0.allowed_vlans").(*schema.Set))
+	}
+	if len(nicPatch) > 0 {
+		updateNetworkInterfaceOptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID:                    &id,
+			ID:                                   &nicID,
+			BareMetalServerNetworkInterfacePatch: nicPatch,
+		}
+		_, response, err := vpcClient.UpdateBareMetalServerNetworkInterfaceWithContext(context, updateNetworkInterfaceOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the primary network interface of bare metal server (%s): %s\n%s", id, err, response))
+		}
+	}
+	if d.HasChange(isBareMetalServerPrimaryNetworkInterface + ".0.security_groups") {
+		oldSecurityGroups, newSecurityGroups := d.GetChange(isBareMetalServerPrimaryNetworkInterface + ".0.security_groups")
+		err = updateSecurityGroupTargetBindings(context, vpcClient, nicID, oldSecurityGroups.(*schema.Set), newSecurityGroups.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(isBareMetalServerTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := d.GetChange(isBareMetalServerTags)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
+		if err != nil {
+			log.Printf(
+				"Error on update of resource bare metal server (%s) tags: %s", id, err)
+		}
+	}
+	if d.HasChange(isBareMetalServerAccessTags) {
+		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
+		if err != nil {
+			log.Printf(
+				"Error on update of resource bare metal server (%s) access tags: %s", id, err)
+		}
+	}
+
+	return resourceIBMIsBareMetalServerRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+		ID: &id,
+	}
+	bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(context, getBareMetalServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting bare metal server (%s): %s\n%s", id, err, response))
+	}
+	// a bare metal server can only be deleted once it is stopped
+	if *bareMetalServer.Status != isBareMetalServerStatusStopped && *bareMetalServer.Status != isBareMetalServerStatusFailed {
+		if err = bareMetalServerStop(context, vpcClient, d, id, vpcv1.StopBareMetalServerOptionsTypeHardConst); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	deleteBareMetalServerOptions := &vpcv1.DeleteBareMetalServerOptions{
+		ID: &id,
+	}
+	response, err = vpcClient.DeleteBareMetalServerWithContext(context, deleteBareMetalServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBareMetalServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting bare metal server (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForBareMetalServerDeleted(vpcClient, id, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// bareMetalServerStop stops the bare metal server with the stop type, hard or soft, and waits for
+// it to be stopped
+func bareMetalServerStop(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id, stopType string) error {
+	stopBareMetalServerOptions := &vpcv1.StopBareMetalServerOptions{
+		ID:   &id,
+		Type: &stopType,
+	}
+	response, err := vpcClient.StopBareMetalServerWithContext(context, stopBareMetalServerOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error stopping bare metal server (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForBareMetalServerActionStop(vpcClient, d.Timeout(schema.TimeoutUpdate), id, d)
+	return err
+}
+
+func isWaitForBareMetalServerAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be running.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting},
+		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(vpcClient, id, d, isBareMetalServerStatusRunning),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForBareMetalServerActionStop(vpcClient *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be stopped.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerStatusStopping, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting},
+		Target:     []string{isBareMetalServerStatusStopped, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(vpcClient, id, d, isBareMetalServerStatusStopped),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForBareMetalServerActionStart(vpcClient *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be running.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusStopped, isBareMetalServerStatusPending, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting, isBareMetalServerStatusStopping},
+		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(vpcClient, id, d, isBareMetalServerStatusRunning),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+// isBareMetalServerRefreshFunc refreshes the status of the bare metal server, the failed status
+// fails the wait for the target status
+func isBareMetalServerRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+			ID: &id,
+		}
+		bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(flex.OperationContext(d), getBareMetalServerOptions)
+		if bareMetalServer == nil || err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting bare metal server : %s\n%s", err, response)
+		}
+		d.Set(isBareMetalServerStatus, *bareMetalServer.Status)
+		d.Set(isBareMetalServerStatusReasons, bareMetalServerFlattenStatusReasons(bareMetalServer.StatusReasons))
+
+		if *bareMetalServer.Status == isBareMetalServerStatusFailed {
+			return bareMetalServer, *bareMetalServer.Status, fmt.Errorf("[ERROR] The bare metal server %s failed while waiting for the status %s: %v", id, target, bareMetalServerFlattenStatusReasons(bareMetalServer.StatusReasons))
+		}
+		return bareMetalServer, *bareMetalServer.Status, nil
+	}
+}
+
+func isWaitForBareMetalServerDeleted(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerStatusDeleting, isBareMetalServerStatusStopped},
+		Target:  []string{isBareMetalServerDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+				ID: &id,
+			}
+			bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(flex.OperationContext(d), getBareMetalServerOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return bareMetalServer, isBareMetalServerDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting bare metal server: %s\n%s", err, response)
+			}
+			if *bareMetalServer.Status == isBareMetalServerStatusFailed {
+				return bareMetalServer, *bareMetalServer.Status, fmt.Errorf("[ERROR] The bare metal server %s failed to delete: %v", id, err)
+			}
+			return bareMetalServer, isBareMetalServerStatusDeleting, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+// bareMetalServerNetworkInterface returns the network interface of any interface type as the
+// generic network interface, whose fields are the union of the fields of the interface types
+func bareMetalServerNetworkInterface(nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) (*vpcv1.BareMetalServerNetworkInterface, error) {
+	if nic, ok := nicIntf.(*vpcv1.BareMetalServerNetworkInterface); ok {
+		return nic, nil
+	}
+	b, err := json.Marshal(nicIntf)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the bare metal server network interface: %s", err)
+	}
+	nic := &vpcv1.BareMetalServerNetworkInterface{}
+	if err = json.Unmarshal(b, nic); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the bare metal server network interface: %s", err)
+	}
+	return nic, nil
+}
+
+// updateSecurityGroupTargetBindings binds the target, such as a network interface, to the
+// security groups added to the set and unbinds it from the removed ones
+func updateSecurityGroupTargetBindings(context context.Context, vpcClient *vpcv1.VpcV1, targetID string, oldSecurityGroups, newSecurityGroups *schema.Set) error {
+	for _, sg := range flex.ExpandStringList(newSecurityGroups.Difference(oldSecurityGroups).List()) {
+		sgID := sg
+		createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{
+			SecurityGroupID: &sgID,
+			ID:              &targetID,
+		}
+		_, response, err := vpcClient.CreateSecurityGroupTargetBindingWithContext(context, createSecurityGroupTargetBindingOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding security group %q to target %s: %s\n%s", sgID, targetID, err, response)
+		}
+	}
+	for _, sg := range flex.ExpandStringList(oldSecurityGroups.Difference(newSecurityGroups).List()) {
+		sgID := sg
+		deleteSecurityGroupTargetBindingOptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
+			SecurityGroupID: &sgID,
+			ID:              &targetID,
+		}
+		response, err := vpcClient.DeleteSecurityGroupTargetBindingWithContext(context, deleteSecurityGroupTargetBindingOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error removing security group %q from target %s: %s\n%s", sgID, targetID, err, response)
+		}
+	}
+	return nil
+}
+
+// bareMetalServerPrimaryIPAddress returns the address of the primary_ip block, or "" when the
+// address is selected by the subnet
+func bareMetalServerPrimaryIPAddress(primaryIP interface{}) string {
+	primaryIPs, _ := primaryIP.([]interface{})
+	if len(primaryIPs) == 0 || primaryIPs[0] == nil {
+		return ""
+	}
+	address, _ := primaryIPs[0].(map[string]interface{})["address"].(string)
+	return address
+}
+
+func bareMetalServerFlattenPrimaryIP(primaryIP *vpcv1.ReservedIPReference) []map[string]interface{} {
+	if primaryIP == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"address":     primaryIP.Address,
+		"reserved_ip": primaryIP.ID,
+		"href":        primaryIP.Href,
+		"name":        primaryIP.Name,
+	}}
+}
+
+func bareMetalServerExpandVlans(vlans *schema.Set) []int64 {
+	allowedVlans := make([]int64, 0, vlans.Len())
+	for _, vlan := range vlans.List() {
+		allowedVlans = append(allowedVlans, int64(vlan.(int)))
+	}
+	return allowedVlans
+}
+
+func bareMetalServerFlattenVlans(vlans []int64) *schema.Set {
+	allowedVlans := make([]interface{}, 0, len(vlans))
+	for _, vlan := range vlans {
+		allowedVlans = append(allowedVlans, int(vlan))
+	}
+	return schema.NewSet(schema.HashInt, allowedVlans)
+}
+
+func bareMetalServerExpandSecurityGroups(securityGroups *schema.Set) []vpcv1.SecurityGroupIdentityIntf {
+	sgs := flex.ExpandStringList(securityGroups.List())
+	securityGroupIdentities := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(sgs))
+	for i := range sgs {
+		securityGroupIdentities = append(securityGroupIdentities, &vpcv1.SecurityGroupIdentity{
+			ID: &sgs[i],
+		})
+	}
+	return securityGroupIdentities
+}
+
+func bareMetalServerFlattenSecurityGroups(securityGroups []vpcv1.SecurityGroupReference) *schema.Set {
+	sgs := make([]string, 0, len(securityGroups))
+	for _, sg := range securityGroups {
+		sgs = append(sgs, *sg.ID)
+	}
+	return flex.NewStringSet(schema.HashString, sgs)
+}
+
+func bareMetalServerFlattenStatusReasons(statusReasons []vpcv1.BareMetalServerStatusReason) []map[string]interface{} {
+	statusReasonsList := make([]map[string]interface{}, 0, len(statusReasons))
+	for _, sr := range statusReasons {
+		statusReasonsList = append(statusReasonsList, map[string]interface{}{
+			"code":      sr.Code,
+			"message":   sr.Message,
+			"more_info": sr.MoreInfo,
+		})
+	}
+	return statusReasonsList
+}
+
+func resourceIBMIsBareMetalServerCPUToMap(cpu vpcv1.BareMetalServerCpu) map[string]interface{} {
+	cpuMap := map[string]interface{}{}
+	cpuMap["architecture"] = cpu.Architecture
+	cpuMap["core_count"] = flex.IntValue(cpu.CoreCount)
+	cpuMap["socket_count"] = flex.IntValue(cpu.SocketCount)
+	cpuMap["threads_per_core"] = flex.IntValue(cpu.ThreadsPerCore)
+	return cpuMap
+}
+
+func resourceIBMIsBareMetalServerDiskToMap(disk vpcv1.BareMetalServerDisk) map[string]interface{} {
+	diskMap := map[string]interface{}{}
+	diskMap["id"] = disk.ID
+	diskMap["href"] = disk.Href
+	diskMap["interface_type"] = disk.InterfaceType
+	diskMap["name"] = disk.Name
+	diskMap["resource_type"] = disk.ResourceType
+	diskMap["size"] = flex.IntValue(disk.Size)
+	return diskMap
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerAction         = "action"
+	isBareMetalServerActionStopType = "stop_type"
+)
+
+func ResourceIBMIsBareMetalServerAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBareMetalServerActionCreate,
+		ReadContext:   resourceIBMIsBareMetalServerActionRead,
+		UpdateContext: resourceIBMIsBareMetalServerActionUpdate,
+		DeleteContext: resourceIBMIsBareMetalServerActionDelete,
+		Exists:        resourceIBMIsBareMetalServerActionExists,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			isBareMetalServerID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Bare metal server identifier",
+			},
+			isBareMetalServerAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_action", isBareMetalServerAction),
+				Description:  "This restart/start/stops a bare metal server.",
+			},
+			isBareMetalServerActionStopType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vpcv1.StopBareMetalServerOptionsTypeHardConst,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_action", isBareMetalServerActionStopType),
+				Description:  "The type of the stop action: `soft` signals running operating systems to quiesce and shutdown cleanly, `hard` immediately stops the server. Ignored for the start and restart actions.",
+			},
+			isBareMetalServerStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Bare metal server status",
+			},
+
+			isBareMetalServerStatusReasons: bareMetalServerStatusReasonsSchema(),
+		},
+	}
+}
+
+func ResourceIBMIsBareMetalServerActionValidator() *validate.ResourceValidator {
+
+	bareMetalServerActions := "start, restart, stop"
+	validateSchema := make([]validate.ValidateSchema, 1)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              bareMetalServerActions},
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerActionStopType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "hard, soft"})
+	ibmISBareMetalServerActionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server_action", Schema: validateSchema}
+	return &ibmISBareMetalServerActionResourceValidator
+}
+
+func resourceIBMIsBareMetalServerActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := d.Get(isBareMetalServerID).(string)
+	if err := resourceIBMIsBareMetalServerActionRun(context, d, meta, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	return resourceIBMIsBareMetalServerActionRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	options := &vpcv1.GetBareMetalServerOptions{
+		ID: &id,
+	}
+	bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting bare metal server (%s): %s\n%s", id, err, response))
+	}
+
+	d.Set(isBareMetalServerID, id)
+	d.Set(isBareMetalServerStatus, *bareMetalServer.Status)
+	d.Set(isBareMetalServerStatusReasons, bareMetalServerFlattenStatusReasons(bareMetalServer.StatusReasons))
+	return nil
+}
+
+func resourceIBMIsBareMetalServerActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isBareMetalServerAction) {
+		if err := resourceIBMIsBareMetalServerActionRun(context, d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMIsBareMetalServerActionRead(context, d, meta)
+}
+
+// resourceIBMIsBareMetalServerActionRun runs the action on the bare metal server, once it is in the
+// status the action applies to, and waits for its result
+func resourceIBMIsBareMetalServerActionRun(context context.Context, d *schema.ResourceData, meta interface{}, id string, timeout time.Duration) error {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	actiontype := d.Get(isBareMetalServerAction).(string)
+
+	getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+		ID: &id,
+	}
+	bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(context, getBareMetalServerOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting bare metal server (%s): %s\n%s", id, err, response)
+	}
+	if (actiontype == "stop" || actiontype == "restart") && *bareMetalServer.Status != isBareMetalServerStatusRunning {
+		d.Set(isBareMetalServerAction, nil)
+		return fmt.Errorf("[ERROR] Error with stop/restart action: Cannot invoke stop/restart action while bare metal server is not in running state")
+	} else if actiontype == "start" && *bareMetalServer.Status != isBareMetalServerStatusStopped {
+		d.Set(isBareMetalServerAction, nil)
+		return fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while bare metal server is not in stopped state")
+	}
+
+	switch actiontype {
+	case "start":
+		response, err = vpcClient.StartBareMetalServerWithContext(context, &vpcv1.StartBareMetalServerOptions{
+			ID: &id,
+		})
+	case "restart":
+		response, err = vpcClient.RestartBareMetalServerWithContext(context, &vpcv1.RestartBareMetalServerOptions{
+			ID: &id,
+		})
+	case "stop":
+		stopType := d.Get(isBareMetalServerActionStopType).(string)
+		response, err = vpcClient.StopBareMetalServerWithContext(context, &vpcv1.StopBareMetalServerOptions{
+			ID:   &id,
+			Type: &stopType,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error running the %s action on bare metal server (%s): %s\n%s", actiontype, id, err, response)
+	}
+
+	if actiontype == "stop" {
+		_, err = isWaitForBareMetalServerActionStop(vpcClient, timeout, id, d)
+	} else {
+		_, err = isWaitForBareMetalServerActionStart(vpcClient, timeout, id, d)
+	}
+	return err
+}
+
+func resourceIBMIsBareMetalServerActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMIsBareMetalServerActionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return false, err
+	}
+	id := d.Id()
+	getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+		ID: &id,
+	}
+	_, response, err := vpcClient.GetBareMetalServerWithContext(flex.OperationContext(d), getBareMetalServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error getting bare metal server : %s\n%s", err, response)
+	}
+	return true, err
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerAction_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-server-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-sshname-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(testAccBareMetalServerPublicKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerActionConfig(vpcname, subnetname, sshname, publicKey, name, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_action.testacc_bms_action", "action", "stop"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_action.testacc_bms_action", "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerActionConfig(vpcname, subnetname, sshname, publicKey, name, "start"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_action.testacc_bms_action", "action", "start"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_action.testacc_bms_action", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerActionConfig(vpcname, subnetname, sshname, publicKey, name, action string) string {
+	return testAccCheckIBMISBareMetalServerConfigBase(vpcname, subnetname, sshname, publicKey, name) + fmt.Sprintf(`
+
+	resource "ibm_is_bare_metal_server_action" "testacc_bms_action" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		action            = "%s"
+	}`, action)
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerDisk = "disk"
+)
+
+func ResourceIBMIsBareMetalServerDisk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBareMetalServerDiskCreate,
+		ReadContext:   resourceIBMIsBareMetalServerDiskRead,
+		UpdateContext: resourceIBMIsBareMetalServerDiskUpdate,
+		DeleteContext: resourceIBMIsBareMetalServerDiskDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the bare metal server of the disk.",
+			},
+			isBareMetalServerDisk: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the disk.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_disk", "name"),
+				Description:  "The user-defined name for this disk.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this bare metal server disk.",
+			},
+			"interface_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk interface used for attaching the disk.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the disk in GB (gigabytes).",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBareMetalServerDiskValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server_disk", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsBareMetalServerDiskCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bareMetalServerID := d.Get(isBareMetalServerID).(string)
+	diskID := d.Get(isBareMetalServerDisk).(string)
+	d.SetId(fmt.Sprintf("%s/%s", bareMetalServerID, diskID))
+
+	if err := resourceIBMIsBareMetalServerDiskUpdateName(context, d, meta); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	return resourceIBMIsBareMetalServerDiskRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerDiskRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getBareMetalServerDiskOptions := &vpcv1.GetBareMetalServerDiskOptions{
+		BareMetalServerID: &parts[0],
+		ID:                &parts[1],
+	}
+	disk, response, err := vpcClient.GetBareMetalServerDiskWithContext(context, getBareMetalServerDiskOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServerDiskWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting disk (%s) of bare metal server: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set(isBareMetalServerID, parts[0])
+	d.Set(isBareMetalServerDisk, parts[1])
+	if err = d.Set("name", disk.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("href", disk.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("interface_type", disk.InterfaceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting interface_type: %s", err))
+	}
+	if err = d.Set("resource_type", disk.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if err = d.Set("size", flex.IntValue(disk.Size)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting size: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsBareMetalServerDiskUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") {
+		if err := resourceIBMIsBareMetalServerDiskUpdateName(context, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMIsBareMetalServerDiskRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerDiskUpdateName(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	diskPatchModel := &vpcv1.BareMetalServerDiskPatch{
+		Name: &name,
+	}
+	diskPatch, err := diskPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerDiskPatch: %s", err)
+	}
+	updateBareMetalServerDiskOptions := &vpcv1.UpdateBareMetalServerDiskOptions{
+		BareMetalServerID:        &parts[0],
+		ID:                       &parts[1],
+		BareMetalServerDiskPatch: diskPatch,
+	}
+	_, response, err := vpcClient.UpdateBareMetalServerDiskWithContext(context, updateBareMetalServerDiskOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateBareMetalServerDiskWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error updating disk (%s) of bare metal server: %s\n%s", d.Id(), err, response)
+	}
+	return nil
+}
+
+// resourceIBMIsBareMetalServerDiskDelete only removes the disk from the state, the disks are
+// deleted with the bare metal server
+func resourceIBMIsBareMetalServerDiskDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
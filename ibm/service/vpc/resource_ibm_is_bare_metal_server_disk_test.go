@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerDisk_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-server-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-sshname-%d", acctest.RandIntRange(10, 100))
+	diskname := fmt.Sprintf("tf-disk-%d", acctest.RandIntRange(10, 100))
+	disknameupdate := fmt.Sprintf("tf-disk-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(testAccBareMetalServerPublicKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerDiskConfig(vpcname, subnetname, sshname, publicKey, name, diskname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "name", diskname),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "size"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "interface_type"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerDiskConfig(vpcname, subnetname, sshname, publicKey, name, disknameupdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "name", disknameupdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerDiskConfig(vpcname, subnetname, sshname, publicKey, name, diskname string) string {
+	return testAccCheckIBMISBareMetalServerConfigBase(vpcname, subnetname, sshname, publicKey, name) + fmt.Sprintf(`
+
+	resource "ibm_is_bare_metal_server_disk" "testacc_bms_disk" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		disk              = ibm_is_bare_metal_server.testacc_bms.disks.0.id
+		name              = "%s"
+	}`, diskname)
+}
@@ -0,0 +1,566 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerID                  = "bare_metal_server"
+	isBareMetalServerNicInterfaceType    = "interface_type"
+	isBareMetalServerNicVlan             = "vlan"
+	isBareMetalServerNicAllowedVlans     = "allowed_vlans"
+	isBareMetalServerNicAllowToFloat     = "allow_interface_to_float"
+	isBareMetalServerNicSecurityGroups   = "security_groups"
+	isBareMetalServerNicStatusPending    = "pending"
+	isBareMetalServerNicStatusAvailable  = "available"
+	isBareMetalServerNicStatusDeleting   = "deleting"
+	isBareMetalServerNicStatusFailed     = "failed"
+	isBareMetalServerNicDeleted          = "deleted"
+	isBareMetalServerNicInterfaceTypePci = "pci"
+)
+
+func ResourceIBMIsBareMetalServerNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBareMetalServerNetworkInterfaceCreate,
+		ReadContext:   resourceIBMIsBareMetalServerNetworkInterfaceRead,
+		UpdateContext: resourceIBMIsBareMetalServerNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMIsBareMetalServerNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMIsBareMetalServerNetworkInterfaceValidate(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the bare metal server of the network interface.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the subnet of the network interface.",
+			},
+			isBareMetalServerNicInterfaceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_network_interface", isBareMetalServerNicInterfaceType),
+				Description:  "The network interface type: `pci` for a physical PCI device, which can only be added or removed while the bare metal server is stopped, or `vlan` for a virtual device on a PCI interface which allows the VLAN.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_network_interface", "name"),
+				Description:  "The user-defined name for this network interface.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The primary IP address to bind to the network interface.",
+				Elem:        bareMetalServerPrimaryIPSchema(),
+			},
+			"allow_ip_spoofing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether source IP spoofing is allowed on this interface.",
+			},
+			"enable_infrastructure_nat": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If true, the VPC infrastructure performs any needed NAT operations. If false, the packet is passed unmodified to or from the network interface.",
+			},
+			isBareMetalServerNicAllowedVlans: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The VLAN IDs allowed for `vlan` interfaces using this PCI interface. Only for the `pci` interfaces.",
+			},
+			isBareMetalServerNicVlan: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_network_interface", isBareMetalServerNicVlan),
+				Description:  "The VLAN ID of the packets of the interface. Required for the `vlan` interfaces.",
+			},
+			isBareMetalServerNicAllowToFloat: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Indicates whether the `vlan` interface can float to any other server within the same resource group, to follow its IP address.",
+			},
+			isBareMetalServerNicSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The identifiers of the security groups of the network interface.",
+			},
+			"network_interface": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this network interface.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this network interface.",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the interface.",
+			},
+			"port_speed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The network interface port speed in Mbps.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network interface.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this bare metal server network interface, primary or secondary.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBareMetalServerNetworkInterfaceValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerNicInterfaceType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "pci, vlan",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 isBareMetalServerNicVlan,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "4094",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server_network_interface", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMIsBareMetalServerNetworkInterfaceValidate checks the arguments which only apply to
+// one of the interface types
+func resourceIBMIsBareMetalServerNetworkInterfaceValidate(diff *schema.ResourceDiff) error {
+	isVlan := validate.Equals(isBareMetalServerNicInterfaceType, vpcv1.BareMetalServerNetworkInterfaceInterfaceTypeVlanConst)
+	isNotVlan := validate.NotEquals(isBareMetalServerNicInterfaceType, vpcv1.BareMetalServerNetworkInterfaceInterfaceTypeVlanConst)
+	isNotPci := validate.NotEquals(isBareMetalServerNicInterfaceType, isBareMetalServerNicInterfaceTypePci)
+	return validate.CheckRules(diff,
+		validate.RequiredWith(isBareMetalServerNicVlan, isVlan),
+		validate.ConflictsWhen(isBareMetalServerNicVlan, validate.And(isNotVlan, validate.Changed(isBareMetalServerNicVlan))),
+		validate.ConflictsWhen(isBareMetalServerNicAllowToFloat, validate.And(isNotVlan, validate.Changed(isBareMetalServerNicAllowToFloat))),
+		validate.ConflictsWhen(isBareMetalServerNicAllowedVlans, validate.And(isNotPci, validate.Changed(isBareMetalServerNicAllowedVlans))),
+	)
+}
+
+func resourceIBMIsBareMetalServerNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bareMetalServerID := d.Get(isBareMetalServerID).(string)
+	interfaceType := d.Get(isBareMetalServerNicInterfaceType).(string)
+	subnet := d.Get("subnet").(string)
+
+	prototype := &vpcv1.BareMetalServerNetworkInterfacePrototype{
+		InterfaceType: &interfaceType,
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnet,
+		},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if address := bareMetalServerPrimaryIPAddress(d.Get("primary_ip")); address != "" {
+		prototype.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+			Address: &address,
+		}
+	}
+	allowIPSpoofing := d.Get("allow_ip_spoofing").(bool)
+	prototype.AllowIPSpoofing = &allowIPSpoofing
+	enableInfrastructureNat := d.Get("enable_infrastructure_nat").(bool)
+	prototype.EnableInfrastructureNat = &enableInfrastructureNat
+	if allowedVlans := d.Get(isBareMetalServerNicAllowedVlans).(*schema.Set); allowedVlans.Len() > 0 {
+		prototype.AllowedVlans = bareMetalServerExpandVlans(allowedVlans)
+	}
+	if vlan, ok := d.GetOk(isBareMetalServerNicVlan); ok {
+		vlanID := int64(vlan.(int))
+		prototype.Vlan = &vlanID
+	}
+	if allowToFloat, ok := d.GetOk(isBareMetalServerNicAllowToFloat); ok {
+		allowToFloatBool := allowToFloat.(bool)
+		prototype.AllowInterfaceToFloat = &allowToFloatBool
+	}
+	if securityGroups := d.Get(isBareMetalServerNicSecurityGroups).(*schema.Set); securityGroups.Len() > 0 {
+		prototype.SecurityGroups = bareMetalServerExpandSecurityGroups(securityGroups)
+	}
+
+	isBareMetalServerNicKey := "bare_metal_server_network_interface_key_" + bareMetalServerID
+	unlock, err := conns.ResourceLocks.Lock(context, isBareMetalServerNicKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	err = bareMetalServerWhileStopped(context, vpcClient, d, bareMetalServerID, interfaceType, func() error {
+		createNetworkInterfaceOptions := &vpcv1.CreateBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID:                        &bareMetalServerID,
+			BareMetalServerNetworkInterfacePrototype: prototype,
+		}
+		nicIntf, response, err := vpcClient.CreateBareMetalServerNetworkInterfaceWithContext(context, createNetworkInterfaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateBareMetalServerNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error creating network interface of bare metal server (%s): %s\n%s", bareMetalServerID, err, response)
+		}
+		nic, err := bareMetalServerNetworkInterface(nicIntf)
+		if err != nil {
+			return err
+		}
+		d.SetId(fmt.Sprintf("%s/%s", bareMetalServerID, *nic.ID))
+
+		_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(vpcClient, bareMetalServerID, *nic.ID, d.Timeout(schema.TimeoutCreate), d)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsBareMetalServerNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+		BareMetalServerID: &parts[0],
+		ID:                &parts[1],
+	}
+	nicIntf, response, err := vpcClient.GetBareMetalServerNetworkInterfaceWithContext(context, getNetworkInterfaceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServerNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interface (%s) of bare metal server: %s\n%s", d.Id(), err, response))
+	}
+	nic, err := bareMetalServerNetworkInterface(nicIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(isBareMetalServerID, parts[0])
+	d.Set("network_interface", nic.ID)
+	if err = d.Set("subnet", *nic.Subnet.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnet: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNicInterfaceType, nic.InterfaceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting interface_type: %s", err))
+	}
+	if err = d.Set("name", nic.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("primary_ip", bareMetalServerFlattenPrimaryIP(nic.PrimaryIP)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ip: %s", err))
+	}
+	if err = d.Set("allow_ip_spoofing", nic.AllowIPSpoofing); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allow_ip_spoofing: %s", err))
+	}
+	if err = d.Set("enable_infrastructure_nat", nic.EnableInfrastructureNat); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_infrastructure_nat: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNicAllowedVlans, bareMetalServerFlattenVlans(nic.AllowedVlans)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allowed_vlans: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNicVlan, flex.IntValue(nic.Vlan)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vlan: %s", err))
+	}
+	if nic.AllowInterfaceToFloat != nil {
+		if err = d.Set(isBareMetalServerNicAllowToFloat, *nic.AllowInterfaceToFloat); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting allow_interface_to_float: %s", err))
+		}
+	}
+	if err = d.Set(isBareMetalServerNicSecurityGroups, bareMetalServerFlattenSecurityGroups(nic.SecurityGroups)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting security_groups: %s", err))
+	}
+	if err = d.Set("href", nic.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("mac_address", nic.MacAddress); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mac_address: %s", err))
+	}
+	if err = d.Set("port_speed", flex.IntValue(nic.PortSpeed)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting port_speed: %s", err))
+	}
+	if err = d.Set("resource_type", nic.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if err = d.Set("status", nic.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+	if err = d.Set("type", nic.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsBareMetalServerNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nicPatch := map[string]interface{}{}
+	for _, key := range []string{"name", "allow_ip_spoofing", "enable_infrastructure_nat"} {
+		if d.HasChange(key) {
+			nicPatch[key] = d.Get(key)
+		}
+	}
+	if d.HasChange(isBareMetalServerNicAllowedVlans) {
+		nicPatch[isBareMetalServerNicAllowedVlans] = bareMetalServerExpandVlans(d.Get(isBareMetalServerNicAllowedVlans).(*schema.Set))
+	}
+	if len(nicPatch) > 0 {
+		updateNetworkInterfaceOptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID:                    &parts[0],
+			ID:                                   &parts[1],
+			BareMetalServerNetworkInterfacePatch: nicPatch,
+		}
+		_, response, err := vpcClient.UpdateBareMetalServerNetworkInterfaceWithContext(context, updateNetworkInterfaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBareMetalServerNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network interface (%s) of bare metal server: %s\n%s", d.Id(), err, response))
+		}
+	}
+	if d.HasChange(isBareMetalServerNicSecurityGroups) {
+		oldSecurityGroups, newSecurityGroups := d.GetChange(isBareMetalServerNicSecurityGroups)
+		err = updateSecurityGroupTargetBindings(context, vpcClient, parts[1], oldSecurityGroups.(*schema.Set), newSecurityGroups.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsBareMetalServerNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bareMetalServerID, nicID := parts[0], parts[1]
+
+	isBareMetalServerNicKey := "bare_metal_server_network_interface_key_" + bareMetalServerID
+	unlock, err := conns.ResourceLocks.Lock(context, isBareMetalServerNicKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	err = bareMetalServerWhileStopped(context, vpcClient, d, bareMetalServerID, d.Get(isBareMetalServerNicInterfaceType).(string), func() error {
+		deleteNetworkInterfaceOptions := &vpcv1.DeleteBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID: &bareMetalServerID,
+			ID:                &nicID,
+		}
+		response, err := vpcClient.DeleteBareMetalServerNetworkInterfaceWithContext(context, deleteNetworkInterfaceOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			log.Printf("[DEBUG] DeleteBareMetalServerNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error deleting network interface (%s) of bare metal server: %s\n%s", d.Id(), err, response)
+		}
+		_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(vpcClient, bareMetalServerID, nicID, d.Timeout(schema.TimeoutDelete), d)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// bareMetalServerWhileStopped runs f, stopping the bare metal server before and starting it after
+// when the interface type can only be added or removed while the server is stopped
+func bareMetalServerWhileStopped(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, bareMetalServerID, interfaceType string, f func() error) error {
+	if interfaceType != isBareMetalServerNicInterfaceTypePci {
+		return f()
+	}
+	getBareMetalServerOptions := &vpcv1.GetBareMetalServerOptions{
+		ID: &bareMetalServerID,
+	}
+	bareMetalServer, response, err := vpcClient.GetBareMetalServerWithContext(context, getBareMetalServerOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting bare metal server (%s): %s\n%s", bareMetalServerID, err, response)
+	}
+	running := *bareMetalServer.Status == isBareMetalServerStatusRunning
+	if running {
+		if err = bareMetalServerStop(context, vpcClient, d, bareMetalServerID, vpcv1.StopBareMetalServerOptionsTypeHardConst); err != nil {
+			return err
+		}
+	}
+	if err = f(); err != nil {
+		return err
+	}
+	if running {
+		response, err = vpcClient.StartBareMetalServerWithContext(context, &vpcv1.StartBareMetalServerOptions{
+			ID: &bareMetalServerID,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error starting bare metal server (%s): %s\n%s", bareMetalServerID, err, response)
+		}
+		_, err = isWaitForBareMetalServerActionStart(vpcClient, d.Timeout(schema.TimeoutUpdate), bareMetalServerID, d)
+		return err
+	}
+	return nil
+}
+
+func isWaitForBareMetalServerNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, bareMetalServerID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of bare metal server (%s) to be available.", id, bareMetalServerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerNicStatusPending},
+		Target:  []string{isBareMetalServerNicStatusAvailable, isBareMetalServerNicStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			getNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+				BareMetalServerID: &bareMetalServerID,
+				ID:                &id,
+			}
+			nicIntf, response, err := vpcClient.GetBareMetalServerNetworkInterfaceWithContext(flex.OperationContext(d), getNetworkInterfaceOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting network interface of bare metal server: %s\n%s", err, response)
+			}
+			nic, err := bareMetalServerNetworkInterface(nicIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			d.Set("status", *nic.Status)
+			if *nic.Status == isBareMetalServerNicStatusFailed {
+				return nic, *nic.Status, fmt.Errorf("[ERROR] The network interface %s of bare metal server %s failed", id, bareMetalServerID)
+			}
+			return nic, *nic.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForBareMetalServerNetworkInterfaceDeleted(vpcClient *vpcv1.VpcV1, bareMetalServerID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of bare metal server (%s) to be deleted.", id, bareMetalServerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerNicStatusPending, isBareMetalServerNicStatusDeleting, isBareMetalServerNicStatusAvailable},
+		Target:  []string{isBareMetalServerNicDeleted, isBareMetalServerNicStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			getNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+				BareMetalServerID: &bareMetalServerID,
+				ID:                &id,
+			}
+			nicIntf, response, err := vpcClient.GetBareMetalServerNetworkInterfaceWithContext(flex.OperationContext(d), getNetworkInterfaceOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isBareMetalServerNicDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting network interface of bare metal server: %s\n%s", err, response)
+			}
+			nic, err := bareMetalServerNetworkInterface(nicIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if *nic.Status == isBareMetalServerNicStatusFailed {
+				return nic, *nic.Status, fmt.Errorf("[ERROR] The network interface %s of bare metal server %s failed to delete", id, bareMetalServerID)
+			}
+			return nic, *nic.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
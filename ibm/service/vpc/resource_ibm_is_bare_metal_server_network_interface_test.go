@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISBareMetalServerNetworkInterface_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-server-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-sshname-%d", acctest.RandIntRange(10, 100))
+	nicname := fmt.Sprintf("tf-nic-%d", acctest.RandIntRange(10, 100))
+	nicnameupdate := fmt.Sprintf("tf-nic-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(testAccBareMetalServerPublicKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerNetworkInterfaceExists("ibm_is_bare_metal_server_network_interface.testacc_bms_nic"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_bms_nic", "name", nicname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_bms_nic", "interface_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_bms_nic", "vlan", "101"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_network_interface.testacc_bms_nic", "primary_ip.0.address"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicnameupdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerNetworkInterfaceExists("ibm_is_bare_metal_server_network_interface.testacc_bms_nic"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_bms_nic", "name", nicnameupdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_bare_metal_server_network_interface" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getBareMetalServerNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID: &parts[0],
+			ID:                &parts[1],
+		}
+		_, response, err := vpcClient.GetBareMetalServerNetworkInterface(getBareMetalServerNetworkInterfaceOptions)
+		if err == nil {
+			return fmt.Errorf("Bare metal server network interface still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for bare metal server network interface (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getBareMetalServerNetworkInterfaceOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
+			BareMetalServerID: &parts[0],
+			ID:                &parts[1],
+		}
+		_, _, err = vpcClient.GetBareMetalServerNetworkInterface(getBareMetalServerNetworkInterfaceOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicname string) string {
+	return testAccCheckIBMISBareMetalServerConfigBase(vpcname, subnetname, sshname, publicKey, name) + fmt.Sprintf(`
+
+	resource "ibm_is_bare_metal_server_network_interface" "testacc_bms_nic" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		name              = "%s"
+		interface_type    = "vlan"
+		vlan              = 101
+	}`, nicname)
+}
@@ -677,7 +677,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 			getdhoptions := &vpcv1.GetDedicatedHostOptions{
 				ID: &id,
 			}
-			dedicatedhost, response, err := instanceC.GetDedicatedHost(getdhoptions)
+			dedicatedhost, response, err := instanceC.GetDedicatedHostWithContext(flex.OperationContext(d), getdhoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
@@ -694,7 +694,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -709,7 +709,7 @@ func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -717,7 +717,7 @@ func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Res
 		getinsOptions := &vpcv1.GetDedicatedHostOptions{
 			ID: &id,
 		}
-		dhost, response, err := instanceC.GetDedicatedHost(getinsOptions)
+		dhost, response, err := instanceC.GetDedicatedHostWithContext(flex.OperationContext(d), getinsOptions)
 		if dhost == nil || err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting dedicated host : %s\n%s", err, response)
 		}
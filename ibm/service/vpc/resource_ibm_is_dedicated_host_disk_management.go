@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -96,7 +97,7 @@ func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta
 		}
 		updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
-		_, _, err = sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
+		_, _, err = sess.UpdateDedicatedHostDiskWithContext(flex.OperationContext(d), updateDedicatedHostDiskOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling UpdateDedicatedHostDisk: %s", err)
 		}
@@ -133,7 +134,7 @@ func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta
 			}
 			updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
-			_, response, err := sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
+			_, response, err := sess.UpdateDedicatedHostDiskWithContext(flex.OperationContext(d), updateDedicatedHostDiskOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error updating dedicated host disk: %s %s", err, response)
 			}
@@ -52,11 +52,11 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 					if diff.HasChange(isFloatingIPTarget) {
 						if !diff.NewValueKnown(isFloatingIPTarget) {
@@ -69,7 +69,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 							if err != nil {
 								return err
 							}
-							if checkIfZoneChanged(old.(string), new.(string), diff.Get(isFloatingIPZone).(string), sess) {
+							if checkIfZoneChanged(ctx, old.(string), new.(string), diff.Get(isFloatingIPZone).(string), sess) {
 								diff.ForceNew(isFloatingIPTarget)
 							}
 						}
@@ -133,6 +133,13 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -234,7 +241,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	if tgt, ok := d.GetOk(isFloatingIPTarget); ok {
 		target = tgt.(string)
-		floatingIPPrototype.Target = &vpcv1.FloatingIPByTargetNetworkInterfaceIdentity{
+		floatingIPPrototype.Target = &vpcv1.FloatingIPTargetPrototypeNetworkInterfaceIdentity{
 			ID: &target,
 		}
 	}
@@ -254,7 +261,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		FloatingIPPrototype: floatingIPPrototype,
 	}
 
-	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
+	floatingip, response, err := sess.CreateFloatingIPWithContext(flex.OperationContext(d), createFloatingIPOptions)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
 	}
@@ -265,7 +272,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
@@ -294,7 +301,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getFloatingIPOptions := &vpcv1.GetFloatingIPOptions{
 		ID: &id,
 	}
-	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
+	floatingip, response, err := sess.GetFloatingIPWithContext(flex.OperationContext(d), getFloatingIPOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -319,6 +326,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFloatingIPTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -349,11 +357,11 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange(isFloatingIPTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		fip, response, err := sess.GetFloatingIP(options)
+		fip, response, err := sess.GetFloatingIPWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
 		}
@@ -382,7 +390,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 	if d.HasChange(isFloatingIPTarget) {
 		target := d.Get(isFloatingIPTarget).(string)
-		floatingIPPatchModel.Target = &vpcv1.FloatingIPPatchTargetNetworkInterfaceIdentity{
+		floatingIPPatchModel.Target = &vpcv1.FloatingIPTargetPatchNetworkInterfaceIdentity{
 			ID: &target,
 		}
 		hasChanged = true
@@ -393,7 +401,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		options.FloatingIPPatch = floatingIPPatch
 	}
 	if hasChanged {
-		_, response, err := sess.UpdateFloatingIP(options)
+		_, response, err := sess.UpdateFloatingIPWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
 		}
@@ -418,7 +426,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getFloatingIpOptions := &vpcv1.GetFloatingIPOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
+	_, response, err := sess.GetFloatingIPWithContext(flex.OperationContext(d), getFloatingIpOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -430,11 +438,11 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteFloatingIPOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteFloatingIP(options)
+	response, err = sess.DeleteFloatingIPWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
 	}
-	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -456,7 +464,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	getFloatingIpOptions := &vpcv1.GetFloatingIPOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
+	_, response, err := sess.GetFloatingIPWithContext(flex.OperationContext(d), getFloatingIpOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -466,28 +474,28 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(ctx context.Context, fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isFloatingIPPending, isFloatingIPDeleting},
 		Target:     []string{"", isFloatingIPDeleted},
-		Refresh:    isFloatingIPDeleteRefreshFunc(fip, id),
+		Refresh:    isFloatingIPDeleteRefreshFunc(ctx, fip, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isFloatingIPDeleteRefreshFunc(ctx context.Context, fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] floating ip delete function here")
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		FloatingIP, response, err := fip.GetFloatingIP(getfipoptions)
+		FloatingIP, response, err := fip.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
@@ -504,21 +512,21 @@ func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isFloatingIPPending},
 		Target:     []string{isFloatingIPAvailable, ""},
-		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
+		Refresh:    isInstanceFloatingIPRefreshFunc(flex.OperationContext(d), floatingipC, id),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
-func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isInstanceFloatingIPRefreshFunc(ctx context.Context, floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
+		instance, response, err := floatingipC.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
 		}
@@ -531,7 +539,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 	}
 }
 
-func checkIfZoneChanged(oldNic, newNic, currentZone string, floatingipC *vpcv1.VpcV1) bool {
+func checkIfZoneChanged(ctx context.Context, oldNic, newNic, currentZone string, floatingipC *vpcv1.VpcV1) bool {
 	var oldZone, newZone string
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
 	start := ""
@@ -542,7 +550,7 @@ func checkIfZoneChanged(oldNic, newNic, currentZone string, floatingipC *vpcv1.V
 			listInstancesOptions.Start = &start
 		}
 
-		instances, _, err := floatingipC.ListInstances(listInstancesOptions)
+		instances, _, err := floatingipC.ListInstancesWithContext(ctx, listInstancesOptions)
 		if err != nil {
 			return false
 		}
@@ -51,7 +51,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -137,6 +137,13 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -225,11 +232,11 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	createFlowLogCollectorOptionsModel.Target = FlowLogCollectorTargetModel
 
 	bucketname := d.Get(isFlowLogStorageBucket).(string)
-	cloudObjectStorageBucketIdentityModel := new(vpcv1.CloudObjectStorageBucketIdentityByName)
+	cloudObjectStorageBucketIdentityModel := new(vpcv1.LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName)
 	cloudObjectStorageBucketIdentityModel.Name = &bucketname
 	createFlowLogCollectorOptionsModel.StorageBucket = cloudObjectStorageBucketIdentityModel
 
-	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
+	flowlogCollector, response, err := sess.CreateFlowLogCollectorWithContext(flex.OperationContext(d), createFlowLogCollectorOptionsModel)
 	if err != nil {
 		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
 	}
@@ -238,7 +245,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -262,7 +269,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	getOptions := &vpcv1.GetFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+	flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
@@ -312,6 +319,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFlowLogTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -342,12 +350,12 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	getOptions := &vpcv1.GetFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+	flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(flex.OperationContext(d), getOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
 
-	if d.HasChange(isFlowLogTags) {
+	if d.HasChange(isFlowLogTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -371,7 +379,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("[ERROR] Error calling asPatch for FlowLogCollectorPatch: %s", err)
 		}
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
-		_, response, err = sess.UpdateFlowLogCollector(updoptions)
+		_, response, err = sess.UpdateFlowLogCollectorWithContext(flex.OperationContext(d), updoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response)
 		}
@@ -390,7 +398,7 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	delOptions := &vpcv1.DeleteFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	response, err := sess.DeleteFlowLogCollector(delOptions)
+	response, err := sess.DeleteFlowLogCollectorWithContext(flex.OperationContext(d), delOptions)
 
 	if err != nil && response.StatusCode != 404 {
 		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
@@ -411,7 +419,7 @@ func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool,
 	getOptions := &vpcv1.GetFlowLogCollectorOptions{
 		ID: &ID,
 	}
-	_, response, err := sess.GetFlowLogCollector(getOptions)
+	_, response, err := sess.GetFlowLogCollectorWithContext(flex.OperationContext(d), getOptions)
 	if err != nil && response.StatusCode != 404 {
 		return false, fmt.Errorf("[ERROR] Error Getting Flow Log Collector : %s\n%s", err, response)
 	}
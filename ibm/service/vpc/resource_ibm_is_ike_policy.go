@@ -237,7 +237,7 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 			ID: &rg,
 		}
 	}
-	ike, response, err := sess.CreateIkePolicy(options)
+	ike, response, err := sess.CreateIkePolicyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
 	}
@@ -260,7 +260,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getikepoptions := &vpcv1.GetIkePolicyOptions{
 		ID: &id,
 	}
-	ike, response, err := sess.GetIkePolicy(getikepoptions)
+	ike, response, err := sess.GetIkePolicyWithContext(flex.OperationContext(d), getikepoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -344,7 +344,7 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		options.IkePolicyPatch = ikePolicyPatch
 
-		_, response, err := sess.UpdateIkePolicy(options)
+		_, response, err := sess.UpdateIkePolicyWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of IKE Policy(%s): %s\n%s", id, err, response)
 		}
@@ -366,7 +366,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getikepoptions := &vpcv1.GetIkePolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIkePolicy(getikepoptions)
+	_, response, err := sess.GetIkePolicyWithContext(flex.OperationContext(d), getikepoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -378,7 +378,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
+	response, err = sess.DeleteIkePolicyWithContext(flex.OperationContext(d), deleteIkePolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
 	}
@@ -400,7 +400,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 	options := &vpcv1.GetIkePolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIkePolicy(options)
+	_, response, err := sess.GetIkePolicyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
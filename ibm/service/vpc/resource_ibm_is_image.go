@@ -59,7 +59,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -102,6 +102,13 @@ func ResourceIBMISImage() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isImageOperatingSystem: {
 				Type:         schema.TypeString,
@@ -284,18 +291,18 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	options := &vpcv1.CreateImageOptions{
 		ImagePrototype: imagePrototype,
 	}
-	image, response, err := sess.CreateImage(options)
+	image, response, err := sess.CreateImageWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -320,7 +327,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	options := &vpcv1.GetVolumeOptions{
 		ID: &volume,
 	}
-	vol, response, err := sess.GetVolume(options)
+	vol, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), options)
 	if err != nil || vol == nil {
 		return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volume, err, response)
 	}
@@ -332,7 +339,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &insId,
 	}
-	instance, response, err := sess.GetInstance(getinsOptions)
+	instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil || instance == nil {
 		return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the source_volume (%s) is attached : %s\n%s", insId, volume, err, response)
 	}
@@ -342,7 +349,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 			InstanceID: &insId,
 			Type:       &actiontype,
 		}
-		_, response, err = sess.CreateInstanceAction(createinsactoptions)
+		_, response, err = sess.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error stopping Instance (%s) to which the source_volume (%s) is attached  : %s\n%s", insId, volume, err, response)
 		}
@@ -373,18 +380,18 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	imagOptions := &vpcv1.CreateImageOptions{
 		ImagePrototype: imagePrototype,
 	}
-	image, response, err := sess.CreateImage(imagOptions)
+	image, response, err := sess.CreateImageWithContext(flex.OperationContext(d), imagOptions)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -395,26 +402,26 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	return nil
 }
 
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isImageProvisioning},
 		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isImageRefreshFunc(imageC, id),
+		Refresh:    isImageRefreshFunc(ctx, imageC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
-func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isImageRefreshFunc(ctx context.Context, imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
 		}
@@ -450,11 +457,11 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange(isImageTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := sess.GetImage(options)
+		image, response, err := sess.GetImageWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Image IP: %s\n%s", err, response)
 		}
@@ -477,7 +484,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for ImagePatch: %s", err)
 		}
 		options.ImagePatch = imagePatch
-		_, response, err := sess.UpdateImage(options)
+		_, response, err := sess.UpdateImageWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of resource vpc Image: %s\n%s", err, response)
 		}
@@ -503,7 +510,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetImageOptions{
 		ID: &id,
 	}
-	image, response, err := sess.GetImage(options)
+	image, response, err := sess.GetImageWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -549,6 +556,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isImageTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -582,7 +590,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getImageOptions := &vpcv1.GetImageOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetImage(getImageOptions)
+	_, response, err := sess.GetImageWithContext(flex.OperationContext(d), getImageOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -593,11 +601,11 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteImageOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteImage(options)
+	response, err = sess.DeleteImageWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Image : %s\n%s", err, response)
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -605,28 +613,28 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isImageDeleting},
 		Target:     []string{"", isImageDeleted},
-		Refresh:    isImageDeleteRefreshFunc(imageC, id),
+		Refresh:    isImageDeleteRefreshFunc(ctx, imageC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isImageDeleteRefreshFunc(ctx context.Context, imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is image delete function here")
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
@@ -650,7 +658,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	options := &vpcv1.GetImageOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetImage(options)
+	_, response, err := sess.GetImageWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
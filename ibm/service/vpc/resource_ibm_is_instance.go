@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -97,6 +98,11 @@ const (
 	isPlacementTargetDedicatedHostGroup = "dedicated_host_group"
 	isInstancePlacementTarget           = "placement_target"
 	isPlacementTargetPlacementGroup     = "placement_group"
+
+	isInstanceMetadataServiceEnabled        = "metadata_service_enabled"
+	isInstanceDefaultTrustedProfile         = "default_trusted_profile"
+	isInstanceDefaultTrustedProfileTarget   = "target"
+	isInstanceDefaultTrustedProfileAutoLink = "auto_link"
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -117,7 +123,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				getinsOptions := &vpcv1.GetInstanceOptions{
 					ID: &id,
 				}
-				instance, response, err := instanceC.GetInstance(getinsOptions)
+				instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 				if err != nil {
 					if response != nil && response.StatusCode == 404 {
 						d.SetId("")
@@ -135,6 +141,7 @@ func ResourceIBMISInstance() *schema.Resource {
 					}
 				}
 				d.Set(isInstanceVolumes, flex.NewStringSet(schema.HashString, volumes))
+				instanceSetDefaultTrustedProfile(d, instanceC, id)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -147,7 +154,10 @@ func ResourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISInstanceProfileCustomizeDiff(ctx, diff, v)
 			},
 		),
 
@@ -228,6 +238,25 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:  "The amount of bandwidth (in megabits per second) allocated exclusively to instance storage volumes",
 			},
 
+			isInstanceMetadataServiceEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the metadata service endpoint is available to the virtual server instance",
+			},
+
+			isInstanceDefaultTrustedProfile: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The default trusted profile to be used when initializing the virtual server instance",
+				Elem: &schema.Resource{
+					Schema: instanceDefaultTrustedProfileSchema(),
+				},
+			},
+
 			isInstanceBandwidth: {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -257,6 +286,13 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isEnableCleanDelete: {
 				Type:             schema.TypeBool,
@@ -698,6 +734,42 @@ func ResourceIBMISInstance() *schema.Resource {
 	}
 }
 
+// resourceIBMISInstanceProfileCustomizeDiff validates the profile against the instance profiles
+// of the region when the provider plan_time_validation is enabled
+func resourceIBMISInstanceProfileCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	cache := flex.PlanTimeValidation(meta)
+	if cache == nil {
+		return nil
+	}
+	profile, ok := flex.PlanTimeValidationValue(diff, isInstanceProfile)
+	if !ok {
+		return nil
+	}
+	profiles, err := cache.Load("is_instance_profiles", func() (interface{}, error) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return nil, err
+		}
+		availableProfiles, response, err := sess.ListInstanceProfilesWithContext(ctx, &vpcv1.ListInstanceProfilesOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		names := make(map[string]bool, len(availableProfiles.Profiles))
+		for _, p := range availableProfiles.Profiles {
+			names[*p.Name] = true
+		}
+		return names, nil
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to list the instance profiles, the profile %s is not validated: %s", profile, err)
+		return nil
+	}
+	if !profiles.(map[string]bool)[profile] {
+		return fmt.Errorf("[ERROR] The instance profile %s is not available in the region, the ibm_is_instance_profiles data source lists the available profiles", profile)
+	}
+	return nil
+}
+
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 	actions := "stop, start, reboot"
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -734,16 +806,6 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              actions})
 
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isInstanceBootAttachmentName,
-			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
-			Type:                       validate.TypeString,
-			Optional:                   true,
-			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
-			MinValueLength:             1,
-			MaxValueLength:             63})
-
 	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
@@ -836,7 +898,9 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
 		ipv4str := ipv4.(string)
 		if ipv4str != "" {
-			primnicobj.PrimaryIpv4Address = &ipv4str
+			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+				Address: &ipv4str,
+			}
 		}
 		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
 		allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -879,7 +943,9 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
 			ipv4str := ipv4.(string)
 			if ipv4str != "" {
-				nwInterface.PrimaryIpv4Address = &ipv4str
+				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+					Address: &ipv4str,
+				}
 			}
 			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
 			allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -930,11 +996,19 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 
 	}
 
+	if metadataService := instanceExpandMetadataService(d); metadataService != nil {
+		instanceproto.MetadataService = metadataService
+	}
+
+	if defaultTrustedProfile := instanceExpandDefaultTrustedProfile(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
+	}
+
 	options := &vpcv1.CreateInstanceOptions{
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -950,7 +1024,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1059,7 +1133,9 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
 		ipv4str := ipv4.(string)
 		if ipv4str != "" {
-			primnicobj.PrimaryIpv4Address = &ipv4str
+			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+				Address: &ipv4str,
+			}
 		}
 		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
 		allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -1102,7 +1178,9 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
 			ipv4str := ipv4.(string)
 			if ipv4str != "" {
-				nwInterface.PrimaryIpv4Address = &ipv4str
+				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+					Address: &ipv4str,
+				}
 			}
 			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
 			allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -1153,11 +1231,19 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 
 	}
 
+	if metadataService := instanceExpandMetadataService(d); metadataService != nil {
+		instanceproto.MetadataService = metadataService
+	}
+
+	if defaultTrustedProfile := instanceExpandDefaultTrustedProfile(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
+	}
+
 	options := &vpcv1.CreateInstanceOptions{
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1173,7 +1259,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1189,7 +1275,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	if err != nil {
 		return err
 	}
-	instanceproto := &vpcv1.InstancePrototypeInstanceByVolume{
+	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
 		Zone: &vpcv1.ZoneIdentity{
 			Name: &zone,
 		},
@@ -1223,7 +1309,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 
 	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
 		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		var volTemplate = &vpcv1.VolumeAttachmentVolumePrototypeInstanceByVolumeContext{}
+		var volTemplate = &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{}
 
 		name, ok := bootvol[isInstanceBootAttachmentName]
 		namestr := name.(string)
@@ -1250,7 +1336,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 			}
 		}
 		deletebool := true
-		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext{
+		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
 			DeleteVolumeOnInstanceDelete: &deletebool,
 			Volume:                       volTemplate,
 		}
@@ -1276,7 +1362,9 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
 		ipv4str := ipv4.(string)
 		if ipv4str != "" {
-			primnicobj.PrimaryIpv4Address = &ipv4str
+			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+				Address: &ipv4str,
+			}
 		}
 		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
 		allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -1319,7 +1407,9 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
 			ipv4str := ipv4.(string)
 			if ipv4str != "" {
-				nwInterface.PrimaryIpv4Address = &ipv4str
+				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+					Address: &ipv4str,
+				}
 			}
 			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
 			allowIPSpoofingbool := allowIPSpoofing.(bool)
@@ -1370,11 +1460,19 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 
 	}
 
+	if metadataService := instanceExpandMetadataService(d); metadataService != nil {
+		instanceproto.MetadataService = metadataService
+	}
+
+	if defaultTrustedProfile := instanceExpandDefaultTrustedProfile(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
+	}
+
 	options := &vpcv1.CreateInstanceOptions{
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1390,7 +1488,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1450,7 +1548,7 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -1458,7 +1556,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 		}
@@ -1498,7 +1596,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
@@ -1510,7 +1608,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
@@ -1542,7 +1640,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -1576,6 +1674,16 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set(isInstanceTotalVolumeBandwidth, int(*instance.TotalVolumeBandwidth))
 	}
 
+	if instance.MetadataService != nil {
+		d.Set(isInstanceMetadataServiceEnabled, instance.MetadataService.Enabled)
+	}
+
+	// the initialization is read only for the instances with a default trusted profile, it is read
+	// for every imported instance by the importer
+	if _, ok := d.GetOk(isInstanceDefaultTrustedProfile); ok {
+		instanceSetDefaultTrustedProfile(d, instanceC, id)
+	}
+
 	d.Set(isInstanceMemory, *instance.Memory)
 	gpuList := make([]map[string]interface{}, 0)
 	if instance.Gpu != nil {
@@ -1593,12 +1701,14 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		currentPrimNic := map[string]interface{}{}
 		currentPrimNic["id"] = *instance.PrimaryNetworkInterface.ID
 		currentPrimNic[isInstanceNicName] = *instance.PrimaryNetworkInterface.Name
-		currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+		if instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+			currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIP.Address
+		}
 		getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 			InstanceID: &id,
 			ID:         instance.PrimaryNetworkInterface.ID,
 		}
-		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+		insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 		}
@@ -1623,12 +1733,14 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				currentNic := map[string]interface{}{}
 				currentNic["id"] = *intfc.ID
 				currentNic[isInstanceNicName] = *intfc.Name
-				currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+				if intfc.PrimaryIP != nil && intfc.PrimaryIP.Address != nil {
+					currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIP.Address
+				}
 				getnicoptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
 					InstanceID: &id,
 					ID:         intfc.ID,
 				}
-				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+				insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
 				}
@@ -1696,7 +1808,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			options := &vpcv1.GetVolumeOptions{
 				ID: instance.BootVolumeAttachment.Volume.ID,
 			}
-			vol, response, err := instanceC.GetVolume(options)
+			vol, response, err := instanceC.GetVolumeWithContext(flex.OperationContext(d), options)
 			if err != nil {
 				log.Printf("Error Getting Boot Volume (%s): %s\n%s", id, err, response)
 			}
@@ -1718,6 +1830,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource Instance (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isInstanceTags, tags)
+	d.Set(flex.TagsAll, tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -1769,7 +1882,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			getinsOptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsOptions)
+			instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
 			}
@@ -1788,7 +1901,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				force := instanceActionForceIntf.(bool)
 				createinsactoptions.Force = &force
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
@@ -1827,7 +1940,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					},
 					DeleteVolumeOnInstanceDelete: &volautoDelete,
 				}
-				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
+				vol, _, err := instanceC.CreateInstanceVolumeAttachmentWithContext(flex.OperationContext(d), createvolattoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
 				}
@@ -1843,7 +1956,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 					InstanceID: &id,
 				}
-				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+				vols, _, err := instanceC.ListInstanceVolumeAttachmentsWithContext(flex.OperationContext(d), listvolattoptions)
 				if err != nil {
 					return err
 				}
@@ -1853,7 +1966,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							InstanceID: &id,
 							ID:         vol.ID,
 						}
-						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+						_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(flex.OperationContext(d), delvolattoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
 						}
@@ -1881,7 +1994,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &add[i],
 					ID:              &networkID,
 				}
-				_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
+				_, response, err := instanceC.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), createsgnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 				}
@@ -1899,7 +2012,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &remove[i],
 					ID:              &networkID,
 				}
-				response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+				response, err := instanceC.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deletesgnicoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 				}
@@ -1930,7 +2043,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+		_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(flex.OperationContext(d), updatepnicfoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
 		}
@@ -1960,7 +2073,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &add[i],
 							ID:              &networkID,
 						}
-						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
+						_, response, err := instanceC.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), createsgnicoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 						}
@@ -1979,7 +2092,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &remove[i],
 							ID:              &networkID,
 						}
-						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+						response, err := instanceC.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deletesgnicoptions)
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 						}
@@ -2012,7 +2125,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+				_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(flex.OperationContext(d), updatepnicfoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
 				}
@@ -2039,7 +2152,30 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(flex.OperationContext(d), updnetoptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange(isInstanceMetadataServiceEnabled) && !d.IsNewResource() {
+		enabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
+		updnetoptions := &vpcv1.UpdateInstanceOptions{
+			ID: &id,
+		}
+
+		instancePatchModel := &vpcv1.InstancePatch{
+			MetadataService: &vpcv1.InstanceMetadataServicePatch{
+				Enabled: &enabled,
+			},
+		}
+		instancePatch, err := instancePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch with metadata service for InstancePatch: %s", err)
+		}
+		updnetoptions.InstancePatch = instancePatch
+
+		_, _, err = instanceC.UpdateInstanceWithContext(flex.OperationContext(d), updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -2060,7 +2196,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(flex.OperationContext(d), updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -2071,7 +2207,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
@@ -2086,7 +2222,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return nil
@@ -2116,7 +2252,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, response, err = instanceC.UpdateInstance(updnetoptions)
+		_, response, err = instanceC.UpdateInstanceWithContext(flex.OperationContext(d), updnetoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
 		}
@@ -2126,7 +2262,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -2143,11 +2279,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 	}
-	if d.HasChange(isInstanceTags) {
+	if d.HasChange(isInstanceTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2178,7 +2314,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -2195,7 +2331,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -2209,7 +2345,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 			InstanceID: &id,
 		}
-		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+		vols, response, err := instanceC.ListInstanceVolumeAttachmentsWithContext(flex.OperationContext(d), listvolattoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Listing volume attachments to the instance: %s\n%s", err, response)
 		}
@@ -2219,7 +2355,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 					InstanceID: &id,
 					ID:         vol.ID,
 				}
-				_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+				_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(flex.OperationContext(d), delvolattoptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
@@ -2236,7 +2372,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteinstanceOptions := &vpcv1.DeleteInstanceOptions{
 		ID: &id,
 	}
-	_, err = instanceC.DeleteInstance(deleteinstanceOptions)
+	_, err = instanceC.DeleteInstanceWithContext(flex.OperationContext(d), deleteinstanceOptions)
 	if err != nil {
 		return err
 	}
@@ -2246,7 +2382,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return err
 		}
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
-			_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForVolumeDeleted(flex.OperationContext(d), instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
@@ -2275,7 +2411,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -2303,7 +2439,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
@@ -2320,7 +2456,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
@@ -2332,7 +2468,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsoptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 			}
@@ -2359,7 +2495,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
@@ -2371,7 +2507,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsoptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 			}
@@ -2398,7 +2534,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2414,7 +2550,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				communicator <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
 				return
@@ -2434,22 +2570,22 @@ func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isInstanceVolumeAttaching},
 		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
+		Refresh:    isInstanceVolumeRefreshFunc(flex.OperationContext(d), instanceC, id, volID),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
-func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
+func isInstanceVolumeRefreshFunc(ctx context.Context, instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
 			InstanceID: &id,
 			ID:         &volID,
 		}
-		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+		vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
 		}
@@ -2472,7 +2608,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				InstanceID: &id,
 				ID:         &volID,
 			}
-			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+			vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(flex.OperationContext(d), getvolattoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
@@ -2489,7 +2625,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
@@ -2537,3 +2673,96 @@ func resourceIbmIsInstanceDedicatedHostGroupReferenceDeletedToMap(dedicatedHostG
 
 	return dedicatedHostGroupReferenceDeletedMap
 }
+
+// instanceDefaultTrustedProfileSchema returns the arguments of the default trusted profile, shared
+// by the instances and the instance templates
+func instanceDefaultTrustedProfileSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isInstanceDefaultTrustedProfileTarget: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The unique identifier or CRN of the default IAM trusted profile to use for this virtual server instance",
+		},
+		isInstanceDefaultTrustedProfileAutoLink: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "If set to true, the system will create a link to the specified target trusted profile during instance creation, which will be deleted when the instance is deleted",
+		},
+	}
+}
+
+// instanceExpandDefaultTrustedProfile returns the default trusted profile of the configuration,
+// identified by crn or by id, or nil when none is set
+func instanceExpandDefaultTrustedProfile(d *schema.ResourceData) *vpcv1.InstanceDefaultTrustedProfilePrototype {
+	profiles := d.Get(isInstanceDefaultTrustedProfile).([]interface{})
+	if len(profiles) == 0 || profiles[0] == nil {
+		return nil
+	}
+	profile := profiles[0].(map[string]interface{})
+	target := profile[isInstanceDefaultTrustedProfileTarget].(string)
+	defaultTrustedProfile := &vpcv1.InstanceDefaultTrustedProfilePrototype{}
+	if strings.HasPrefix(target, "crn:") {
+		defaultTrustedProfile.Target = &vpcv1.TrustedProfileIdentityTrustedProfileByCRN{
+			CRN: &target,
+		}
+	} else {
+		defaultTrustedProfile.Target = &vpcv1.TrustedProfileIdentityTrustedProfileByID{
+			ID: &target,
+		}
+	}
+	if autoLink, ok := d.GetOkExists(isInstanceDefaultTrustedProfile + ".0." + isInstanceDefaultTrustedProfileAutoLink); ok {
+		autoLinkBool := autoLink.(bool)
+		defaultTrustedProfile.AutoLink = &autoLinkBool
+	}
+	return defaultTrustedProfile
+}
+
+// instanceExpandMetadataService returns the metadata service settings of the configuration, or
+// nil when metadata_service_enabled is not set
+func instanceExpandMetadataService(d *schema.ResourceData) *vpcv1.InstanceMetadataServicePrototype {
+	if enabled, ok := d.GetOkExists(isInstanceMetadataServiceEnabled); ok {
+		enabledBool := enabled.(bool)
+		return &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: &enabledBool,
+		}
+	}
+	return nil
+}
+
+// instanceSetDefaultTrustedProfile sets the default trusted profile of the initialization of the
+// instance. A failure to read the initialization is logged, and the default trusted profile of the
+// state is kept.
+func instanceSetDefaultTrustedProfile(d *schema.ResourceData, instanceC *vpcv1.VpcV1, id string) {
+	getInstanceInitializationOptions := &vpcv1.GetInstanceInitializationOptions{
+		ID: &id,
+	}
+	initParms, response, err := instanceC.GetInstanceInitializationWithContext(flex.OperationContext(d), getInstanceInitializationOptions)
+	if err != nil {
+		log.Printf("[WARN] Error getting Instance initialization, the default trusted profile is not refreshed: %s\n%s", err, response)
+		return
+	}
+	if initParms.DefaultTrustedProfile != nil && initParms.DefaultTrustedProfile.Target != nil {
+		target := initParms.DefaultTrustedProfile.Target
+		d.Set(isInstanceDefaultTrustedProfile, instanceFlattenDefaultTrustedProfile(d, target.ID, target.CRN, initParms.DefaultTrustedProfile.AutoLink))
+	} else {
+		d.Set(isInstanceDefaultTrustedProfile, nil)
+	}
+}
+
+// instanceFlattenDefaultTrustedProfile returns the default trusted profile with its target in the
+// form, crn or id, used by the configuration so that reading it back does not produce a diff
+func instanceFlattenDefaultTrustedProfile(d *schema.ResourceData, id, crn *string, autoLink *bool) []map[string]interface{} {
+	target := id
+	if configured, ok := d.GetOk(isInstanceDefaultTrustedProfile + ".0." + isInstanceDefaultTrustedProfileTarget); ok && crn != nil && configured.(string) == *crn {
+		target = crn
+	} else if target == nil {
+		target = crn
+	}
+	return []map[string]interface{}{{
+		isInstanceDefaultTrustedProfileTarget:   target,
+		isInstanceDefaultTrustedProfileAutoLink: autoLink,
+	}}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -118,7 +119,7 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &instanceId,
 	}
-	instance, response, err := sess.GetInstance(getinsOptions)
+	instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", instanceId, err, response))
 	}
@@ -137,7 +138,7 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		force := instanceActionForceIntf.(bool)
 		createinsactoptions.Force = &force
 	}
-	_, response, err = sess.CreateInstanceAction(createinsactoptions)
+	_, response, err = sess.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -170,7 +171,7 @@ func resourceIBMISInstanceActionRead(context context.Context, d *schema.Resource
 	options := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := sess.GetInstance(options)
+	instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -207,7 +208,7 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := sess.GetInstance(getinsOptions)
+	instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response))
 	}
@@ -222,7 +223,7 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
-	_, response, err = sess.CreateInstanceAction(createinsactoptions)
+	_, response, err = sess.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -258,7 +259,7 @@ func resourceIBMISInstanceActionExists(d *schema.ResourceData, meta interface{})
 	getInstanceOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetInstance(getInstanceOptions)
+	_, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getInstanceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
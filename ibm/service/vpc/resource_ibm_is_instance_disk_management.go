@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -97,7 +98,7 @@ func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta inte
 		}
 		updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
-		_, response, err := sess.UpdateInstanceDisk(updateInstanceDiskOptions)
+		_, response, err := sess.UpdateInstanceDiskWithContext(flex.OperationContext(d), updateInstanceDiskOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling UpdateInstanceDisk: %s %s", err, response)
 		}
@@ -135,7 +136,7 @@ func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta inte
 			}
 			updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
-			_, _, err = sess.UpdateInstanceDisk(updateInstanceDiskOptions)
+			_, _, err = sess.UpdateInstanceDiskWithContext(flex.OperationContext(d), updateInstanceDiskOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error updating instance disk: %s", err)
 			}
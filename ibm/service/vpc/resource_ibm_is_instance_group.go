@@ -39,7 +39,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -146,6 +146,13 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for instance group",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 		},
 	}
 }
@@ -243,19 +250,19 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		instanceGroupOptions.ApplicationPort = &applicatioPort
 	}
 
-	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
+	instanceGroup, response, err := sess.CreateInstanceGroupWithContext(flex.OperationContext(d), &instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
 		return fmt.Errorf("[ERROR] Error Creating InstanceGroup: %s\n%s", err, response)
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d.Id(), meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), d.Id(), meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		return healthError
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
@@ -278,10 +285,10 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.TagsAll) {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+		instanceGroup, response, err := sess.GetInstanceGroupWithContext(flex.OperationContext(d), &getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
 			return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
 		}
@@ -343,13 +350,13 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
 		}
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
-		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+		_, response, err := sess.UpdateInstanceGroupWithContext(flex.OperationContext(d), &instanceGroupUpdateOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response)
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			return healthError
 		}
@@ -365,7 +372,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 
 	instanceGroupID := d.Id()
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(flex.OperationContext(d), &getInstanceGroupOptions)
 	if err != nil || instanceGroup == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -406,14 +413,15 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set(flex.TagsAll, tags)
 	return nil
 }
 
-func getLBStatus(sess *vpcv1.VpcV1, lbId string) (string, error) {
+func getLBStatus(ctx context.Context, sess *vpcv1.VpcV1, lbId string) (string, error) {
 	getlboptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbId,
 	}
-	lb, response, err := sess.GetLoadBalancer(getlboptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 	if err != nil || lb == nil {
 		return "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -432,7 +440,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 
 	// First, get the instance
 	igOpts := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-	instanceGroup, response, err := sess.GetInstanceGroup(&igOpts)
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(flex.OperationContext(d), &igOpts)
 	if err != nil || instanceGroup == nil {
 		if response != nil && response.StatusCode == 404 {
 			return fmt.Errorf("Instance Group with id:[%s] not found!!", instanceGroupID)
@@ -452,11 +460,11 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 
 	instanceGroupUpdateOptions.ID = &instanceGroupID
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
-	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+	_, response, err = sess.UpdateInstanceGroupWithContext(flex.OperationContext(d), &instanceGroupUpdateOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
 	}
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
 		return healthError
 	}
@@ -468,17 +476,17 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 		loadBalancerID := strings.Split(loadBalancerPool, "/")[5]
 
 		// Now check if the load balancer is in active state or not
-		lbStatus, err := getLBStatus(sess, loadBalancerID)
+		lbStatus, err := getLBStatus(flex.OperationContext(d), sess, loadBalancerID)
 		if err != nil {
 			return err
 		}
 		if lbStatus != "active" {
 			log.Printf("Load Balancer [%s] is not active....Waiting it to be active!\n", loadBalancerID)
-			_, err := isWaitForLBAvailable(sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
+			_, err := isWaitForLBAvailable(flex.OperationContext(d), sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
-			lbStatus, err = getLBStatus(sess, loadBalancerID)
+			lbStatus, err = getLBStatus(flex.OperationContext(d), sess, loadBalancerID)
 			if err != nil {
 				return err
 			}
@@ -489,7 +497,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	deleteInstanceGroupOptions := vpcv1.DeleteInstanceGroupOptions{ID: &instanceGroupID}
-	response, Err := sess.DeleteInstanceGroup(&deleteInstanceGroupOptions)
+	response, Err := sess.DeleteInstanceGroupWithContext(flex.OperationContext(d), &deleteInstanceGroupOptions)
 	if Err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -512,7 +520,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	}
 	instanceGroupID := d.Id()
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
-	_, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	_, response, err := sess.GetInstanceGroupWithContext(flex.OperationContext(d), &getInstanceGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -522,7 +530,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(ctx context.Context, instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -534,7 +542,7 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		Pending: []string{SCALING},
 		Target:  []string{HEALTHY},
 		Refresh: func() (interface{}, string, error) {
-			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+			instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
 				return nil, SCALING, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
 			}
@@ -551,7 +559,7 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, healthStateConf)
 
 }
 
@@ -572,6 +580,6 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), healthStateConf)
 
 }
@@ -207,7 +207,7 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupID:               &instanceGroupID,
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
-		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
+		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManagerWithContext(flex.OperationContext(d), &createInstanceGroupManagerOptions)
 		if err != nil || instanceGroupManagerIntf == nil {
 			return fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response)
 		}
@@ -254,12 +254,12 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+		_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 		if healthError != nil {
 			return healthError
 		}
 
-		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
+		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManagerWithContext(flex.OperationContext(d), &createInstanceGroupManagerOptions)
 		if err != nil || instanceGroupManagerIntf == nil {
 			return fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response)
 		}
@@ -337,12 +337,12 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			return healthError
 		}
 
-		_, response, err := sess.UpdateInstanceGroupManager(&updateInstanceGroupManagerOptions)
+		_, response, err := sess.UpdateInstanceGroupManagerWithContext(flex.OperationContext(d), &updateInstanceGroupManagerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating InstanceGroup manager: %s\n%s", err, response)
 		}
@@ -367,7 +367,7 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 		ID:              &instanceGroupManagerID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	instanceGroupManagerIntf, response, err := sess.GetInstanceGroupManager(&getInstanceGroupManagerOptions)
+	instanceGroupManagerIntf, response, err := sess.GetInstanceGroupManagerWithContext(flex.OperationContext(d), &getInstanceGroupManagerOptions)
 	if err != nil || instanceGroupManagerIntf == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -440,12 +440,12 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 		InstanceGroupID: &instanceGroupID,
 	}
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		return healthError
 	}
 
-	response, err := sess.DeleteInstanceGroupManager(&deleteInstanceGroupManagerOptions)
+	response, err := sess.DeleteInstanceGroupManagerWithContext(flex.OperationContext(d), &deleteInstanceGroupManagerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -266,12 +266,12 @@ func resourceIBMISInstanceGroupManagerActionCreate(d *schema.ResourceData, meta
 
 	instanceGroupManagerActionOptions.InstanceGroupManagerActionPrototype = &instanceGroupManagerActionPrototype
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		return healthError
 	}
 
-	instanceGroupManagerActionIntf, response, err := sess.CreateInstanceGroupManagerAction(&instanceGroupManagerActionOptions)
+	instanceGroupManagerActionIntf, response, err := sess.CreateInstanceGroupManagerActionWithContext(flex.OperationContext(d), &instanceGroupManagerActionOptions)
 	if err != nil || instanceGroupManagerActionIntf == nil {
 		return fmt.Errorf("[ERROR] Error creating InstanceGroup manager Action: %s\n%s", err, response)
 	}
@@ -359,11 +359,11 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 		}
 		updateInstanceGroupManagerActionOptions.InstanceGroupManagerActionPatch = instanceGroupManagerActionPatch
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			return healthError
 		}
-		_, response, err := sess.UpdateInstanceGroupManagerAction(updateInstanceGroupManagerActionOptions)
+		_, response, err := sess.UpdateInstanceGroupManagerActionWithContext(flex.OperationContext(d), updateInstanceGroupManagerActionOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating InstanceGroup manager action: %s\n%s", err, response)
 		}
@@ -391,7 +391,7 @@ func resourceIBMISInstanceGroupManagerActionRead(d *schema.ResourceData, meta in
 		ID:                     &instanceGroupManagerActionID,
 	}
 
-	instanceGroupManagerActionIntf, response, err := sess.GetInstanceGroupManagerAction(getInstanceGroupManagerActionOptions)
+	instanceGroupManagerActionIntf, response, err := sess.GetInstanceGroupManagerActionWithContext(flex.OperationContext(d), getInstanceGroupManagerActionOptions)
 	if err != nil || instanceGroupManagerActionIntf == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -489,12 +489,12 @@ func resourceIBMISInstanceGroupManagerActionDelete(d *schema.ResourceData, meta
 	deleteInstanceGroupManagerActionOptions.InstanceGroupManagerID = &instancegroupmanagerscheduledID
 	deleteInstanceGroupManagerActionOptions.ID = &instanceGroupManagerActionID
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		return healthError
 	}
 
-	response, err := sess.DeleteInstanceGroupManagerAction(deleteInstanceGroupManagerActionOptions)
+	response, err := sess.DeleteInstanceGroupManagerActionWithContext(flex.OperationContext(d), deleteInstanceGroupManagerActionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -526,7 +526,7 @@ func resourceIBMISInstanceGroupManagerActionExists(d *schema.ResourceData, meta
 		ID:                     &instanceGroupManagerActionID,
 	}
 
-	_, response, err := sess.GetInstanceGroupManagerAction(getInstanceGroupManagerActionOptions)
+	_, response, err := sess.GetInstanceGroupManagerActionWithContext(flex.OperationContext(d), getInstanceGroupManagerActionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -6,7 +6,6 @@ package vpc
 import (
 	"fmt"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -133,15 +132,18 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isInsGrpKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		return healthError
 	}
 
-	data, response, err := sess.CreateInstanceGroupManagerPolicy(&createInstanceGroupManagerPolicyOptions)
+	data, response, err := sess.CreateInstanceGroupManagerPolicyWithContext(flex.OperationContext(d), &createInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
 		return fmt.Errorf("[ERROR] Error Creating InstanceGroup Manager Policy: %s\n%s", err, response)
 	}
@@ -194,15 +196,18 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		updateInstanceGroupManagerPolicyOptions.InstanceGroupManagerID = &instanceGroupManagerID
 
 		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-		conns.IbmMutexKV.Lock(isInsGrpKey)
-		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isInsGrpKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			return healthError
 		}
 
-		_, response, err := sess.UpdateInstanceGroupManagerPolicy(&updateInstanceGroupManagerPolicyOptions)
+		_, response, err := sess.UpdateInstanceGroupManagerPolicyWithContext(flex.OperationContext(d), &updateInstanceGroupManagerPolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
 		}
@@ -229,7 +234,7 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 		InstanceGroupID:        &instanceGroupID,
 		InstanceGroupManagerID: &instanceGroupManagerID,
 	}
-	data, response, err := sess.GetInstanceGroupManagerPolicy(&getInstanceGroupManagerPolicyOptions)
+	data, response, err := sess.GetInstanceGroupManagerPolicyWithContext(flex.OperationContext(d), &getInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -269,15 +274,18 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isInsGrpKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(flex.OperationContext(d), instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		return healthError
 	}
 
-	response, err := sess.DeleteInstanceGroupManagerPolicy(&deleteInstanceGroupManagerPolicyOptions)
+	response, err := sess.DeleteInstanceGroupManagerPolicyWithContext(flex.OperationContext(d), &deleteInstanceGroupManagerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -312,7 +320,7 @@ func resourceIBMISInstanceGroupManagerPolicyExists(d *schema.ResourceData, meta
 		InstanceGroupID:        &instanceGroupID,
 	}
 
-	_, response, err := sess.GetInstanceGroupManagerPolicy(&getInstanceGroupManagerPolicyOptions)
+	_, response, err := sess.GetInstanceGroupManagerPolicyWithContext(flex.OperationContext(d), &getInstanceGroupManagerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
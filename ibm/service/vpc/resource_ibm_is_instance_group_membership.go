@@ -176,7 +176,7 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 		InstanceGroupID: &instanceGroupID,
 	}
 
-	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
+	instanceGroupMembership, response, err := sess.GetInstanceGroupMembershipWithContext(flex.OperationContext(d), &getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
 		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response)
 	}
@@ -204,7 +204,7 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 				return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupMembershipPatch: %s", err)
 			}
 			updateInstanceGroupMembershipOptions.InstanceGroupMembershipPatch = instanceGroupMembershipPatch
-			_, response, err := sess.UpdateInstanceGroupMembership(&updateInstanceGroupMembershipOptions)
+			_, response, err := sess.UpdateInstanceGroupMembershipWithContext(flex.OperationContext(d), &updateInstanceGroupMembershipOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error updating InstanceGroup Membership: %s\n%s", err, response)
 			}
@@ -230,7 +230,7 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 		ID:              &instanceGroupMembershipID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
+	instanceGroupMembership, response, err := sess.GetInstanceGroupMembershipWithContext(flex.OperationContext(d), &getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -287,7 +287,7 @@ func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta int
 		ID:              &instanceGroupMembershipID,
 		InstanceGroupID: &instanceGroupID,
 	}
-	response, err := sess.DeleteInstanceGroupMembership(&deleteInstanceGroupMembershipOptions)
+	response, err := sess.DeleteInstanceGroupMembershipWithContext(flex.OperationContext(d), &deleteInstanceGroupMembershipOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -217,7 +217,9 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 		createInstanceNetworkInterfaceOptions.SetName(name.(string))
 	}
 	if primary_ipv4, ok := d.GetOk(isInstanceNicPrimaryIpv4Address); ok {
-		createInstanceNetworkInterfaceOptions.SetPrimaryIpv4Address(primary_ipv4.(string))
+		createInstanceNetworkInterfaceOptions.SetPrimaryIP(&vpcv1.NetworkInterfaceIPPrototype{
+			Address: core.StringPtr(primary_ipv4.(string)),
+		})
 	}
 
 	if secgrpintf, ok := d.GetOk(isInstanceNicSecurityGroups); ok {
@@ -235,8 +237,11 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_network_interface_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.ResourceLocks.Lock(context, isNICKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -261,7 +266,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 			ID:                 &floating_ip_id,
 		}
 
-		_, response, err := vpcClient.AddInstanceNetworkInterfaceFloatingIP(addInstanceNetworkInterfaceFloatingIPOptions)
+		_, response, err := vpcClient.AddInstanceNetworkInterfaceFloatingIPWithContext(flex.OperationContext(d), addInstanceNetworkInterfaceFloatingIPOptions)
 
 		if err != nil {
 			d.Set(isInstanceNicFloatingIP, "")
@@ -318,8 +323,10 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 	if err = d.Set(isInstanceNicName, *networkInterface.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
-	if err = d.Set(isInstanceNicPrimaryIpv4Address, *networkInterface.PrimaryIpv4Address); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ipv4_address: %s", err))
+	if networkInterface.PrimaryIP != nil && networkInterface.PrimaryIP.Address != nil {
+		if err = d.Set(isInstanceNicPrimaryIpv4Address, *networkInterface.PrimaryIP.Address); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ipv4_address: %s", err))
+		}
 	}
 	if networkInterface.SecurityGroups != nil && len(networkInterface.SecurityGroups) != 0 {
 		secgrpList := []string{}
@@ -428,7 +435,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					SecurityGroupID: &add[i],
 					ID:              &network_interface_id,
 				}
-				_, response, err := vpcClient.CreateSecurityGroupTargetBinding(createsgnicoptions)
+				_, response, err := vpcClient.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), createsgnicoptions)
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
 				}
@@ -445,7 +452,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					SecurityGroupID: &remove[i],
 					ID:              &network_interface_id,
 				}
-				response, err := vpcClient.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
+				response, err := vpcClient.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deletesgnicoptions)
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
 				}
@@ -459,8 +466,11 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_network_interface_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		unlock, err := conns.ResourceLocks.Lock(context, isNICKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
@@ -475,7 +485,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 		network_interface_id := parts[1]
 		if floating_ip_id_new == nil || floating_ip_id_new.(string) == "" {
 			removeInstanceNetworkInterfaceFloatingIPOptions := vpcClient.NewRemoveInstanceNetworkInterfaceFloatingIPOptions(instance_id, network_interface_id, floating_ip_id_old.(string))
-			response, err := vpcClient.RemoveInstanceNetworkInterfaceFloatingIP(removeInstanceNetworkInterfaceFloatingIPOptions)
+			response, err := vpcClient.RemoveInstanceNetworkInterfaceFloatingIPWithContext(flex.OperationContext(d), removeInstanceNetworkInterfaceFloatingIPOptions)
 			if err != nil {
 				if response.StatusCode == 404 {
 					log.Println("[DEBUG] The specified floating IP address is not associated with the network interface with the specified identifier. ", err.Error())
@@ -488,7 +498,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 			getFloatingIPOptions := &vpcv1.GetFloatingIPOptions{
 				ID: &floating_ip_id,
 			}
-			floatingip, response, err := vpcClient.GetFloatingIP(getFloatingIPOptions)
+			floatingip, response, err := vpcClient.GetFloatingIPWithContext(flex.OperationContext(d), getFloatingIPOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					d.SetId("")
@@ -512,7 +522,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				ID:                 &floating_ip_id,
 			}
 
-			_, response, err = vpcClient.AddInstanceNetworkInterfaceFloatingIP(addInstanceNetworkInterfaceFloatingIPOptions)
+			_, response, err = vpcClient.AddInstanceNetworkInterfaceFloatingIPWithContext(flex.OperationContext(d), addInstanceNetworkInterfaceFloatingIPOptions)
 
 			if err != nil {
 				d.Set(isInstanceNicFloatingIP, "")
@@ -548,8 +558,11 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_network_interface_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.ResourceLocks.Lock(context, isNICKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
@@ -587,7 +600,7 @@ func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeo
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -602,7 +615,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 		getInstanceNetworkInterfaceOptions.SetInstanceID(parts[0])
 		getInstanceNetworkInterfaceOptions.SetID(parts[1])
 
-		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
+		networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getInstanceNetworkInterfaceOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
 		}
@@ -627,7 +640,7 @@ func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -642,7 +655,7 @@ func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *s
 		getInstanceNetworkInterfaceOptions.SetInstanceID(parts[0])
 		getInstanceNetworkInterfaceOptions.SetID(parts[1])
 
-		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
+		networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getInstanceNetworkInterfaceOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return networkInterface, isNetworkInterfaceDeleted, nil
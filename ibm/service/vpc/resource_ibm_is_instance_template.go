@@ -49,6 +49,10 @@ const (
 	isInstanceTemplateVolumeDeleteOnInstanceDelete = "delete_volume_on_instance_delete"
 )
 
+// isInstanceTemplateVolAttachmentNameValidator identifies the validator of the names of the volume
+// attachments, the name of the template is validated by the validator of name
+const isInstanceTemplateVolAttachmentNameValidator = "volume_attachments.name"
+
 func ResourceIBMISInstanceTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMisInstanceTemplateCreate,
@@ -76,7 +80,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     false,
-				ValidateFunc: validate.ValidateISName,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateName),
 				Description:  "Instance Template name",
 			},
 
@@ -156,7 +160,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 						isInstanceTemplateVolAttachmentName: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateVolAttachmentName),
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateVolAttachmentNameValidator),
 							Description:  "The user-defined name for this volume attachment.",
 						},
 						isInstanceTemplateVolAttVol: {
@@ -334,6 +338,26 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				},
 			},
 
+			isInstanceMetadataServiceEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Indicates whether the metadata service endpoint is available to the virtual server instance",
+			},
+
+			isInstanceDefaultTrustedProfile: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The default trusted profile to be used when initializing the virtual server instance",
+				Elem: &schema.Resource{
+					Schema: instanceDefaultTrustedProfileSchema(),
+				},
+			},
+
 			isInstanceTemplateResourceGroup: {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -375,7 +399,13 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
-			Identifier:                 isInstanceTemplateVolAttachmentName,
+			Identifier:                 isInstanceTemplateName,
+			ValidateFunctionIdentifier: validate.ISName,
+			Type:                       validate.TypeString,
+			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceTemplateVolAttachmentNameValidator,
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
 			Type:                       validate.TypeString,
 			Required:                   true,
@@ -540,10 +570,10 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 	// Handle volume attachments
 	if volsintf, ok := d.GetOk(isInstanceTemplateVolumeAttachments); ok {
 		vols := volsintf.([]interface{})
-		var intfs []vpcv1.VolumeAttachmentPrototypeInstanceContext
+		var intfs []vpcv1.VolumeAttachmentPrototype
 		for _, resource := range vols {
 			vol := resource.(map[string]interface{})
-			volInterface := &vpcv1.VolumeAttachmentPrototypeInstanceContext{}
+			volInterface := &vpcv1.VolumeAttachmentPrototype{}
 			deleteVolBool := vol[isInstanceTemplateVolumeDeleteOnInstanceDelete].(bool)
 			volInterface.DeleteVolumeOnInstanceDelete = &deleteVolBool
 			attachmentnamestr := vol[isInstanceTemplateVolAttachmentName].(string)
@@ -551,7 +581,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 			volIdStr := vol[isInstanceTemplateVolAttVol].(string)
 
 			if volIdStr != "" {
-				volInterface.Volume = &vpcv1.VolumeAttachmentVolumePrototypeInstanceContextVolumeIdentity{
+				volInterface.Volume = &vpcv1.VolumeAttachmentPrototypeVolumeVolumeIdentity{
 					ID: &volIdStr,
 				}
 			} else {
@@ -560,7 +590,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 				profileName := newvol[isInstanceTemplateVolAttVolProfile].(string)
 				capacity := int64(newvol[isInstanceTemplateVolAttVolCapacity].(int))
 
-				volPrototype := &vpcv1.VolumeAttachmentVolumePrototypeInstanceContextVolumePrototypeInstanceContext{
+				volPrototype := &vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContext{
 					Profile: &vpcv1.VolumeProfileIdentity{
 						Name: &profileName,
 					},
@@ -626,7 +656,9 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 		if IPAddress, ok := primnic[isInstanceTemplateNicPrimaryIpv4Address]; ok {
 			if PrimaryIpv4Address := IPAddress.(string); PrimaryIpv4Address != "" {
-				primnicobj.PrimaryIpv4Address = &PrimaryIpv4Address
+				primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+					Address: &PrimaryIpv4Address,
+				}
 			}
 		}
 	}
@@ -670,7 +702,9 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 			}
 			if IPAddress, ok := nic[isInstanceTemplateNicPrimaryIpv4Address]; ok {
 				if PrimaryIpv4Address := IPAddress.(string); PrimaryIpv4Address != "" {
-					nwInterface.PrimaryIpv4Address = &PrimaryIpv4Address
+					nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototype{
+						Address: &PrimaryIpv4Address,
+					}
 				}
 			}
 			intfs = append(intfs, *nwInterface)
@@ -706,11 +740,19 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	}
 
+	if metadataService := instanceExpandMetadataService(d); metadataService != nil {
+		instanceproto.MetadataService = metadataService
+	}
+
+	if defaultTrustedProfile := instanceExpandDefaultTrustedProfile(d); defaultTrustedProfile != nil {
+		instanceproto.DefaultTrustedProfile = defaultTrustedProfile
+	}
+
 	options := &vpcv1.CreateInstanceTemplateOptions{
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
+	instanceIntf, response, err := sess.CreateInstanceTemplateWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
 	}
@@ -727,7 +769,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
 		ID: &ID,
 	}
-	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
+	instanceIntf, response, err := instanceC.GetInstanceTemplateWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
 	}
@@ -744,6 +786,20 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 		d.Set(isInstanceTotalVolumeBandwidth, int(*instance.TotalVolumeBandwidth))
 	}
 
+	if instance.MetadataService != nil {
+		d.Set(isInstanceMetadataServiceEnabled, instance.MetadataService.Enabled)
+	}
+
+	var defaultTrustedProfileList []map[string]interface{}
+	if instance.DefaultTrustedProfile != nil {
+		if target, ok := instance.DefaultTrustedProfile.Target.(*vpcv1.TrustedProfileIdentity); ok {
+			defaultTrustedProfileList = instanceFlattenDefaultTrustedProfile(d, target.ID, target.CRN, instance.DefaultTrustedProfile.AutoLink)
+		}
+	}
+	if err = d.Set(isInstanceDefaultTrustedProfile, defaultTrustedProfileList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting default_trusted_profile: %s", err)
+	}
+
 	var placementTargetMap map[string]interface{}
 	if instance.PlacementTarget != nil {
 		placementTargetMap = resourceIbmIsInstanceTemplateInstancePlacementTargetPrototypeToMap(*instance.PlacementTarget.(*vpcv1.InstancePlacementTargetPrototype))
@@ -756,8 +812,8 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 		primaryNicList := make([]map[string]interface{}, 0)
 		currentPrimNic := map[string]interface{}{}
 		currentPrimNic[isInstanceTemplateNicName] = *instance.PrimaryNetworkInterface.Name
-		if instance.PrimaryNetworkInterface.PrimaryIpv4Address != nil {
-			currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIpv4Address
+		if address := networkInterfaceIPPrototypeAddress(instance.PrimaryNetworkInterface.PrimaryIP); address != nil {
+			currentPrimNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 		}
 		subInf := instance.PrimaryNetworkInterface.Subnet
 		subnetIdentity := subInf.(*vpcv1.SubnetIdentity)
@@ -783,8 +839,8 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 		for _, intfc := range instance.NetworkInterfaces {
 			currentNic := map[string]interface{}{}
 			currentNic[isInstanceTemplateNicName] = *intfc.Name
-			if intfc.PrimaryIpv4Address != nil {
-				currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *intfc.PrimaryIpv4Address
+			if address := networkInterfaceIPPrototypeAddress(intfc.PrimaryIP); address != nil {
+				currentNic[isInstanceTemplateNicPrimaryIpv4Address] = *address
 			}
 			if intfc.AllowIPSpoofing != nil {
 				currentNic[isInstanceTemplateNicAllowIPSpoofing] = *intfc.AllowIPSpoofing
@@ -827,7 +883,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 			newVolumeArr := []map[string]interface{}{}
 			newVolume := map[string]interface{}{}
 			volumeIntf := volume.Volume
-			volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentVolumePrototypeInstanceContext)
+			volumeInst := volumeIntf.(*vpcv1.VolumeAttachmentPrototypeVolume)
 			if volumeInst.ID != nil {
 				volumeAttach[isInstanceTemplateVolAttVol] = *volumeInst.ID
 			}
@@ -917,7 +973,7 @@ func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updnetoptions.InstanceTemplatePatch = instanceTemplatePatch
 
-		_, _, err = instanceC.UpdateInstanceTemplate(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceTemplateWithContext(flex.OperationContext(d), updnetoptions)
 		if err != nil {
 			return err
 		}
@@ -934,7 +990,7 @@ func instanceTemplateDelete(d *schema.ResourceData, meta interface{}, ID string)
 	deleteinstanceTemplateOptions := &vpcv1.DeleteInstanceTemplateOptions{
 		ID: &ID,
 	}
-	_, err = instanceC.DeleteInstanceTemplate(deleteinstanceTemplateOptions)
+	_, err = instanceC.DeleteInstanceTemplateWithContext(flex.OperationContext(d), deleteinstanceTemplateOptions)
 	if err != nil {
 		return err
 	}
@@ -949,7 +1005,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
 		ID: &ID,
 	}
-	_, response, err := instanceC.GetInstanceTemplate(getinsOptions)
+	_, response, err := instanceC.GetInstanceTemplateWithContext(flex.OperationContext(d), getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -958,3 +1014,15 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 	}
 	return true, nil
 }
+
+// networkInterfaceIPPrototypeAddress returns the address of the primary ip of a network interface
+// prototype, or nil when the address is selected by the subnet
+func networkInterfaceIPPrototypeAddress(ip vpcv1.NetworkInterfaceIPPrototypeIntf) *string {
+	switch ip := ip.(type) {
+	case *vpcv1.NetworkInterfaceIPPrototype:
+		return ip.Address
+	case *vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext:
+		return ip.Address
+	}
+	return nil
+}
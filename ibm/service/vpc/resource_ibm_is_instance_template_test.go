@@ -45,6 +45,46 @@ func TestAccIBMISInstanceTemplate_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceTemplate_metadataService(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("tf-testvpc%d", randInt)
+	subnetName := fmt.Sprintf("tf-testsubnet%d", randInt)
+	templateName := fmt.Sprintf("tf-testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("tf-testsshkey%d", randInt)
+	profileName := fmt.Sprintf("tf-testprofile%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceTemplateMetadataServiceConfig(vpcName, subnetName, sshKeyName, publicKey, profileName, templateName, "crn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "name", templateName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "metadata_service_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.instancetemplate1", "default_trusted_profile.0.target", "ibm_iam_trusted_profile.profile", "crn"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "default_trusted_profile.0.auto_link", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceTemplateMetadataServiceConfig(vpcName, subnetName, sshKeyName, publicKey, profileName, templateName, "id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.instancetemplate1", "default_trusted_profile.0.target", "ibm_iam_trusted_profile.profile", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstanceTemplate_WithVolumeAttachment(t *testing.T) {
 	randInt := acctest.RandIntRange(10, 100)
 
@@ -185,3 +225,49 @@ func testAccCheckIBMISInstanceTemplateWithVolume(vpcName, subnetName, sshKeyName
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, volAttachName)
 
 }
+
+func testAccCheckIBMISInstanceTemplateMetadataServiceConfig(vpcName, subnetName, sshKeyName, publicKey, profileName, templateName, target string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_iam_trusted_profile" "profile" {
+	  name = "%s"
+	}
+
+	data "ibm_is_images" "is_images" {
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%s"
+	   image   = data.ibm_is_images.is_images.images.0.id
+	   profile = "bx2-8x32"
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	   metadata_service_enabled = true
+	   default_trusted_profile {
+		 target    = ibm_iam_trusted_profile.profile.%s
+		 auto_link = true
+	   }
+	 }
+	`, vpcName, subnetName, sshKeyName, publicKey, profileName, templateName, target)
+}
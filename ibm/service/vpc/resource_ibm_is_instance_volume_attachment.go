@@ -10,7 +10,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -280,7 +279,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		}
 		var snapCapacity int64
 		if volSnapshotStr != "" {
-			snapshotGet, _, err := sess.GetSnapshot(&vpcv1.GetSnapshotOptions{
+			snapshotGet, _, err := sess.GetSnapshotWithContext(flex.OperationContext(d), &vpcv1.GetSnapshotOptions{
 				ID: &volSnapshotStr,
 			})
 			if err != nil {
@@ -332,10 +331,13 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isInstanceKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachment(instanceVolAttproto)
+	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachmentWithContext(flex.OperationContext(d), instanceVolAttproto)
 	if err != nil {
 		log.Printf("[DEBUG] Instance volume attachment create err %s\n%s", err, response)
 		return fmt.Errorf("[ERROR] Error while attaching volume for instance %s: %q", instanceId, err)
@@ -380,7 +382,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 		InstanceID: &instanceId,
 		ID:         &id,
 	}
-	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachment(getinsVolAttOptions)
+	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(flex.OperationContext(d), getinsVolAttOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -412,7 +414,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 	getVolOptions := &vpcv1.GetVolumeOptions{
 		ID: &volId,
 	}
-	volumeDetail, _, err := instanceC.GetVolume(getVolOptions)
+	volumeDetail, _, err := instanceC.GetVolumeWithContext(flex.OperationContext(d), getVolOptions)
 	if err != nil || volumeDetail == nil {
 		return fmt.Errorf("[ERROR] Error while getting volume details of volume %s ", id)
 	}
@@ -465,7 +467,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updateInstanceVolAttOptions.VolumeAttachmentPatch = volAttNamePatchModelAsPatch
 
-		instanceVolAttUpdate, response, err := instanceC.UpdateInstanceVolumeAttachment(updateInstanceVolAttOptions)
+		instanceVolAttUpdate, response, err := instanceC.UpdateInstanceVolumeAttachmentWithContext(flex.OperationContext(d), updateInstanceVolAttOptions)
 		if err != nil || instanceVolAttUpdate == nil {
 			log.Printf("[DEBUG] Instance volume attachment updation err %s\n%s", err, response)
 			return err
@@ -486,7 +488,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VolumePatch: %s", err)
 		}
 		voloptions.VolumePatch = volumePatch
-		_, response, err := instanceC.UpdateVolume(voloptions)
+		_, response, err := instanceC.UpdateVolumeWithContext(flex.OperationContext(d), voloptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume name : %s\n%s", err, response)
 		}
@@ -504,7 +506,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &insId,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", insId, err, response)
 		}
@@ -515,7 +517,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &insId,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", insId, err, response)
 			}
@@ -547,11 +549,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeProfilePatch: %s", err)
 		}
 		updateVolumeProfileOptions.VolumePatch = volumeProfilePatch
-		_, response, err = instanceC.UpdateVolume(updateVolumeProfileOptions)
+		_, response, err = instanceC.UpdateVolumeWithContext(flex.OperationContext(d), updateVolumeProfileOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume profile/iops: %s\n%s", err, response)
 		}
-		isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		isWaitForVolumeAvailable(flex.OperationContext(d), instanceC, volId, d.Timeout(schema.TimeoutCreate))
 	}
 
 	// capacity update
@@ -561,7 +563,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getvolumeoptions := &vpcv1.GetVolumeOptions{
 			ID: &volId,
 		}
-		vol, response, err := instanceC.GetVolume(getvolumeoptions)
+		vol, response, err := instanceC.GetVolumeWithContext(flex.OperationContext(d), getvolumeoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
 		}
@@ -573,7 +575,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &instanceId,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", instanceId, err, response)
 		}
@@ -583,7 +585,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &instanceId,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
 			}
@@ -601,11 +603,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeCapacityPatchModel: %s", err)
 		}
 		updateVolumeOptions.VolumePatch = volumeCapacityPatch
-		_, response, err = instanceC.UpdateVolume(updateVolumeOptions)
+		_, response, err = instanceC.UpdateVolumeWithContext(flex.OperationContext(d), updateVolumeOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating volume capacity: %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(flex.OperationContext(d), instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -634,10 +636,13 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isInstanceKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
+	_, err = instanceC.DeleteInstanceVolumeAttachmentWithContext(flex.OperationContext(d), deleteInstanceVolAttOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) : %q", id, instanceId, err)
 	}
@@ -650,11 +655,11 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		deleteVolumeOptions := &vpcv1.DeleteVolumeOptions{
 			ID: &volId,
 		}
-		response, err := instanceC.DeleteVolume(deleteVolumeOptions)
+		response, err := instanceC.DeleteVolumeWithContext(flex.OperationContext(d), deleteVolumeOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error while deleting volume : %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForVolumeDeleted(flex.OperationContext(d), instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -704,7 +709,7 @@ func instanceVolAttExists(d *schema.ResourceData, meta interface{}, instanceId,
 		InstanceID: &instanceId,
 		ID:         &id,
 	}
-	_, response, err := instanceC.GetInstanceVolumeAttachment(getinsvolattOptions)
+	_, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(flex.OperationContext(d), getinsvolattOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -223,7 +223,7 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 			ID: &rg,
 		}
 	}
-	ipSec, response, err := sess.CreateIpsecPolicy(options)
+	ipSec, response, err := sess.CreateIpsecPolicyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
 	}
@@ -246,7 +246,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
 		ID: &id,
 	}
-	ipSec, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
+	ipSec, response, err := sess.GetIpsecPolicyWithContext(flex.OperationContext(d), getIpsecPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -331,7 +331,7 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		options.IPsecPolicyPatch = ipsecPolicyPatch
 
-		_, response, err := sess.UpdateIpsecPolicy(options)
+		_, response, err := sess.UpdateIpsecPolicyWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
 		}
@@ -353,7 +353,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
+	_, response, err := sess.GetIpsecPolicyWithContext(flex.OperationContext(d), getIpsecPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -364,7 +364,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
+	response, err = sess.DeleteIpsecPolicyWithContext(flex.OperationContext(d), deleteIpsecPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
 	}
@@ -386,7 +386,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
+	_, response, err := sess.GetIpsecPolicyWithContext(flex.OperationContext(d), getIpsecPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -61,7 +61,7 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -158,6 +158,13 @@ func ResourceIBMISLB() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_lb", "tag")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isLBResourceGroup: {
 				Type:     schema.TypeString,
@@ -341,27 +348,27 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		options.Profile = loadBalancerProfileIdentityModel
 	} else {
 
-		dataPath := &vpcv1.LoadBalancerLoggingDatapath{
+		dataPath := &vpcv1.LoadBalancerLoggingDatapathPrototype{
 			Active: &isLogging,
 		}
-		loadBalancerLogging := &vpcv1.LoadBalancerLogging{
+		loadBalancerLogging := &vpcv1.LoadBalancerLoggingPrototype{
 			Datapath: dataPath,
 		}
 		options.Logging = loadBalancerLogging
 	}
 
-	lb, response, err := sess.CreateLoadBalancer(options)
+	lb, response, err := sess.CreateLoadBalancerWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Load Balancer err %s\n%s", err, response)
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
+	if _, ok := d.GetOk(isLBTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
@@ -391,7 +398,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &id,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -474,6 +481,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isLBTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -526,11 +534,11 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isLBTags) {
+	if d.HasChange(isLBTags) || d.HasChange(flex.TagsAll) {
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
 		}
@@ -554,7 +562,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
-		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
+		_, response, err := sess.UpdateLoadBalancerWithContext(flex.OperationContext(d), updateLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
 		}
@@ -563,10 +571,10 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		updateLoadBalancerOptions := &vpcv1.UpdateLoadBalancerOptions{
 			ID: &id,
 		}
-		dataPath := &vpcv1.LoadBalancerLoggingDatapath{
+		dataPath := &vpcv1.LoadBalancerLoggingDatapathPatch{
 			Active: &isLogging,
 		}
-		loadBalancerLogging := &vpcv1.LoadBalancerLogging{
+		loadBalancerLogging := &vpcv1.LoadBalancerLoggingPatch{
 			Datapath: dataPath,
 		}
 		loadBalancerPatchModel := &vpcv1.LoadBalancerPatch{
@@ -578,7 +586,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
-		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
+		_, response, err := sess.UpdateLoadBalancerWithContext(flex.OperationContext(d), updateLoadBalancerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
 		}
@@ -591,11 +599,11 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{}
 				createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 				createSecurityGroupTargetBindingOptions.ID = &id
-				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+				_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), createSecurityGroupTargetBindingOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -608,7 +616,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					SecurityGroupID: &securityGroupID,
 					ID:              &id,
 				}
-				_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+				_, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupTargetOptions)
 				if err != nil {
 					if response != nil && response.StatusCode == 404 {
 						continue
@@ -616,11 +624,11 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					return fmt.Errorf("[ERROR] Error Getting Security Group Target for this load balancer (%s): %s\n%s", securityGroupID, err, response)
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, id)
-				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+				response, err = sess.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -651,7 +659,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	_, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -663,11 +671,11 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
+	response, err = sess.DeleteLoadBalancerWithContext(flex.OperationContext(d), deleteLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting vpc load balancer : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -675,28 +683,28 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBDeleting},
 		Target:     []string{isLBDeleted, "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
+		Refresh:    isLBDeleteRefreshFunc(ctx, lbc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLBDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb delete function here")
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := lbc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
@@ -723,7 +731,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	_, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -733,28 +741,28 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	return true, nil
 }
 
-func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
 		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBRefreshFunc(sess, lbId),
+		Refresh:    isLBRefreshFunc(ctx, sess, lbId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
+func isLBRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
@@ -4,12 +4,12 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -233,10 +233,13 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, connLimit, httpStatusCode)
+	err = lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, connLimit, httpStatusCode)
 	if err != nil {
 		return err
 	}
@@ -258,7 +261,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	getlboptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getlboptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getlboptions)
 
 	if err != nil || lb == nil {
 		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
@@ -305,21 +308,21 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	lbListener, response, err := sess.CreateLoadBalancerListener(options)
+	lbListener, response, err := sess.CreateLoadBalancerListenerWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(flex.OperationContext(d), sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", lbID, err)
 	}
@@ -328,29 +331,29 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	return nil
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
 		Target:     []string{isLBListenerProvisioningDone, ""},
-		Refresh:    isLBListenerRefreshFunc(sess, lbID, lbListenerID),
+		Refresh:    isLBListenerRefreshFunc(ctx, sess, lbID, lbListenerID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
+func isLBListenerRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lblis, response, err := sess.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Listener: %s\n%s", err, response)
 		}
@@ -390,7 +393,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	lbListener, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+	lbListener, response, err := sess.GetLoadBalancerListenerWithContext(flex.OperationContext(d), getLoadBalancerListenerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -431,7 +434,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -555,26 +558,29 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerWithContext(flex.OperationContext(d), updateLoadBalancerListenerOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(flex.OperationContext(d), sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -594,8 +600,11 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerDelete(d, meta, lbID, lbListenerID)
 	if err != nil {
@@ -614,7 +623,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+	_, response, err := sess.GetLoadBalancerListenerWithContext(flex.OperationContext(d), getLoadBalancerListenerOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -623,7 +632,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		return fmt.Errorf("[ERROR] Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -631,15 +640,15 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+	response, err = sess.DeleteLoadBalancerListenerWithContext(flex.OperationContext(d), deleteLoadBalancerListenerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(flex.OperationContext(d), sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be active: %s", lbID, err)
 	}
@@ -648,28 +657,28 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerDeleting, "delete_pending"},
 		Target:     []string{isLBListenerDeleted, ""},
-		Refresh:    isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
+		Refresh:    isLBListenerDeleteRefreshFunc(ctx, lbc, lbID, lbListenerID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
+func isLBListenerDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lbLis, response, err := lbc.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lbLis, response, err := lbc.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
@@ -707,7 +716,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+	_, response, err := sess.GetLoadBalancerListenerWithContext(flex.OperationContext(d), getLoadBalancerListenerOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -10,7 +10,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -472,51 +471,54 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
+	policy, response, err := sess.CreateLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyPending},
 		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLbRefreshFunc(vpc, id),
+		Refresh:    isLbRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -529,21 +531,21 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
 		Target:     []string{isLBListenerProvisioningDone},
-		Refresh:    isLbListenerPolicyRefreshFunc(vpc, id),
+		Refresh:    isLbListenerPolicyRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -561,7 +563,7 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 			ID:             &policyID,
 		}
 
-		policy, _, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, _, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			return policy, "", err
@@ -628,7 +630,7 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), getLbListenerPolicyOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -775,20 +777,23 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), &updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -809,8 +814,11 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
 	if err != nil {
@@ -836,7 +844,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), getLbListenerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -850,37 +858,37 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+	response, err = sess.DeleteLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), deleteLbListenerPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in lbListenerPolicyDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
 		Target:     []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
-		Refresh:    isLbListenerPolicyDeleteRefreshFunc(vpc, id),
+		Refresh:    isLbListenerPolicyDeleteRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
@@ -900,7 +908,7 @@ func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.S
 		}
 
 		//Getting lb listener policy
-		policy, response, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, response, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -927,7 +935,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	}
 
 	//Getting lb listener policy
-	policy, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+	policy, response, err := sess.GetLoadBalancerListenerPolicyWithContext(flex.OperationContext(d), getLbListenerPolicyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -959,7 +967,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 			}
 
 			//Getting lb listener policy rule
-			rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+			rule, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), getLbListenerPolicyRuleOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					d.SetId("")
@@ -1013,7 +1021,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
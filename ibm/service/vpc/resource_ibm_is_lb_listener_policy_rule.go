@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -262,23 +263,26 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
+	rule, response, err := sess.CreateLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -286,28 +290,28 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRulePending},
 		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLoadbalancerRefreshFunc(vpc, id),
+		Refresh:    isLoadbalancerRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLoadbalancerRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -320,21 +324,21 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
 		Target:     []string{isLBListenerPolicyRuleProvisioningDone},
-		Refresh:    isLbListenerPolicyRuleRefreshFunc(vpc, id),
+		Refresh:    isLbListenerPolicyRuleRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRuleRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
@@ -354,7 +358,7 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 			ID:             &ruleID,
 		}
 
-		rule, _, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, _, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			return rule, "", err
@@ -423,7 +427,7 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), getLbListenerPolicyRuleOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -499,21 +503,24 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+		_, response, err := sess.UpdateLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), &updatePolicyRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -535,8 +542,11 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	ruleID := parts[3]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
 	if err != nil {
@@ -564,7 +574,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	}
 
 	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+	_, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), getLbListenerPolicyRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -579,31 +589,31 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 		PolicyID:       &policyID,
 		ID:             &ID,
 	}
-	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+	response, err = sess.DeleteLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), deleteLbListenerPolicyRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
 		Target:     []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
-		Refresh:    isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id),
+		Refresh:    isLbListenerPolicyRuleDeleteRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isLbListenerPolicyRuleDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
@@ -625,7 +635,7 @@ func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resour
 		}
 
 		//Getting lb listener policy
-		rule, response, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, response, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -653,7 +663,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	}
 
 	//Getting lb listener policy
-	rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+	rule, response, err := sess.GetLoadBalancerListenerPolicyRuleWithContext(flex.OperationContext(d), getLbListenerPolicyRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -675,7 +685,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
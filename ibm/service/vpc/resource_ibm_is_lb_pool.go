@@ -9,7 +9,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -280,10 +279,13 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		healthMonitorPort = int64(hmp.(int))
 	}
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+	err = lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 	if err != nil {
 		return err
 	}
@@ -297,7 +299,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 		return err
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -331,7 +333,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	if pProtocol != "" {
 		options.ProxyProtocol = &pProtocol
 	}
-	lbPool, response, err := sess.CreateLoadBalancerPool(options)
+	lbPool, response, err := sess.CreateLoadBalancerPoolWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
 	}
@@ -339,12 +341,12 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -380,7 +382,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 		ID:             &lbPoolID,
 	}
 
-	lbPool, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	lbPool, response, err := sess.GetLoadBalancerPoolWithContext(flex.OperationContext(d), getLoadBalancerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -427,7 +429,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -526,15 +528,18 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -549,18 +554,18 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
-		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+		_, response, err := sess.UpdateLoadBalancerPoolWithContext(flex.OperationContext(d), updateLoadBalancerPoolOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -580,8 +585,11 @@ func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	lbPoolID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbPoolDelete(d, meta, lbID, lbPoolID)
 	if err != nil {
@@ -600,7 +608,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	_, response, err := sess.GetLoadBalancerPoolWithContext(flex.OperationContext(d), getLoadBalancerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -608,12 +616,12 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		return fmt.Errorf("[ERROR] Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
@@ -622,16 +630,16 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+	response, err = sess.DeleteLoadBalancerPoolWithContext(flex.OperationContext(d), deleteLoadBalancerPoolOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -667,7 +675,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	_, response, err := sess.GetLoadBalancerPoolWithContext(flex.OperationContext(d), getLoadBalancerPoolOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -677,29 +685,29 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return true, nil
 }
 
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
 		Target:     []string{isLBPoolActive, ""},
-		Refresh:    isLBPoolRefreshFunc(sess, lbId, lbPoolId),
+		Refresh:    isLBPoolRefreshFunc(ctx, sess, lbId, lbPoolId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
+func isLBPoolRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := sess.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response)
 		}
@@ -712,29 +720,29 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
 		Target:     []string{isLBPoolDeleteDone, ""},
-		Refresh:    isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
+		Refresh:    isLBPoolDeleteRefreshFunc(ctx, lbc, lbId, lbPoolId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
+func isLBPoolDeleteRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb pool delete function here")
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := lbc.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := lbc.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
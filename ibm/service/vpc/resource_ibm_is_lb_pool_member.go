@@ -4,12 +4,12 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -168,8 +168,11 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 		weight = int64(w.(int))
 	}
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
 	if err != nil {
@@ -184,12 +187,12 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -215,7 +218,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	}
 	options.Weight = &weight
 
-	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
+	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMemberWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
 	}
@@ -223,17 +226,17 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolMemberAvailable(flex.OperationContext(d), sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -241,22 +244,22 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	return nil
 }
 
-func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberAvailable(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"create_pending", "update_pending", "maintenance_pending"},
 		Target:     []string{isLBPoolMemberActive, ""},
-		Refresh:    isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
+		Refresh:    isLBPoolMemberRefreshFunc(ctx, lbc, lbID, lbPoolID, lbPoolMemID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
+func isLBPoolMemberRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
@@ -264,7 +267,7 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 		}
@@ -311,7 +314,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	lbPoolMem, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+	lbPoolMem, response, err := sess.GetLoadBalancerPoolMemberWithContext(flex.OperationContext(d), getlbpmoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -337,7 +340,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
-	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	lb, response, err := sess.GetLoadBalancerWithContext(flex.OperationContext(d), getLoadBalancerOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 	}
@@ -376,21 +379,24 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(flex.OperationContext(d), sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -427,22 +433,22 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
-		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+		_, response, err := sess.UpdateLoadBalancerPoolMemberWithContext(flex.OperationContext(d), updatelbpmoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(flex.OperationContext(d), sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -463,8 +469,11 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	lbPoolMemID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
 	if err != nil {
@@ -485,7 +494,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+	_, response, err := sess.GetLoadBalancerPoolMemberWithContext(flex.OperationContext(d), getlbpmoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -493,17 +502,17 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberAvailable(flex.OperationContext(d), sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -513,22 +522,22 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+	response, err = sess.DeleteLoadBalancerPoolMemberWithContext(flex.OperationContext(d), dellbpmoptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
 
-	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberDeleted(flex.OperationContext(d), sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(flex.OperationContext(d), sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(flex.OperationContext(d), sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
@@ -537,22 +546,22 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 
-func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBPoolMemberDeletePending},
 		Target:     []string{isLBPoolMemberDeleted, ""},
-		Refresh:    isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
+		Refresh:    isDeleteLBPoolMemberRefreshFunc(ctx, lbc, lbID, lbPoolID, lbPoolMemID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
+func isDeleteLBPoolMemberRefreshFunc(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
@@ -560,7 +569,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
@@ -601,7 +610,7 @@ func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+	_, response, err := sess.GetLoadBalancerPoolMemberWithContext(flex.OperationContext(d), getlbpmoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -441,7 +441,7 @@ func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) e
 		NetworkACLID:            &nwACLID,
 		NetworkACLRulePrototype: ruleTemplate,
 	}
-	nwaclRule, response, err := sess.CreateNetworkACLRule(createNetworkAclRuleOptions)
+	nwaclRule, response, err := sess.CreateNetworkACLRuleWithContext(flex.OperationContext(d), createNetworkAclRuleOptions)
 	if err != nil || nwaclRule == nil {
 		return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
 	}
@@ -465,7 +465,7 @@ func resourceIBMISNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) e
 		NetworkACLID: &nwACLID,
 		ID:           &ruleId,
 	}
-	nwaclRule, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+	nwaclRule, response, err := sess.GetNetworkACLRuleWithContext(flex.OperationContext(d), getNetworkAclRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -745,7 +745,7 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLOptionsPatch : %s", err)
 		}
 		updateNetworkACLRuleOptions.NetworkACLRulePatch = updateNetworkACLOptionsPatch
-		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+		_, response, err := sess.UpdateNetworkACLRuleWithContext(flex.OperationContext(d), updateNetworkACLRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Network ACL Rule : %s\n%s", err, response)
 		}
@@ -777,7 +777,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		NetworkACLID: &nwACLId,
 		ID:           &id,
 	}
-	_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+	_, response, err := sess.GetNetworkACLRuleWithContext(flex.OperationContext(d), getNetworkAclRuleOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -791,7 +791,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		NetworkACLID: &nwACLId,
 		ID:           &id,
 	}
-	response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+	response, err = sess.DeleteNetworkACLRuleWithContext(flex.OperationContext(d), deleteNetworkAclRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
 	}
@@ -808,7 +808,7 @@ func nwaclRuleExists(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		NetworkACLID: &nwACLId,
 		ID:           &id,
 	}
-	_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+	_, response, err := sess.GetNetworkACLRuleWithContext(flex.OperationContext(d), getNetworkAclRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
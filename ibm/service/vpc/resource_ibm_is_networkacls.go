@@ -63,7 +63,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -96,6 +96,13 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isNetworkACLCRN: {
 				Type:        schema.TypeString,
@@ -299,15 +306,6 @@ func ResourceIBMISNetworkACLValidator() *validate.ResourceValidator {
 			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isNetworkACLRuleName,
-			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
-			MinValueLength:             1,
-			MaxValueLength:             63})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isNetworkACLRuleDestination,
@@ -426,7 +424,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		NetworkACLPrototype: nwaclTemplate,
 	}
 
-	nwacl, response, err := sess.CreateNetworkACL(options)
+	nwacl, response, err := sess.CreateNetworkACLWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
 	}
@@ -435,17 +433,17 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	nwaclid := *nwacl.ID
 
 	//Remove default rules
-	err = clearRules(sess, nwaclid)
+	err = clearRules(flex.OperationContext(d), sess, nwaclid)
 	if err != nil {
 		return err
 	}
 
-	err = createInlineRules(sess, nwaclid, rules)
+	err = createInlineRules(flex.OperationContext(d), sess, nwaclid, rules)
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *nwacl.CRN)
 		if err != nil {
@@ -473,7 +471,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
 		ID: &id,
 	}
-	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	nwacl, response, err := sess.GetNetworkACLWithContext(flex.OperationContext(d), getNetworkAclOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -493,6 +491,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource network acl (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isNetworkACLTags, tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
 	if len(nwacl.Rules) > 0 {
@@ -621,12 +620,12 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
 		}
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
-		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+		_, response, err := sess.UpdateNetworkACLWithContext(flex.OperationContext(d), updateNetworkACLOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
+	if d.HasChange(isNetworkACLTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string))
 		if err != nil {
@@ -640,12 +639,12 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return err
 		}
 		//Delete all existing rules
-		err = clearRules(sess, id)
+		err = clearRules(flex.OperationContext(d), sess, id)
 		if err != nil {
 			return err
 		}
 		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		err = createInlineRules(flex.OperationContext(d), sess, id, rules)
 		if err != nil {
 			return err
 		}
@@ -673,7 +672,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	_, response, err := sess.GetNetworkACLWithContext(flex.OperationContext(d), getNetworkAclOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -686,7 +685,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
+	response, err = sess.DeleteNetworkACLWithContext(flex.OperationContext(d), deleteNetworkAclOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Network ACL : %s\n%s", err, response)
 	}
@@ -708,7 +707,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	_, response, err := sess.GetNetworkACLWithContext(flex.OperationContext(d), getNetworkAclOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -725,7 +724,7 @@ func checkNetworkACLNil(ptr *int64) int {
 	return int(*ptr)
 }
 
-func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
+func clearRules(ctx context.Context, nwaclC *vpcv1.VpcV1, nwaclid string) error {
 	start := ""
 	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	for {
@@ -735,7 +734,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		if start != "" {
 			listNetworkAclRulesOptions.Start = &start
 		}
-		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
+		rawrules, response, err := nwaclC.ListNetworkACLRulesWithContext(ctx, listNetworkAclRulesOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
 		}
@@ -762,7 +761,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 			deleteNetworkAclRuleOptions.ID = rule.ID
 		}
 
-		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+		response, err := nwaclC.DeleteNetworkACLRuleWithContext(ctx, deleteNetworkAclRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
 		}
@@ -793,7 +792,7 @@ func validateInlineRules(rules []interface{}) error {
 	return nil
 }
 
-func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+func createInlineRules(ctx context.Context, nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
 	before := ""
 
 	for i := 0; i <= len(rules)-1; i++ {
@@ -892,7 +891,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 			NetworkACLID:            &nwaclid,
 			NetworkACLRulePrototype: ruleTemplate,
 		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+		_, response, err := nwaclC.CreateNetworkACLRuleWithContext(ctx, createNetworkAclRuleOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
 		}
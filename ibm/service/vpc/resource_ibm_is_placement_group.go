@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,13 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 			isPlacementGroupAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -193,9 +200,9 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupTags); ok || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) tags: %s", d.Id(), err)
@@ -205,7 +212,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) access tags: %s", d.Id(), err)
@@ -261,19 +268,20 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 	if err = d.Set("resource_type", placementGroup.ResourceType); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
 	}
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroup.CRN, "", isUserTagType)
+	tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *placementGroup.CRN, "", isUserTagType)
 	if err != nil {
 		log.Printf(
 			"Error getting placement group (%s) tags: %s", d.Id(), err)
 	}
 
-	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *placementGroup.CRN, "", isAccessTagType)
+	accesstags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *placementGroup.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error getting placement group (%s) access tags: %s", d.Id(), err)
 	}
 
 	d.Set(isPlacementGroupTags, tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set(isPlacementGroupAccessTags, accesstags)
 	return nil
 }
@@ -309,9 +317,9 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
+	if d.HasChange(isPlacementGroupTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
@@ -320,7 +328,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 
 	if d.HasChange(isPlacementGroupAccessTags) {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -369,7 +377,7 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 				ID: &id,
 			}
 
-			placementGroup, response, err := vpcClient.GetPlacementGroup(getPlacementGroupOptions)
+			placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(flex.OperationContext(d), getPlacementGroupOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return placementGroup, isPlacementGroupDeleteDone, nil
@@ -388,7 +396,7 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
@@ -401,7 +409,7 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 
 			deletePlacementGroupOptions.SetID(id)
 
-			response, err := vpcClient.DeletePlacementGroup(deletePlacementGroupOptions)
+			response, err := vpcClient.DeletePlacementGroupWithContext(flex.OperationContext(d), deletePlacementGroupOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isPlacementGroupResourcesAttached, err
@@ -417,7 +425,7 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -432,7 +440,7 @@ func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -440,7 +448,7 @@ func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.Re
 		getinsOptions := &vpcv1.GetPlacementGroupOptions{
 			ID: &id,
 		}
-		placementGroup, response, err := vpcClient.GetPlacementGroup(getinsOptions)
+		placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(flex.OperationContext(d), getinsOptions)
 		if placementGroup == nil || err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting placementGroup : %s\n%s", err, response)
 		}
@@ -51,7 +51,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -107,6 +107,13 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -214,20 +221,20 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	publicgw, response, err := sess.CreatePublicGateway(options)
+	publicgw, response, err := sess.CreatePublicGatewayWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
@@ -238,27 +245,27 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	return resourceIBMISPublicGatewayRead(d, meta)
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(ctx context.Context, publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isPublicGatewayProvisioning},
 		Target:     []string{isPublicGatewayProvisioningDone, ""},
-		Refresh:    isPublicGatewayRefreshFunc(publicgwC, id),
+		Refresh:    isPublicGatewayRefreshFunc(ctx, publicgwC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isPublicGatewayRefreshFunc(ctx context.Context, publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
+		publicgw, response, err := publicgwC.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
 		}
@@ -280,7 +287,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 		ID: &id,
 	}
-	publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+	publicgw, response, err := sess.GetPublicGatewayWithContext(flex.OperationContext(d), getPublicGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -306,6 +313,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
 	d.Set(isPublicGatewayTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -336,11 +344,11 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange(isPublicGatewayTags) || d.HasChange(flex.TagsAll) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+		publicgw, response, err := sess.GetPublicGatewayWithContext(flex.OperationContext(d), getPublicGatewayOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
 		}
@@ -363,7 +371,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("[ERROR] Error calling asPatch for PublicGatewayPatch: %s", err)
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
-		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
+		_, response, err := sess.UpdatePublicGatewayWithContext(flex.OperationContext(d), updatePublicGatewayOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
 		}
@@ -381,7 +389,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+	_, response, err := sess.GetPublicGatewayWithContext(flex.OperationContext(d), getPublicGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -393,11 +401,11 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
 		ID: &id,
 	}
-	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
+	response, err = sess.DeletePublicGatewayWithContext(flex.OperationContext(d), deletePublicGatewayOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -405,28 +413,28 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(ctx context.Context, pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isPublicGatewayDeleting},
 		Target:     []string{isPublicGatewayDeleted, ""},
-		Refresh:    isPublicGatewayDeleteRefreshFunc(pg, id),
+		Refresh:    isPublicGatewayDeleteRefreshFunc(ctx, pg, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isPublicGatewayDeleteRefreshFunc(ctx context.Context, pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is pubic gateway delete function here")
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		pgw, response, err := pg.GetPublicGateway(getPublicGatewayOptions)
+		pgw, response, err := pg.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
@@ -446,7 +454,7 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
+	_, response, err := sess.GetPublicGatewayWithContext(flex.OperationContext(d), getPublicGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -38,7 +38,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -66,6 +66,13 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isSecurityGroupCRN: {
 				Type:        schema.TypeString,
@@ -167,13 +174,13 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		name = nm.(string)
 		createSecurityGroupOptions.Name = &name
 	}
-	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
+	sg, response, err := sess.CreateSecurityGroupWithContext(flex.OperationContext(d), createSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
@@ -194,7 +201,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &id,
 	}
-	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	group, response, err := sess.GetSecurityGroupWithContext(flex.OperationContext(d), getSecurityGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -208,6 +215,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error getting Security Group tags : %s\n%s", d.Id(), err)
 	}
 	d.Set(isSecurityGroupTags, tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set(isSecurityGroupCRN, *group.CRN)
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
@@ -324,7 +332,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
+	if d.HasChange(isSecurityGroupTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string))
 		if err != nil {
@@ -352,7 +360,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
 		}
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
-		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+		_, response, err := sess.UpdateSecurityGroupWithContext(flex.OperationContext(d), updateSecurityGroupOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
 		}
@@ -370,7 +378,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	_, response, err := sess.GetSecurityGroupWithContext(flex.OperationContext(d), getSecurityGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -385,7 +393,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	for {
 		listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(id)
 
-		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
+		groups, response, err := sess.ListSecurityGroupTargetsWithContext(flex.OperationContext(d), listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
 			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
 		}
@@ -408,7 +416,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			if securityGroupTargetReference != nil && securityGroupTargetReference.ID != nil {
 
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(id, *securityGroupTargetReference.ID)
-				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+				response, err = sess.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
 				}
@@ -420,7 +428,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	deleteSecurityGroupOptions := &vpcv1.DeleteSecurityGroupOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
+	response, err = sess.DeleteSecurityGroupWithContext(flex.OperationContext(d), deleteSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response)
 	}
@@ -438,7 +446,7 @@ func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{})
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	_, response, err := sess.GetSecurityGroupWithContext(flex.OperationContext(d), getSecurityGroupOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -166,7 +166,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	_, response, err := sess.CreateSecurityGroupTargetBinding(options)
+	_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
 	}
@@ -191,7 +191,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	secGroupTarget, response, err := sess.GetSecurityGroupTarget(getSecurityGroupNetworkInterfaceOptions)
+	secGroupTarget, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupNetworkInterfaceOptions)
 	if err != nil || secGroupTarget == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -205,7 +205,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 		InstanceID: &instance_id,
 		ID:         &net_interf_id,
 	}
-	instanceNic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
+	instanceNic, response, err := sess.GetInstanceNetworkInterfaceWithContext(flex.OperationContext(d), getnicoptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s %s\n%s", instance_id, err, response)
 	}
@@ -214,7 +214,9 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 	d.Set(isSGNICAInstanceNwInterfaceID, *instanceNic.ID)
 	d.Set(isSGNICAName, *instanceNic.Name)
 	d.Set(isSGNICAPortSpeed, *instanceNic.PortSpeed)
-	d.Set(isSGNICAPrimaryIPV4Address, *instanceNic.PrimaryIpv4Address)
+	if instanceNic.PrimaryIP != nil && instanceNic.PrimaryIP.Address != nil {
+		d.Set(isSGNICAPrimaryIPV4Address, *instanceNic.PrimaryIP.Address)
+	}
 	d.Set(isSGNICAStatus, *instanceNic.Status)
 	d.Set(isSGNICAType, *instanceNic.Type)
 	if instanceNic.Subnet != nil {
@@ -244,7 +246,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &sgID,
 	}
-	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	sg, response, err := sess.GetSecurityGroupWithContext(flex.OperationContext(d), getSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
 	}
@@ -269,7 +271,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupNetworkInterfaceOptions)
+	_, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -282,7 +284,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	response, err = sess.DeleteSecurityGroupTargetBinding(removeSecurityGroupNetworkInterfaceOptions)
+	response, err = sess.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
 	}
@@ -308,7 +310,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists(d *schema.Resour
 		SecurityGroupID: &sgID,
 		ID:              &nicID,
 	}
-	_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupNetworkInterfaceOptions)
+	_, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -8,7 +8,6 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -238,15 +237,18 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
 		SecurityGroupRulePrototype: sgTemplate,
 	}
 
-	rule, response, err := sess.CreateSecurityGroupRule(options)
+	rule, response, err := sess.CreateSecurityGroupRuleWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
 	}
@@ -290,7 +292,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	sgrule, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	sgrule, response, err := sess.GetSecurityGroupRuleWithContext(flex.OperationContext(d), getSecurityGroupRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -302,7 +304,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
 		ID: &secgrpID,
 	}
-	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	sg, response, err := sess.GetSecurityGroupWithContext(flex.OperationContext(d), getSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
 	}
@@ -415,11 +417,14 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	updateSecurityGroupRuleOptions := sgTemplate
-	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+	_, response, err := sess.UpdateSecurityGroupRuleWithContext(flex.OperationContext(d), updateSecurityGroupRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
 	}
@@ -437,14 +442,17 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	_, response, err := sess.GetSecurityGroupRuleWithContext(flex.OperationContext(d), getSecurityGroupRuleOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -458,7 +466,7 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+	response, err = sess.DeleteSecurityGroupRuleWithContext(flex.OperationContext(d), deleteSecurityGroupRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
 	}
@@ -480,7 +488,7 @@ func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+	_, response, err := sess.GetSecurityGroupRuleWithContext(flex.OperationContext(d), getSecurityGroupRuleOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -111,7 +112,7 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 	createSecurityGroupTargetBindingOptions.ID = &targetID
 
-	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+	sg, response, err := sess.CreateSecurityGroupTargetBindingWithContext(flex.OperationContext(d), createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
 		return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
 	}
@@ -120,7 +121,7 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	crn := sgtarget.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := sgtarget.ID
-		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *lbid, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForLbSgTargetCreateAvailable(flex.OperationContext(d), sess, *lbid, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			return errsgt
 		}
@@ -148,7 +149,7 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 		ID:              &securityGroupTargetID,
 	}
 
-	data, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+	data, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupTargetOptions)
 	if err != nil || data == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -184,7 +185,7 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 		SecurityGroupID: &securityGroupID,
 		ID:              &securityGroupTargetID,
 	}
-	sgt, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+	sgt, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupTargetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -194,7 +195,7 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 	}
 
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
-	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+	response, err = sess.DeleteSecurityGroupTargetBindingWithContext(flex.OperationContext(d), deleteSecurityGroupTargetBindingOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
 	}
@@ -202,7 +203,7 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 	crn := securityGroupTargetReference.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := securityGroupTargetReference.ID
-		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+		_, errsgt := isWaitForLBRemoveAvailable(flex.OperationContext(d), sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
 		if errsgt != nil {
 			return errsgt
 		}
@@ -230,7 +231,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 		ID:              &securityGroupTargetID,
 	}
 
-	_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+	_, response, err := sess.GetSecurityGroupTargetWithContext(flex.OperationContext(d), getSecurityGroupTargetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -242,37 +243,37 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 
 }
 
-func isWaitForLBRemoveAvailable(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBRemoveAvailable(ctx context.Context, sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[INFO] Waiting for load balancer binding (%s) to be removed.", lbId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{isLBProvisioning},
 		Target:         []string{isLBProvisioningDone},
-		Refresh:        isLBRemoveRefreshFunc(sess, sgt, lbId, securityGroupID, securityGroupTargetID),
+		Refresh:        isLBRemoveRefreshFunc(ctx, sess, sgt, lbId, securityGroupID, securityGroupTargetID),
 		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 1,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
+func isLBRemoveRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getSecurityGroupTargetOptions := &vpcv1.GetSecurityGroupTargetOptions{
 			SecurityGroupID: &securityGroupID,
 			ID:              &securityGroupTargetID,
 		}
-		_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+		_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				getlboptions := &vpcv1.GetLoadBalancerOptions{
 					ID: &lbId,
 				}
-				lb, response, err := sess.GetLoadBalancer(getlboptions)
+				lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 				if err != nil {
 					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 				}
@@ -289,28 +290,28 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 	}
 }
 
-func isWaitForLbSgTargetCreateAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbSgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
 		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBSgTargetRefreshFunc(sess, lbId),
+		Refresh:    isLBSgTargetRefreshFunc(ctx, sess, lbId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
+func isLBSgTargetRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
@@ -0,0 +1,693 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareName                     = "name"
+	isShareProfile                  = "profile"
+	isShareZone                     = "zone"
+	isShareSize                     = "size"
+	isShareIops                     = "iops"
+	isShareAccessControlMode        = "access_control_mode"
+	isShareEncryptionKey            = "encryption_key"
+	isShareEncryption               = "encryption"
+	isShareInitialOwner             = "initial_owner"
+	isShareResourceGroup            = "resource_group"
+	isShareSourceShare              = "source_share"
+	isShareReplicationCronSpec      = "replication_cron_spec"
+	isShareReplicationRole          = "replication_role"
+	isShareReplicationStatus        = "replication_status"
+	isShareReplicationStatusReasons = "replication_status_reasons"
+	isShareReplicaShare             = "replica_share"
+	isShareTargets                  = "share_targets"
+	isShareTags                     = "tags"
+	isShareLifecycleState           = "lifecycle_state"
+	isShareDeleted                  = "deleted"
+)
+
+func ResourceIBMIsShare() *schema.Resource {
+	return flex.WithDeletionProtection(&schema.Resource{
+		CreateContext: resourceIBMIsShareCreate,
+		ReadContext:   resourceIBMIsShareRead,
+		UpdateContext: resourceIBMIsShareUpdate,
+		DeleteContext: resourceIBMIsShareDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+				},
+			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsShareValidate(diff)
+				}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			isShareName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareName),
+				Description:  "The unique user-defined name for this file share.",
+			},
+			isShareProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the profile to use for this file share.",
+			},
+			isShareZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone this file share will reside in.",
+			},
+			isShareSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareSize),
+				Description:  "The size of the file share rounded up to the next gigabyte. The size of a replica share is the size of its source share.",
+			},
+			isShareIops: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareIops),
+				Description:  "The maximum input/output operations per second (IOPS) for the file share.",
+			},
+			isShareAccessControlMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", isShareAccessControlMode),
+				Description:  "The access control mode for the share: `security_group` or `vpc`.",
+			},
+			isShareEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the root key to use to wrap the data encryption key for the share.",
+			},
+			isShareEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption used for this file share.",
+			},
+			isShareInitialOwner: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The owner assigned to the file share at creation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gid": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The initial group identifier for the file share.",
+						},
+						"uid": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The initial user identifier for the file share.",
+						},
+					},
+				},
+			},
+			isShareResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use.",
+			},
+			isShareSourceShare: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the source file share, to create this file share as its replica.",
+			},
+			isShareReplicationCronSpec: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The cron specification for the replication schedule of a replica file share.",
+			},
+			isShareTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_share", "tag")},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Tags for the file share",
+			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the file share is created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this file share.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this file share.",
+			},
+			isShareLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the file share.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			isShareReplicaShare: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the replica file share, when this file share is a replication source.",
+			},
+			isShareReplicationRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication role of the file share: `none`, `replica` or `source`.",
+			},
+			isShareReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the file share.",
+			},
+			isShareReplicationStatusReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current replication status (if any).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the status reason.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the status reason.",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link to documentation about this status reason.",
+						},
+					},
+				},
+			},
+			isShareTargets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mount targets for the file share.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this share mount target.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this share mount target.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share mount target.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func ResourceIBMIsShareValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareSize,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "10",
+			MaxValue:                   "32000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareIops,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "100",
+			MaxValue:                   "96000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareAccessControlMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "security_group, vpc"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmISShareResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share", Schema: validateSchema}
+	return &ibmISShareResourceValidator
+}
+
+// resourceIBMIsShareValidate checks that a share only grows, and that the replication schedule is
+// only set for the replica shares, which take their size from their source share
+func resourceIBMIsShareValidate(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" && diff.HasChange(isShareSize) {
+		o, n := diff.GetChange(isShareSize)
+		if n.(int) < o.(int) {
+			return fmt.Errorf("'%s' attribute has a constraint, it supports only expansion and can't be changed from %d to %d.", isShareSize, o.(int), n.(int))
+		}
+	}
+
+	isReplica := validate.NotEquals(isShareSourceShare, "")
+	isNotReplica := validate.Equals(isShareSourceShare, "")
+	if err := validate.CheckRules(diff,
+		validate.ConflictsWhen(isShareSize, validate.And(isReplica, validate.Changed(isShareSize))),
+		validate.RequiredWith(isShareReplicationCronSpec, isReplica),
+		validate.ConflictsWhen(isShareReplicationCronSpec, validate.And(isNotReplica, validate.Changed(isShareReplicationCronSpec))),
+		validate.ConflictsWhen(isShareEncryptionKey, isReplica),
+		validate.ConflictsWhen(isShareInitialOwner, isReplica),
+	); err != nil {
+		return fmt.Errorf("ShareError : %s", err)
+	}
+	return nil
+}
+
+func resourceIBMIsShareCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(isShareName).(string)
+	profile := d.Get(isShareProfile).(string)
+	zone := d.Get(isShareZone).(string)
+	var iops *int64
+	if i, ok := d.GetOk(isShareIops); ok {
+		iops = optionalInt64(i.(int))
+	}
+	var resourceGroup vpcv1.ResourceGroupIdentityIntf
+	if rg, ok := d.GetOk(isShareResourceGroup); ok {
+		rgID := rg.(string)
+		resourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rgID,
+		}
+	}
+
+	var sharePrototype vpcv1.SharePrototypeIntf
+	if sourceShare, ok := d.GetOk(isShareSourceShare); ok {
+		sourceShareID := sourceShare.(string)
+		cronSpec := d.Get(isShareReplicationCronSpec).(string)
+		sharePrototype = &vpcv1.SharePrototypeShareBySourceShare{
+			Name:                &name,
+			Profile:             &vpcv1.ShareProfileIdentity{Name: &profile},
+			Zone:                &vpcv1.ZoneIdentity{Name: &zone},
+			Iops:                iops,
+			ReplicationCronSpec: &cronSpec,
+			ResourceGroup:       resourceGroup,
+			SourceShare:         &vpcv1.ShareIdentity{ID: &sourceShareID},
+		}
+	} else {
+		// size is computed for the replica shares, so it is only known to be missing here
+		v, ok := d.GetOk(isShareSize)
+		if !ok {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating file share: %q is required when %q is not set", isShareSize, isShareSourceShare))
+		}
+		size := int64(v.(int))
+		shareBySize := &vpcv1.SharePrototypeShareBySize{
+			Name:          &name,
+			Profile:       &vpcv1.ShareProfileIdentity{Name: &profile},
+			Zone:          &vpcv1.ZoneIdentity{Name: &zone},
+			Size:          &size,
+			Iops:          iops,
+			ResourceGroup: resourceGroup,
+		}
+		if mode, ok := d.GetOk(isShareAccessControlMode); ok {
+			accessControlMode := mode.(string)
+			shareBySize.AccessControlMode = &accessControlMode
+		}
+		if key, ok := d.GetOk(isShareEncryptionKey); ok {
+			encryptionKey := key.(string)
+			shareBySize.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+				CRN: &encryptionKey,
+			}
+		}
+		if owners, ok := d.GetOk(isShareInitialOwner); ok && len(owners.([]interface{})) > 0 && owners.([]interface{})[0] != nil {
+			owner := owners.([]interface{})[0].(map[string]interface{})
+			shareBySize.InitialOwner = &vpcv1.ShareInitialOwner{
+				Gid: optionalInt64(owner["gid"].(int)),
+				Uid: optionalInt64(owner["uid"].(int)),
+			}
+		}
+		sharePrototype = shareBySize
+	}
+
+	createShareOptions := &vpcv1.CreateShareOptions{
+		SharePrototype: sharePrototype,
+	}
+	share, response, err := vpcClient.CreateShareWithContext(context, createShareOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateShareWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating file share: %s\n%s", err, response))
+	}
+	d.SetId(*share.ID)
+	log.Printf("[INFO] File share : %s", *share.ID)
+
+	_, err = isWaitForShareAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	v := os.Getenv("IC_ENV_TAGS")
+	if _, ok := d.GetOk(isShareTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
+		oldList, newList := d.GetChange(isShareTags)
+		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *share.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource file share (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMIsShareRead(context, d, meta)
+}
+
+// optionalInt64 returns a pointer to the value of an optional integer argument, or nil when
+// it is not set
+func optionalInt64(i int) *int64 {
+	if i == 0 {
+		return nil
+	}
+	v := int64(i)
+	return &v
+}
+
+func resourceIBMIsShareRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	getShareOptions := &vpcv1.GetShareOptions{
+		ID: &id,
+	}
+	share, response, err := vpcClient.GetShareWithContext(context, getShareOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetShareWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting file share (%s): %s\n%s", id, err, response))
+	}
+
+	d.Set(isShareName, share.Name)
+	d.Set(isShareProfile, share.Profile.Name)
+	d.Set(isShareZone, share.Zone.Name)
+	d.Set(isShareSize, flex.IntValue(share.Size))
+	d.Set(isShareIops, flex.IntValue(share.Iops))
+	d.Set(isShareAccessControlMode, share.AccessControlMode)
+	if share.EncryptionKey != nil {
+		d.Set(isShareEncryptionKey, share.EncryptionKey.CRN)
+	}
+	d.Set(isShareEncryption, share.Encryption)
+	if share.ResourceGroup != nil {
+		d.Set(isShareResourceGroup, share.ResourceGroup.ID)
+	}
+	if share.SourceShare != nil {
+		d.Set(isShareSourceShare, share.SourceShare.ID)
+	}
+	d.Set(isShareReplicationCronSpec, share.ReplicationCronSpec)
+	if share.ReplicaShare != nil {
+		d.Set(isShareReplicaShare, share.ReplicaShare.ID)
+	} else {
+		d.Set(isShareReplicaShare, nil)
+	}
+	d.Set(isShareReplicationRole, share.ReplicationRole)
+	d.Set(isShareReplicationStatus, share.ReplicationStatus)
+	statusReasons := make([]map[string]interface{}, 0, len(share.ReplicationStatusReasons))
+	for _, sr := range share.ReplicationStatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      sr.Code,
+			"message":   sr.Message,
+			"more_info": sr.MoreInfo,
+		})
+	}
+	if err = d.Set(isShareReplicationStatusReasons, statusReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting replication_status_reasons: %s", err))
+	}
+	targets := make([]map[string]interface{}, 0, len(share.MountTargets))
+	for _, target := range share.MountTargets {
+		targets = append(targets, map[string]interface{}{
+			"id":            target.ID,
+			"name":          target.Name,
+			"href":          target.Href,
+			"resource_type": target.ResourceType,
+		})
+	}
+	if err = d.Set(isShareTargets, targets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting share_targets: %s", err))
+	}
+	d.Set("created_at", share.CreatedAt.String())
+	d.Set("crn", share.CRN)
+	d.Set("href", share.Href)
+	d.Set(isShareLifecycleState, share.LifecycleState)
+	d.Set("resource_type", share.ResourceType)
+
+	tags, err := flex.GetTagsUsingCRN(meta, *share.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of resource file share (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isShareTags, tags)
+	d.Set(flex.TagsAll, tags)
+
+	return nil
+}
+
+func resourceIBMIsShareUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	sharePatchModel := &vpcv1.SharePatch{}
+	hasChange := false
+	if d.HasChange(isShareName) {
+		name := d.Get(isShareName).(string)
+		sharePatchModel.Name = &name
+		hasChange = true
+	}
+	if d.HasChange(isShareProfile) {
+		profile := d.Get(isShareProfile).(string)
+		sharePatchModel.Profile = &vpcv1.ShareProfileIdentity{
+			Name: &profile,
+		}
+		hasChange = true
+	}
+	if d.HasChange(isShareSize) && d.Get(isShareSourceShare).(string) == "" {
+		size := int64(d.Get(isShareSize).(int))
+		sharePatchModel.Size = &size
+		hasChange = true
+	}
+	if d.HasChange(isShareIops) {
+		sharePatchModel.Iops = optionalInt64(d.Get(isShareIops).(int))
+		hasChange = true
+	}
+	if d.HasChange(isShareAccessControlMode) {
+		accessControlMode := d.Get(isShareAccessControlMode).(string)
+		sharePatchModel.AccessControlMode = &accessControlMode
+		hasChange = true
+	}
+	if d.HasChange(isShareReplicationCronSpec) {
+		cronSpec := d.Get(isShareReplicationCronSpec).(string)
+		sharePatchModel.ReplicationCronSpec = &cronSpec
+		hasChange = true
+	}
+	if hasChange {
+		sharePatch, err := sharePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for SharePatch: %s", err))
+		}
+		updateShareOptions := &vpcv1.UpdateShareOptions{
+			ID:         &id,
+			SharePatch: sharePatch,
+		}
+		_, response, err := vpcClient.UpdateShareWithContext(context, updateShareOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateShareWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating file share (%s): %s\n%s", id, err, response))
+		}
+		_, err = isWaitForShareAvailable(vpcClient, id, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(isShareTags) || d.HasChange(flex.TagsAll) {
+		oldList, newList := d.GetChange(isShareTags)
+		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of resource file share (%s) tags: %s", id, err)
+		}
+	}
+
+	return resourceIBMIsShareRead(context, d, meta)
+}
+
+func resourceIBMIsShareDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	deleteShareOptions := &vpcv1.DeleteShareOptions{
+		ID: &id,
+	}
+	_, response, err := vpcClient.DeleteShareWithContext(context, deleteShareOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteShareWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting file share (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForShareDeleted(vpcClient, id, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForShareAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for file share (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.ShareLifecycleStatePendingConst, vpcv1.ShareLifecycleStateUpdatingConst, vpcv1.ShareLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.ShareLifecycleStateStableConst, vpcv1.ShareLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getShareOptions := &vpcv1.GetShareOptions{
+				ID: &id,
+			}
+			share, response, err := vpcClient.GetShareWithContext(flex.OperationContext(d), getShareOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting file share: %s\n%s", err, response)
+			}
+			d.Set(isShareLifecycleState, *share.LifecycleState)
+			if *share.LifecycleState == vpcv1.ShareLifecycleStateFailedConst {
+				return share, *share.LifecycleState, fmt.Errorf("[ERROR] The file share %s failed", id)
+			}
+			return share, *share.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForShareDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for file share (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.ShareLifecycleStateDeletingConst, vpcv1.ShareLifecycleStateStableConst, vpcv1.ShareLifecycleStateUpdatingConst},
+		Target:  []string{isShareDeleted, vpcv1.ShareLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getShareOptions := &vpcv1.GetShareOptions{
+				ID: &id,
+			}
+			share, response, err := vpcClient.GetShareWithContext(flex.OperationContext(d), getShareOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isShareDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting file share: %s\n%s", err, response)
+			}
+			if *share.LifecycleState == vpcv1.ShareLifecycleStateFailedConst {
+				return share, *share.LifecycleState, fmt.Errorf("[ERROR] The file share %s failed to delete", id)
+			}
+			return share, *share.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
@@ -0,0 +1,518 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareTargetShare                   = "share"
+	isShareTargetVPC                     = "vpc"
+	isShareTargetVirtualNetworkInterface = "virtual_network_interface"
+	isShareTargetTransitEncryption       = "transit_encryption"
+	isShareTargetID                      = "share_target"
+	isShareTargetDeleted                 = "deleted"
+)
+
+func ResourceIBMIsShareTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsShareTargetCreate,
+		ReadContext:   resourceIBMIsShareTargetRead,
+		UpdateContext: resourceIBMIsShareTargetUpdate,
+		DeleteContext: resourceIBMIsShareTargetDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return validate.CheckRules(diff,
+					validate.OneOfWhen([]string{isShareTargetVPC, isShareTargetVirtualNetworkInterface}, validate.Always),
+				)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isShareTargetShare: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The file share identifier.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_target", "name"),
+				Description:  "The user-defined name for this share target.",
+			},
+			isShareTargetVPC: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The VPC in which instances can mount the file share, for a share whose access control mode is `vpc`.",
+			},
+			isShareTargetVirtualNetworkInterface: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The virtual network interface for this share target, for a share whose access control mode is `security_group`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the virtual network interface.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The name for the virtual network interface.",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The associated subnet.",
+						},
+						"primary_ip": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "The primary IP address of the virtual network interface.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
+										Description: "The IP address to reserve, which must not already be reserved on the subnet.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										ForceNew:    true,
+										Description: "The name for the reserved IP.",
+									},
+									"reserved_ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for the reserved IP.",
+									},
+								},
+							},
+						},
+						"resource_group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The resource group of the virtual network interface.",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The security groups to use for the virtual network interface.",
+						},
+					},
+				},
+			},
+			isShareTargetTransitEncryption: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_target", isShareTargetTransitEncryption),
+				Description:  "The transit encryption mode for this share target: `none` or `user_managed`.",
+			},
+			isShareTargetID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of this share target.",
+			},
+			"access_control_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access control mode for the share target.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the share target was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this share target.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the mount target.",
+			},
+			"mount_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mount path for the share, used by the clients to mount it.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsShareTargetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isShareTargetTransitEncryption,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none, user_managed"})
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share_target", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsShareTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get(isShareTargetShare).(string)
+	name := d.Get("name").(string)
+	var transitEncryption *string
+	if te, ok := d.GetOk(isShareTargetTransitEncryption); ok {
+		transitEncryptionStr := te.(string)
+		transitEncryption = &transitEncryptionStr
+	}
+
+	var prototype vpcv1.ShareMountTargetPrototypeIntf
+	if vpc, ok := d.GetOk(isShareTargetVPC); ok {
+		vpcID := vpc.(string)
+		prototype = &vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC{
+			Name:              &name,
+			TransitEncryption: transitEncryption,
+			VPC: &vpcv1.VPCIdentity{
+				ID: &vpcID,
+			},
+		}
+	} else {
+		prototype = &vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup{
+			Name:                    &name,
+			TransitEncryption:       transitEncryption,
+			VirtualNetworkInterface: shareTargetExpandVirtualNetworkInterface(d.Get(isShareTargetVirtualNetworkInterface + ".0").(map[string]interface{})),
+		}
+	}
+
+	// the mount targets of a share are created one at a time, while the share is stable
+	isShareTargetKey := "share_target_key_" + shareID
+	unlock, err := conns.ResourceLocks.Lock(context, isShareTargetKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	createShareMountTargetOptions := &vpcv1.CreateShareMountTargetOptions{
+		ShareID:                   &shareID,
+		ShareMountTargetPrototype: prototype,
+	}
+	target, response, err := vpcClient.CreateShareMountTargetWithContext(context, createShareMountTargetOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateShareMountTargetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating target of file share (%s): %s\n%s", shareID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", shareID, *target.ID))
+
+	_, err = isWaitForShareTargetAvailable(vpcClient, shareID, *target.ID, d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsShareTargetRead(context, d, meta)
+}
+
+func shareTargetExpandVirtualNetworkInterface(vniMap map[string]interface{}) *vpcv1.ShareMountTargetVirtualNetworkInterfacePrototype {
+	subnet := vniMap["subnet"].(string)
+	vni := &vpcv1.ShareMountTargetVirtualNetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnet,
+		},
+	}
+	if name := vniMap["name"].(string); name != "" {
+		vni.Name = &name
+	}
+	if rg := vniMap["resource_group"].(string); rg != "" {
+		vni.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+	if sgs := vniMap["security_groups"].(*schema.Set); sgs.Len() > 0 {
+		vni.SecurityGroups = bareMetalServerExpandSecurityGroups(sgs)
+	}
+	if primaryIPs := vniMap["primary_ip"].([]interface{}); len(primaryIPs) > 0 && primaryIPs[0] != nil {
+		primaryIPMap := primaryIPs[0].(map[string]interface{})
+		primaryIP := &vpcv1.VirtualNetworkInterfacePrimaryIPPrototype{}
+		if address := primaryIPMap["address"].(string); address != "" {
+			primaryIP.Address = &address
+		}
+		if name := primaryIPMap["name"].(string); name != "" {
+			primaryIP.Name = &name
+		}
+		vni.PrimaryIP = primaryIP
+	}
+	return vni
+}
+
+func resourceIBMIsShareTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getShareMountTargetOptions := &vpcv1.GetShareMountTargetOptions{
+		ShareID: &parts[0],
+		ID:      &parts[1],
+	}
+	target, response, err := vpcClient.GetShareMountTargetWithContext(context, getShareMountTargetOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetShareMountTargetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting target (%s) of file share: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set(isShareTargetShare, parts[0])
+	d.Set(isShareTargetID, target.ID)
+	d.Set("name", target.Name)
+	// the targets with a virtual network interface are in the VPC of its subnet
+	if *target.AccessControlMode == vpcv1.ShareMountTargetAccessControlModeVPCConst && target.VPC != nil {
+		d.Set(isShareTargetVPC, target.VPC.ID)
+	}
+	d.Set(isShareTargetTransitEncryption, target.TransitEncryption)
+	d.Set("access_control_mode", target.AccessControlMode)
+	d.Set("created_at", target.CreatedAt.String())
+	d.Set("href", target.Href)
+	d.Set("lifecycle_state", target.LifecycleState)
+	d.Set("mount_path", target.MountPath)
+	d.Set("resource_type", target.ResourceType)
+
+	if target.VirtualNetworkInterface != nil {
+		getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
+			ID: target.VirtualNetworkInterface.ID,
+		}
+		vni, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(context, getVirtualNetworkInterfaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting virtual network interface (%s) of target (%s) of file share: %s\n%s", *target.VirtualNetworkInterface.ID, d.Id(), err, response))
+		}
+		if err = d.Set(isShareTargetVirtualNetworkInterface, shareTargetFlattenVirtualNetworkInterface(vni)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting virtual_network_interface: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func shareTargetFlattenVirtualNetworkInterface(vni *vpcv1.VirtualNetworkInterface) []map[string]interface{} {
+	vniMap := map[string]interface{}{
+		"id":              vni.ID,
+		"name":            vni.Name,
+		"security_groups": bareMetalServerFlattenSecurityGroups(vni.SecurityGroups),
+	}
+	if vni.Subnet != nil {
+		vniMap["subnet"] = vni.Subnet.ID
+	}
+	if vni.ResourceGroup != nil {
+		vniMap["resource_group"] = vni.ResourceGroup.ID
+	}
+	if vni.PrimaryIP != nil {
+		vniMap["primary_ip"] = []map[string]interface{}{{
+			"address":     vni.PrimaryIP.Address,
+			"name":        vni.PrimaryIP.Name,
+			"reserved_ip": vni.PrimaryIP.ID,
+		}}
+	}
+	return []map[string]interface{}{vniMap}
+}
+
+func resourceIBMIsShareTargetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		shareMountTargetPatchModel := &vpcv1.ShareMountTargetPatch{
+			Name: &name,
+		}
+		shareMountTargetPatch, err := shareMountTargetPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for ShareMountTargetPatch: %s", err))
+		}
+		updateShareMountTargetOptions := &vpcv1.UpdateShareMountTargetOptions{
+			ShareID:               &parts[0],
+			ID:                    &parts[1],
+			ShareMountTargetPatch: shareMountTargetPatch,
+		}
+		_, response, err := vpcClient.UpdateShareMountTargetWithContext(context, updateShareMountTargetOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateShareMountTargetWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating target (%s) of file share: %s\n%s", d.Id(), err, response))
+		}
+	}
+
+	return resourceIBMIsShareTargetRead(context, d, meta)
+}
+
+func resourceIBMIsShareTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shareID, targetID := parts[0], parts[1]
+
+	isShareTargetKey := "share_target_key_" + shareID
+	unlock, err := conns.ResourceLocks.Lock(context, isShareTargetKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	deleteShareMountTargetOptions := &vpcv1.DeleteShareMountTargetOptions{
+		ShareID: &shareID,
+		ID:      &targetID,
+	}
+	_, response, err := vpcClient.DeleteShareMountTargetWithContext(context, deleteShareMountTargetOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteShareMountTargetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting target (%s) of file share: %s\n%s", d.Id(), err, response))
+	}
+	_, err = isWaitForShareTargetDeleted(vpcClient, shareID, targetID, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForShareTargetAvailable(vpcClient *vpcv1.VpcV1, shareID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for target (%s) of file share (%s) to be available.", id, shareID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.ShareMountTargetLifecycleStatePendingConst, vpcv1.ShareMountTargetLifecycleStateUpdatingConst, vpcv1.ShareMountTargetLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.ShareMountTargetLifecycleStateStableConst, vpcv1.ShareMountTargetLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getShareMountTargetOptions := &vpcv1.GetShareMountTargetOptions{
+				ShareID: &shareID,
+				ID:      &id,
+			}
+			target, response, err := vpcClient.GetShareMountTargetWithContext(flex.OperationContext(d), getShareMountTargetOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting target of file share: %s\n%s", err, response)
+			}
+			d.Set("lifecycle_state", *target.LifecycleState)
+			if *target.LifecycleState == vpcv1.ShareMountTargetLifecycleStateFailedConst {
+				return target, *target.LifecycleState, fmt.Errorf("[ERROR] The target %s of file share %s failed", id, shareID)
+			}
+			return target, *target.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForShareTargetDeleted(vpcClient *vpcv1.VpcV1, shareID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for target (%s) of file share (%s) to be deleted.", id, shareID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.ShareMountTargetLifecycleStateDeletingConst, vpcv1.ShareMountTargetLifecycleStateStableConst},
+		Target:  []string{isShareTargetDeleted, vpcv1.ShareMountTargetLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getShareMountTargetOptions := &vpcv1.GetShareMountTargetOptions{
+				ShareID: &shareID,
+				ID:      &id,
+			}
+			target, response, err := vpcClient.GetShareMountTargetWithContext(flex.OperationContext(d), getShareMountTargetOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isShareTargetDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting target of file share: %s\n%s", err, response)
+			}
+			if *target.LifecycleState == vpcv1.ShareMountTargetLifecycleStateFailedConst {
+				return target, *target.LifecycleState, fmt.Errorf("[ERROR] The target %s of file share %s failed to delete", id, shareID)
+			}
+			return target, *target.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISShareTarget_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	sharename := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-share-target-%d", acctest.RandIntRange(10, 100))
+	nameupdate := fmt.Sprintf("tf-share-target-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISShareTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareTargetConfig(vpcname, sharename, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISShareTargetExists("ibm_is_share_target.testacc_share_target"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share_target.testacc_share_target", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share_target.testacc_share_target", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_share_target.testacc_share_target", "mount_path"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareTargetConfig(vpcname, sharename, nameupdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISShareTargetExists("ibm_is_share_target.testacc_share_target"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share_target.testacc_share_target", "name", nameupdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareTargetDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_share_target" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getShareMountTargetOptions := &vpcv1.GetShareMountTargetOptions{
+			ShareID: &parts[0],
+			ID:      &parts[1],
+		}
+		_, response, err := vpcClient.GetShareMountTarget(getShareMountTargetOptions)
+		if err == nil {
+			return fmt.Errorf("Share target still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for share target (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISShareTargetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getShareMountTargetOptions := &vpcv1.GetShareMountTargetOptions{
+			ShareID: &parts[0],
+			ID:      &parts[1],
+		}
+		_, _, err = vpcClient.GetShareMountTarget(getShareMountTargetOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISShareTargetConfig(vpcname, sharename, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_share" "testacc_share" {
+		name                = "%s"
+		size                = 200
+		profile             = "%s"
+		zone                = "%s"
+		access_control_mode = "vpc"
+	}
+
+	resource "ibm_is_share_target" "testacc_share_target" {
+		share = ibm_is_share.testacc_share.id
+		vpc   = ibm_is_vpc.testacc_vpc.id
+		name  = "%s"
+	}`, vpcname, sharename, acc.IsShareProfileName, acc.ISZoneName, name)
+}
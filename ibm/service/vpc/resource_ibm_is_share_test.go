@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISShare_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	nameupdate := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareConfig(name, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISShareExists("ibm_is_share.testacc_share"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "size", "200"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "encryption", "provider_managed"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareConfig(nameupdate, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISShareExists("ibm_is_share.testacc_share"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "name", nameupdate),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share", "size", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISShare_replica(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	replicaname := fmt.Sprintf("tf-share-replica-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareReplicaConfig(name, replicaname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISShareExists("ibm_is_share.testacc_share_replica"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_share.testacc_share_replica", "replication_role", "replica"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_share.testacc_share_replica", "source_share", "ibm_is_share.testacc_share", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_share.testacc_share_replica", "size", "ibm_is_share.testacc_share", "size"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_share" {
+			continue
+		}
+
+		getShareOptions := &vpcv1.GetShareOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, response, err := vpcClient.GetShare(getShareOptions)
+		if err == nil {
+			return fmt.Errorf("File share still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for file share (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		getShareOptions := &vpcv1.GetShareOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err = vpcClient.GetShare(getShareOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISShareConfig(name string, size int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "testacc_share" {
+		name    = "%s"
+		size    = %d
+		profile = "%s"
+		zone    = "%s"
+	}`, name, size, acc.IsShareProfileName, acc.ISZoneName)
+}
+
+func testAccCheckIBMISShareReplicaConfig(name, replicaname string) string {
+	return testAccCheckIBMISShareConfig(name, 200) + fmt.Sprintf(`
+
+	resource "ibm_is_share" "testacc_share_replica" {
+		name                  = "%s"
+		profile               = "%s"
+		zone                  = "us-south-2"
+		source_share          = ibm_is_share.testacc_share.id
+		replication_cron_spec = "0 */5 * * *"
+	}`, replicaname, acc.IsShareProfileName)
+}
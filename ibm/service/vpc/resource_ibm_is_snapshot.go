@@ -174,27 +174,30 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	options := &vpcv1.CreateSnapshotOptions{}
+	snapshotPrototype := &vpcv1.SnapshotPrototypeSnapshotBySourceVolume{}
 	if snapshotName, ok := d.GetOk(isSnapshotName); ok {
 		name := snapshotName.(string)
-		options.Name = &name
+		snapshotPrototype.Name = &name
 	}
 	if sourceVolume, ok := d.GetOk(isSnapshotSourceVolume); ok {
 		sv := sourceVolume.(string)
-		options.SourceVolume = &vpcv1.VolumeIdentity{
+		snapshotPrototype.SourceVolume = &vpcv1.VolumeIdentity{
 			ID: &sv,
 		}
 	}
 	if grp, ok := d.GetOk(isVPCResourceGroup); ok {
 		rg := grp.(string)
-		options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+		snapshotPrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
 			ID: &rg,
 		}
 	}
+	options := &vpcv1.CreateSnapshotOptions{
+		SnapshotPrototype: snapshotPrototype,
+	}
 
 	log.Printf("[DEBUG] Snapshot create")
 
-	snapshot, response, err := sess.CreateSnapshot(options)
+	snapshot, response, err := sess.CreateSnapshotWithContext(flex.OperationContext(d), options)
 	if err != nil || snapshot == nil {
 		return fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response)
 	}
@@ -202,7 +205,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(*snapshot.ID)
 	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
 
-	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return err
@@ -211,27 +214,27 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceIBMISSnapshotRead(d, meta)
 }
 
-func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotPending},
 		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotRefreshFunc(sess, id),
+		Refresh:    isSnapshotRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -263,7 +266,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+	snapshot, response, err := sess.GetSnapshotWithContext(flex.OperationContext(d), getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -333,11 +336,11 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 			return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
 		}
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
-		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
+		_, response, err := sess.UpdateSnapshotWithContext(flex.OperationContext(d), updateSnapshotOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -345,26 +348,26 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	return nil
 }
 
-func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotUpdate(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotUpdating},
 		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotUpdateRefreshFunc(sess, id),
+		Refresh:    isSnapshotUpdateRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotUpdateRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -398,7 +401,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSnapshot(getSnapshotOptions)
+	_, response, err := sess.GetSnapshotWithContext(flex.OperationContext(d), getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -410,11 +413,11 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
+	response, err = sess.DeleteSnapshotWithContext(flex.OperationContext(d), deleteSnapshotOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
 	}
-	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -422,28 +425,28 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isSnapshotDeleting},
 		Target:     []string{isSnapshotDeleted, isSnapshotFailed},
-		Refresh:    isSnapshotDeleteRefreshFunc(sess, id),
+		Refresh:    isSnapshotDeleteRefreshFunc(ctx, sess, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSnapshotDeleteRefreshFunc(ctx context.Context, sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for Snapshot delete.")
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
@@ -468,7 +471,7 @@ func snapshotExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSnapshot(getSnapshotOptions)
+	_, response, err := sess.GetSnapshotWithContext(flex.OperationContext(d), getSnapshotOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
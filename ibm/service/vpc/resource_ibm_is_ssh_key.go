@@ -38,7 +38,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -83,6 +83,13 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -182,7 +189,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		}
 	}
 
-	key, response, err := sess.CreateKey(options)
+	key, response, err := sess.CreateKeyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
 	}
@@ -190,7 +197,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if _, ok := d.GetOk(isKeyTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
@@ -220,7 +227,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetKeyOptions{
 		ID: &id,
 	}
-	key, response, err := sess.GetKey(options)
+	key, response, err := sess.GetKeyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -239,6 +246,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isKeyTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -277,11 +285,11 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange(isKeyTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
-		key, response, err := sess.GetKey(options)
+		key, response, err := sess.GetKeyWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
 		}
@@ -304,7 +312,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for KeyPatch: %s", err)
 		}
 		options.KeyPatch = keyPatch
-		_, response, err := sess.UpdateKey(options)
+		_, response, err := sess.UpdateKeyWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc SSH Key: %s\n%s", err, response)
 		}
@@ -331,7 +339,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getKeyOptions := &vpcv1.GetKeyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetKey(getKeyOptions)
+	_, response, err := sess.GetKeyWithContext(flex.OperationContext(d), getKeyOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -342,7 +350,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteKeyOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteKey(options)
+	response, err = sess.DeleteKeyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting SSH Key : %s\n%s", err, response)
 	}
@@ -365,7 +373,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	options := &vpcv1.GetKeyOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetKey(options)
+	_, response, err := sess.GetKeyWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -11,7 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -64,7 +63,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -118,6 +117,13 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isSubnetAccessTags: {
 				Type:        schema.TypeSet,
@@ -284,8 +290,11 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
 	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	conns.IbmMutexKV.Lock(isSubnetKey)
-	defer conns.IbmMutexKV.Unlock(isSubnetKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isSubnetKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -303,7 +312,7 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		rtID = rt.(string)
 	}
 
-	err := subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
+	err = subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
 	if err != nil {
 		return err
 	}
@@ -360,21 +369,21 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	createSubnetOptions := &vpcv1.CreateSubnetOptions{
 		SubnetPrototype: subnetTemplate,
 	}
-	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
+	subnet, response, err := sess.CreateSubnetWithContext(flex.OperationContext(d), createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
 		return fmt.Errorf("[ERROR] Error while creating Subnet %s\n%v", err, response)
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
+	if _, ok := d.GetOk(isSubnetTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isSubnetTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) tags: %s", d.Id(), err)
@@ -383,7 +392,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 
 	if _, ok := d.GetOk(isSubnetAccessTags); ok {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -393,27 +402,27 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	return nil
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isSubnetProvisioning},
 		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isSubnetRefreshFunc(subnetC, id),
+		Refresh:    isSubnetRefreshFunc(ctx, subnetC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
 		}
@@ -445,7 +454,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(flex.OperationContext(d), getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -480,19 +489,20 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 
-	tags, err := flex.GetGlobalTagsUsingCRN(meta, *subnet.CRN, "", isUserTagType)
+	tags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *subnet.CRN, "", isUserTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource subnet (%s) tags: %s", d.Id(), err)
 	}
 
-	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *subnet.CRN, "", isAccessTagType)
+	accesstags, err := flex.GetGlobalTagsUsingCRN(flex.OperationContext(d), meta, *subnet.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource subnet (%s) access tags: %s", d.Id(), err)
 	}
 
 	d.Set(isSubnetTags, tags)
+	d.Set(flex.TagsAll, tags)
 	d.Set(isSubnetAccessTags, accesstags)
 	d.Set(isSubnetCRN, *subnet.CRN)
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/subnets")
@@ -509,9 +519,9 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
+	if d.HasChange(isSubnetTags) || d.HasChange(flex.TagsAll) {
 		oldList, newList := d.GetChange(isSubnetTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
@@ -520,7 +530,7 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange(isSubnetAccessTags) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(flex.OperationContext(d), oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -563,11 +573,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 				ID: &id,
 			}
-			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+			response, err := sess.UnsetSubnetPublicGatewayWithContext(flex.OperationContext(d), unsetSubnetPublicGatewayOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -578,11 +588,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 					ID: &gw,
 				},
 			}
-			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
+			_, response, err := sess.SetSubnetPublicGatewayWithContext(flex.OperationContext(d), setSubnetPublicGatewayOptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -614,7 +624,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
-		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
+		_, response, err := sess.UpdateSubnetWithContext(flex.OperationContext(d), updateSubnetOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
 		}
@@ -642,7 +652,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(flex.OperationContext(d), getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -653,11 +663,11 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		_, err = sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+		_, err = sess.UnsetSubnetPublicGatewayWithContext(flex.OperationContext(d), unsetSubnetPublicGatewayOptions)
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -665,10 +675,10 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSubnet(deleteSubnetOptions)
+	response, err = sess.DeleteSubnetWithContext(flex.OperationContext(d), deleteSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 409 {
-			_, err = isWaitForSubnetDeleteRetry(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForSubnetDeleteRetry(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
 			}
@@ -676,7 +686,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
 		}
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -684,7 +694,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Retrying subnet (%s) delete", id)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isSubnetInUse},
@@ -694,7 +704,7 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 				ID: &id,
 			}
 			log.Printf("Retrying subnet (%s) delete", id)
-			response, err := vpcClient.DeleteSubnet(deleteSubnetOptions)
+			response, err := vpcClient.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isSubnetInUse, nil
@@ -709,31 +719,31 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isSubnetDeleting},
 		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
+		Refresh:    isSubnetDeleteRefreshFunc(ctx, subnetC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetDeleteRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is subnet delete function here")
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
@@ -761,7 +771,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 	getsubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSubnet(getsubnetOptions)
+	_, response, err := sess.GetSubnetWithContext(flex.OperationContext(d), getsubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
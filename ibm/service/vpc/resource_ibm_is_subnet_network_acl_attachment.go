@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -211,7 +212,7 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 	replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 	replaceSubnetNetworkACLOptionsModel.ID = &subnet
 	replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-	resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+	resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(flex.OperationContext(d), replaceSubnetNetworkACLOptionsModel)
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -234,7 +235,7 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
 		ID: &id,
 	}
-	nwacl, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
+	nwacl, response, err := sess.GetSubnetNetworkACLWithContext(flex.OperationContext(d), getSubnetNetworkACLOptionsModel)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -352,7 +353,7 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &subnet
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+		resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(flex.OperationContext(d), replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -377,7 +378,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(flex.OperationContext(d), getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -391,7 +392,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getvpcOptions)
+	vpc, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -411,7 +412,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &id
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+		resultACL, response, err := sess.ReplaceSubnetNetworkACLWithContext(flex.OperationContext(d), replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -435,7 +436,7 @@ func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta
 	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
+	_, response, err := sess.GetSubnetNetworkACLWithContext(flex.OperationContext(d), getSubnetNetworkACLOptionsModel)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -148,7 +148,7 @@ func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) err
 			ID: &targetId,
 		}
 	}
-	rip, response, err := sess.CreateSubnetReservedIP(options)
+	rip, response, err := sess.CreateSubnetReservedIPWithContext(flex.OperationContext(d), options)
 	if err != nil || response == nil || rip == nil {
 		return fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
 	}
@@ -234,7 +234,7 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 
 		options.ReservedIPPatch = reservedIPPatch
 
-		_, response, err := sess.UpdateSubnetReservedIP(options)
+		_, response, err := sess.UpdateSubnetReservedIPWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the reserved IP %s\n%s", err, response)
 		}
@@ -264,7 +264,7 @@ func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) err
 	subnetID := allIDs[0]
 	reservedIPID := allIDs[1]
 	deleteOptions := sess.NewDeleteSubnetReservedIPOptions(subnetID, reservedIPID)
-	response, err := sess.DeleteSubnetReservedIP(deleteOptions)
+	response, err := sess.DeleteSubnetReservedIPWithContext(flex.OperationContext(d), deleteOptions)
 	if err != nil || response == nil {
 		return fmt.Errorf("[ERROR] Error deleting the reserverd ip %s in subnet %s, %s\n%s", reservedIPID, subnetID, err, response)
 	}
@@ -293,7 +293,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 	subnetID := allIDs[0]
 	reservedIPID := allIDs[1]
 	options := sess.NewGetSubnetReservedIPOptions(subnetID, reservedIPID)
-	rip, response, err := sess.GetSubnetReservedIP(options)
+	rip, response, err := sess.GetSubnetReservedIPWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -53,7 +53,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -68,7 +68,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.ValidateISName,
+				ValidateFunc: validate.InvokeValidator("ibm_is_virtual_endpoint_gateway", isVirtualEndpointGatewayName),
 				Description:  "Endpoint gateway name",
 			},
 			isVirtualEndpointGatewayResourceType: {
@@ -192,6 +192,13 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for VPE",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 		},
 	}
 }
@@ -214,6 +221,13 @@ func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "provider_cloud_service, provider_infrastructure_service"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVirtualEndpointGatewayName,
+			ValidateFunctionIdentifier: validate.ISName,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			ForceNew:                   true})
 
 	ibmEndpointGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_endpoint_gateway", Schema: validateSchema}
 	return &ibmEndpointGatewayResourceValidator
@@ -267,7 +281,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		opt.SetResourceGroup(resourceGroupOpt)
 
 	}
-	result, response, err := sess.CreateEndpointGateway(opt)
+	result, response, err := sess.CreateEndpointGatewayWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
 		return fmt.Errorf("Create Endpoint Gateway failed %s\n%s", err, response)
@@ -275,7 +289,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(*result.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
@@ -300,16 +314,16 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		endpointGatewayPatchModel.Name = core.StringPtr(name)
 		endpointGatewayPatchModelAsPatch, _ := endpointGatewayPatchModel.AsPatch()
 		opt := sess.NewUpdateEndpointGatewayOptions(d.Id(), endpointGatewayPatchModelAsPatch)
-		_, response, err := sess.UpdateEndpointGateway(opt)
+		_, response, err := sess.UpdateEndpointGatewayWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
 			return fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response)
 		}
 
 	}
-	if d.HasChange(isVirtualEndpointGatewayTags) {
+	if d.HasChange(isVirtualEndpointGatewayTags) || d.HasChange(flex.TagsAll) {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
-		result, response, err := sess.GetEndpointGateway(opt)
+		result, response, err := sess.GetEndpointGatewayWithContext(flex.OperationContext(d), opt)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
 		}
@@ -330,7 +344,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 	}
 	// read option
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
-	result, response, err := sess.GetEndpointGateway(opt)
+	result, response, err := sess.GetEndpointGatewayWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -356,6 +370,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 			"Error on get of VPE (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVirtualEndpointGatewayTags, tags)
+	d.Set(flex.TagsAll, tags)
 	return nil
 }
 
@@ -366,7 +381,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta inte
 	}
 
 	opt := sess.NewDeleteEndpointGatewayOptions(d.Id())
-	response, err := sess.DeleteEndpointGateway(opt)
+	response, err := sess.DeleteEndpointGatewayWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
 		return fmt.Errorf("Delete Endpoint Gateway failed : %s\n%s", err, response)
@@ -381,7 +396,7 @@ func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta inte
 	}
 
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
-	_, response, err := sess.GetEndpointGateway(opt)
+	_, response, err := sess.GetEndpointGatewayWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Endpoint Gateway does not exist.")
@@ -115,7 +115,7 @@ func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta in
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 	ipID := d.Get(isVirtualEndpointGatewayIPID).(string)
 	opt := sess.NewAddEndpointGatewayIPOptions(gatewayID, ipID)
-	_, response, err := sess.AddEndpointGatewayIP(opt)
+	_, response, err := sess.AddEndpointGatewayIPWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Add Endpoint Gateway failed: %v", response)
 		return err
@@ -136,7 +136,7 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
-	result, response, err := sess.GetEndpointGatewayIP(opt)
+	result, response, err := sess.GetEndpointGatewayIPWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway IP failed: %v", response)
 		return err
@@ -164,7 +164,7 @@ func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta in
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewRemoveEndpointGatewayIPOptions(gatewayID, ipID)
-	response, err := sess.RemoveEndpointGatewayIP(opt)
+	response, err := sess.RemoveEndpointGatewayIPWithContext(flex.OperationContext(d), opt)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Remove Endpoint Gateway IP failed: %v", response)
 		return err
@@ -189,7 +189,7 @@ func resourceIBMisVirtualEndpointGatewayIPExists(d *schema.ResourceData, meta in
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
-	_, response, err := sess.GetEndpointGatewayIP(opt)
+	_, response, err := sess.GetEndpointGatewayIPWithContext(flex.OperationContext(d), opt)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("Endpoint Gateway IP does not exist.")
@@ -43,7 +43,8 @@ const (
 )
 
 func ResourceIBMISVolume() *schema.Resource {
-	return &schema.Resource{
+	// a change of the profile to or from custom replaces the volume in CustomizeDiff
+	return flex.WithDeletionProtection(&schema.Resource{
 		Create:   resourceIBMISVolumeCreate,
 		Read:     resourceIBMISVolumeRead,
 		Update:   resourceIBMISVolumeUpdate,
@@ -59,7 +60,7 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -175,6 +176,13 @@ func ResourceIBMISVolume() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the volume instance",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -212,7 +220,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				Description: "The maximum bandwidth (in megabits per second) for the volume",
 			},
 		},
-	}
+	}, flex.VolumeProfileForceNew)
 }
 
 func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
@@ -322,18 +330,18 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		volTemplate.Iops = &iops
 	}
 
-	vol, response, err := sess.CreateVolume(options)
+	vol, response, err := sess.CreateVolumeWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
+	if _, ok := d.GetOk(isVolumeTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
@@ -362,7 +370,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	vol, response, err := sess.GetVolume(options)
+	vol, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -407,6 +415,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc volume (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVolumeTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -452,15 +461,15 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	}
 	var capacity int64
 	if delete {
-		deleteAllSnapshots(sess, id)
+		deleteAllSnapshots(flex.OperationContext(d), sess, id)
 	}
 
 	// tags update
-	if d.HasChange(isVolumeTags) {
+	if d.HasChange(isVolumeTags) || d.HasChange(flex.TagsAll) {
 		options := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := sess.GetVolume(options)
+		vol, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
 		}
@@ -485,8 +494,8 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeNamePatch: %s", err)
 		}
 		options.VolumePatch = volumeNamePatch
-		_, _, err = sess.UpdateVolume(options)
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, _, err = sess.UpdateVolumeWithContext(flex.OperationContext(d), options)
+		_, err = isWaitForVolumeAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -499,7 +508,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getvoloptions := &vpcv1.GetVolumeOptions{
 			ID: &volId,
 		}
-		vol, response, err := sess.GetVolume(getvoloptions)
+		vol, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), getvoloptions)
 		if err != nil || vol == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volId, err, response)
 		}
@@ -511,7 +520,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &insId,
 		}
-		instance, response, err := sess.GetInstance(getinsOptions)
+		instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the volume (%s) is attached : %s\n%s", insId, volId, err, response)
 		}
@@ -521,7 +530,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				InstanceID: &insId,
 				Type:       &actiontype,
 			}
-			_, response, err = sess.CreateInstanceAction(createinsactoptions)
+			_, response, err = sess.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
 			}
@@ -549,8 +558,8 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for VolumeProfilePatch: %s", err)
 		}
 		options.VolumePatch = volumeProfilePatch
-		_, response, err = sess.UpdateVolume(options)
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, response, err = sess.UpdateVolumeWithContext(flex.OperationContext(d), options)
+		_, err = isWaitForVolumeAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -562,7 +571,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getvolumeoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := sess.GetVolume(getvolumeoptions)
+		vol, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), getvolumeoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
@@ -577,7 +586,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: insId,
 		}
-		instance, response, err := sess.GetInstance(getinsOptions)
+		instance, response, err := sess.GetInstanceWithContext(flex.OperationContext(d), getinsOptions)
 		if err != nil || instance == nil {
 			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", *insId, err, response)
 		}
@@ -587,7 +596,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				InstanceID: insId,
 				Type:       &actiontype,
 			}
-			_, response, err = sess.CreateInstanceAction(createinsactoptions)
+			_, response, err = sess.CreateInstanceActionWithContext(flex.OperationContext(d), createinsactoptions)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
 			}
@@ -605,11 +614,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			return fmt.Errorf("[ERROR] Error calling asPatch for volumeCapacityPatch: %s", err)
 		}
 		options.VolumePatch = volumeCapacityPatch
-		_, response, err = sess.UpdateVolume(options)
+		_, response, err = sess.UpdateVolumeWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc volume: %s\n%s", err, response)
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -637,7 +646,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getvoloptions := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	volDetails, response, err := sess.GetVolume(getvoloptions)
+	volDetails, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), getvoloptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -651,7 +660,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				InstanceID: volAtt.Instance.ID,
 				ID:         volAtt.ID,
 			}
-			_, err := sess.DeleteInstanceVolumeAttachment(deleteVolumeAttachment)
+			_, err := sess.DeleteInstanceVolumeAttachmentWithContext(flex.OperationContext(d), deleteVolumeAttachment)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s: %q", *volAtt.ID, *volAtt.Instance.ID, err)
 			}
@@ -666,11 +675,11 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteVolumeOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVolume(options)
+	response, err = sess.DeleteVolumeWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting Volume : %s\n%s", err, response)
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -678,27 +687,27 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVolumeDeleting},
 		Target:     []string{"done", ""},
-		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
+		Refresh:    isVolumeDeleteRefreshFunc(ctx, vol, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVolumeDeleteRefreshFunc(ctx context.Context, vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := vol.GetVolume(volgetoptions)
+		vol, response, err := vol.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
@@ -725,7 +734,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	options := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVolume(options)
+	_, response, err := sess.GetVolumeWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -735,27 +744,27 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVolumeProvisioning},
 		Target:     []string{isVolumeProvisioningDone, ""},
-		Refresh:    isVolumeRefreshFunc(client, id),
+		Refresh:    isVolumeRefreshFunc(ctx, client, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVolumeRefreshFunc(ctx context.Context, client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := client.GetVolume(volgetoptions)
+		vol, response, err := client.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting volume: %s\n%s", err, response)
 		}
@@ -768,10 +777,10 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 	}
 }
 
-func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
+func deleteAllSnapshots(ctx context.Context, sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots := new(vpcv1.DeleteSnapshotsOptions)
 	delete_all_snapshots.SourceVolumeID = &id
-	response, err := sess.DeleteSnapshots(delete_all_snapshots)
+	response, err := sess.DeleteSnapshotsWithContext(ctx, delete_all_snapshots)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting snapshots from volume %s\n%s", err, response)
 	}
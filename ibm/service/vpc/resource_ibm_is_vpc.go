@@ -76,7 +76,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -185,6 +185,13 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			isVPCCRN: {
 				Type:        schema.TypeString,
@@ -474,31 +481,31 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	}
 	options.ClassicAccess = &isClassic
 
-	vpc, response, err := sess.CreateVPC(options)
+	vpc, response, err := sess.CreateVPCWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
 	}
 	d.SetId(*vpc.ID)
 
 	if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-		sgNameUpdate(sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
+		sgNameUpdate(flex.OperationContext(d), sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
 	}
 
 	if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-		rtNameUpdate(sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
+		rtNameUpdate(flex.OperationContext(d), sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
 	}
 
 	if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-		nwaclNameUpdate(sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
+		nwaclNameUpdate(flex.OperationContext(d), sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(flex.OperationContext(d), sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPCTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
@@ -509,27 +516,27 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	return nil
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isVPCPending},
 		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isVPCRefreshFunc(vpc, id),
+		Refresh:    isVPCRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
@@ -559,7 +566,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	vpc, response, err := sess.GetVPC(getvpcOptions)
+	vpc, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -597,6 +604,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPCTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -631,7 +639,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		if start != "" {
 			options.Start = &start
 		}
-		s, response, err := sess.ListSubnets(options)
+		s, response, err := sess.ListSubnetsWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
 		}
@@ -662,7 +670,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	listSgOptions := &vpcv1.ListSecurityGroupsOptions{
 		VPCID: &vpcid,
 	}
-	sgs, _, err := sess.ListSecurityGroups(listSgOptions)
+	sgs, _, err := sess.ListSecurityGroupsWithContext(flex.OperationContext(d), listSgOptions)
 	if err != nil {
 		return err
 	}
@@ -801,11 +809,11 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPCTags) {
+	if d.HasChange(isVPCTags) || d.HasChange(flex.TagsAll) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := sess.GetVPC(getvpcOptions)
+		vpc, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getvpcOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
@@ -819,17 +827,17 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 
 	if d.HasChange(isVPCDefaultSecurityGroupName) {
 		if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-			sgNameUpdate(sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
+			sgNameUpdate(flex.OperationContext(d), sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultRoutingTableName) {
 		if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-			rtNameUpdate(sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
+			rtNameUpdate(flex.OperationContext(d), sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultNetworkACLName) {
 		if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-			nwaclNameUpdate(sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
+			nwaclNameUpdate(flex.OperationContext(d), sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
 		}
 	}
 
@@ -845,7 +853,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPCPatch: %s", err)
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
-		_, response, err := sess.UpdateVPC(updateVpcOptions)
+		_, response, err := sess.UpdateVPCWithContext(flex.OperationContext(d), updateVpcOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
 		}
@@ -872,7 +880,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPC(getVpcOptions)
+	_, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getVpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -884,11 +892,11 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVPC(deletevpcOptions)
+	response, err = sess.DeleteVPCWithContext(flex.OperationContext(d), deletevpcOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -896,28 +904,28 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVPCDeleting},
 		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
+		Refresh:    isVPCDeleteRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is vpc delete function here")
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
@@ -943,7 +951,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPC(getvpcOptions)
+	_, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -960,7 +968,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 // 	return conns.String(buf.String())
 // }
 
-func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func nwaclNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions := &vpcv1.UpdateNetworkACLOptions{
 		ID: &id,
 	}
@@ -972,14 +980,14 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
 	}
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
-	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+	_, response, err := sess.UpdateNetworkACLWithContext(ctx, updateNetworkACLOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
 	}
 	return nil
 }
 
-func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func sgNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions := &vpcv1.UpdateSecurityGroupOptions{
 		ID: &id,
 	}
@@ -991,14 +999,14 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
 	}
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
-	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+	_, response, err := sess.UpdateSecurityGroupWithContext(ctx, updateSecurityGroupOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
 	}
 	return nil
 }
 
-func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
+func rtNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions := new(vpcv1.UpdateVPCRoutingTableOptions)
 	updateVpcRoutingTableOptions.VPCID = &vpcID
 	updateVpcRoutingTableOptions.ID = &id
@@ -1009,7 +1017,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
-	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	_, response, err := sess.UpdateVPCRoutingTableWithContext(ctx, updateVpcRoutingTableOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
 	}
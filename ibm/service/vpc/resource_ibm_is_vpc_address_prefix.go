@@ -6,7 +6,6 @@ package vpc
 import (
 	"fmt"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -125,10 +124,13 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutCreate, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
+	err = vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 	if err != nil {
 		return err
 	}
@@ -149,7 +151,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 			Name: &zone,
 		},
 	}
-	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
+	addrPrefix, response, err := sess.CreateVPCAddressPrefixWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC Address Prefix %s\n%s", err, response)
 	}
@@ -184,7 +186,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	addrPrefix, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
+	addrPrefix, response, err := sess.GetVPCAddressPrefixWithContext(flex.OperationContext(d), getvpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -203,7 +205,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	getVPCOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getVPCOptions)
+	vpc, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getVPCOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
 	}
@@ -227,8 +229,11 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutUpdate, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -269,7 +274,7 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 			return fmt.Errorf("[ERROR] Error calling asPatch for AddressPrefixPatch: %s", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
-		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+		_, response, err := sess.UpdateVPCAddressPrefixWithContext(flex.OperationContext(d), updatevpcAddressPrefixoptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC Address Prefix: %s\n%s", err, response)
 		}
@@ -287,8 +292,11 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := flex.LockResource(d, schema.TimeoutDelete, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	error := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 	if error != nil {
@@ -309,7 +317,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
+	_, response, err := sess.GetVPCAddressPrefixWithContext(flex.OperationContext(d), getvpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -321,7 +329,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+	response, err = sess.DeleteVPCAddressPrefixWithContext(flex.OperationContext(d), deletevpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -357,7 +365,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
+	_, response, err := sess.GetVPCAddressPrefixWithContext(flex.OperationContext(d), getvpcAddressPrefixOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
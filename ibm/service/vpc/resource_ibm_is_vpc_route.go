@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -144,14 +145,14 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 		VPCID:       &vpcID,
 		Destination: &cidr,
 		Name:        &routeName,
-		NextHop: &vpcv1.RouteNextHopPrototype{
+		NextHop: &vpcv1.RoutePrototypeNextHop{
 			Address: &nextHop,
 		},
 		Zone: &vpcv1.ZoneIdentity{
 			Name: &zoneName,
 		},
 	}
-	route, response, err := sess.CreateVPCRoute(createRouteOptions)
+	route, response, err := sess.CreateVPCRouteWithContext(flex.OperationContext(d), createRouteOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating VPC Route err %s\n%s", err, response)
 	}
@@ -176,7 +177,7 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
-			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+			route, response, err := sess.GetVPCRouteWithContext(flex.OperationContext(d), getVpcRouteOptions)
 			if err != nil {
 				return route, "", fmt.Errorf("[ERROR] Error Getting VPC Route: %s\n%s", err, response)
 			}
@@ -191,7 +192,7 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
 }
 
 func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
@@ -219,7 +220,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+	route, response, err := sess.GetVPCRouteWithContext(flex.OperationContext(d), getVpcRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -239,7 +240,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	getVPCOptions := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
 	}
-	vpc, response, err := sess.GetVPC(getVPCOptions)
+	vpc, response, err := sess.GetVPCWithContext(flex.OperationContext(d), getVPCOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
 	}
@@ -291,7 +292,7 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 			return fmt.Errorf("[ERROR] Error calling asPatch for RoutePatch: %s", err)
 		}
 		updateVpcRouteOptions.RoutePatch = routePatch
-		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
+		_, response, err := sess.UpdateVPCRouteWithContext(flex.OperationContext(d), updateVpcRouteOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating VPC Route: %s\n%s", err, response)
 		}
@@ -325,7 +326,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+	_, response, err := sess.GetVPCRouteWithContext(flex.OperationContext(d), getVpcRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -336,11 +337,11 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
+	response, err = sess.DeleteVPCRouteWithContext(flex.OperationContext(d), deleteRouteOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting VPC Route: %s\n%s", err, response)
 	}
-	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCRouteDeleted(flex.OperationContext(d), sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -348,7 +349,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	return nil
 }
 
-func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCRouteDeleted(ctx context.Context, sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
 	stateConf := &resource.StateChangeConf{
@@ -359,7 +360,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
-			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+			route, response, err := sess.GetVPCRouteWithContext(ctx, getVpcRouteOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
@@ -373,7 +374,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIBMISVpcRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -399,7 +400,7 @@ func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		VPCID: &vpcID,
 		ID:    &routeID,
 	}
-	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
+	_, response, err := sess.GetVPCRouteWithContext(flex.OperationContext(d), getVpcRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -187,7 +188,7 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 		routeVPCZoneIngress := d.Get(rtRouteVPCZoneIngress).(bool)
 		createVpcRoutingTableOptions.RouteVPCZoneIngress = &routeVPCZoneIngress
 	}
-	routeTable, response, err := sess.CreateVPCRoutingTable(createVpcRoutingTableOptions)
+	routeTable, response, err := sess.CreateVPCRoutingTableWithContext(flex.OperationContext(d), createVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table err %s\n%s", err, response)
 		return err
@@ -206,7 +207,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 
 	idSet := strings.Split(d.Id(), "/")
 	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
-	routeTable, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
+	routeTable, response, err := sess.GetVPCRoutingTableWithContext(flex.OperationContext(d), getVpcRoutingTableOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -275,7 +276,7 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
-	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	_, response, err := sess.UpdateVPCRoutingTableWithContext(flex.OperationContext(d), updateVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
 		return err
@@ -292,7 +293,7 @@ func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}
 	idSet := strings.Split(d.Id(), "/")
 
 	deleteTableOptions := sess.NewDeleteVPCRoutingTableOptions(idSet[0], idSet[1])
-	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
+	response, err := sess.DeleteVPCRoutingTableWithContext(flex.OperationContext(d), deleteTableOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table : %s", response)
 		return err
@@ -313,7 +314,7 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 		return false, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", d.Id())
 	}
 	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
+	_, response, err := sess.GetVPCRoutingTableWithContext(flex.OperationContext(d), getVpcRoutingTableOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -164,12 +165,12 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 	if add, ok := d.GetOk(rNextHop); ok {
 		item := add.(string)
 		if net.ParseIP(item) == nil {
-			nhConnectionID := &vpcv1.RouteNextHopPrototypeVPNGatewayConnectionIdentity{
+			nhConnectionID := &vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeVPNGatewayConnectionIdentity{
 				ID: core.StringPtr(item),
 			}
 			createVpcRoutingTableRouteOptions.SetNextHop(nhConnectionID)
 		} else {
-			nh := &vpcv1.RouteNextHopPrototypeRouteNextHopIP{
+			nh := &vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeRouteNextHopIP{
 				Address: core.StringPtr(item),
 			}
 			createVpcRoutingTableRouteOptions.SetNextHop(nh)
@@ -186,7 +187,7 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 		createVpcRoutingTableRouteOptions.SetName(routeName)
 	}
 
-	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+	route, response, err := sess.CreateVPCRoutingTableRouteWithContext(flex.OperationContext(d), createVpcRoutingTableRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
 		return err
@@ -205,7 +206,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 
 	idSet := strings.Split(d.Id(), "/")
 	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	route, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
+	route, response, err := sess.GetVPCRoutingTableRouteWithContext(flex.OperationContext(d), getVpcRoutingTableRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -258,7 +259,7 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		}
 
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
-		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		_, response, err := sess.UpdateVPCRoutingTableRouteWithContext(flex.OperationContext(d), updateVpcRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
 			return err
@@ -276,7 +277,7 @@ func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interf
 
 	idSet := strings.Split(d.Id(), "/")
 	deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+	response, err := sess.DeleteVPCRoutingTableRouteWithContext(flex.OperationContext(d), deleteVpcRoutingTableRouteOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table route : %s", response)
 		return err
@@ -297,7 +298,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 		return false, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID/routeID", d.Id())
 	}
 	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
+	_, response, err := sess.GetVPCRoutingTableRouteWithContext(flex.OperationContext(d), getVpcRoutingTableRouteOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
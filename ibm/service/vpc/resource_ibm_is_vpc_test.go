@@ -130,6 +130,41 @@ func TestAccIBMISVPC_securityGroups(t *testing.T) {
 	})
 }
 
+func TestIBMISVPC_replay(t *testing.T) {
+	var vpc string
+	acc.ReplayTest(t, "ibm_is_vpc_basic", map[string]string{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": "https://us-south.iaas.cloud.ibm.com/v1",
+		"IBMCLOUD_GT_API_ENDPOINT":    "https://tags.global-search-tagging.cloud.ibm.com",
+	}, resource.TestCase{
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCConfig2("tf-replay-vpc", "auto"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc1", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc1", "name", "tf-replay-vpc"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc1", "status", "available"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCConfig2("tf-replay-vpc-update", "auto"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc1", "name", "tf-replay-vpc-update"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_vpc.testacc_vpc1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"address_prefix_management"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPCDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
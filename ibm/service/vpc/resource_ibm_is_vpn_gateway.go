@@ -54,7 +54,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -121,6 +121,13 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "VPN Gateway tags list",
 			},
+			flex.TagsAll: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of all tags attached to the resource, including the provider default tags",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -279,13 +286,13 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		}
 	}
 
-	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
+	vpnGatewayIntf, response, err := sess.CreateVPNGatewayWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(flex.OperationContext(d), sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -294,7 +301,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	log.Printf("[INFO] VPNGateway : %s", *vpnGateway.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" || len(flex.DefaultTags(meta)) > 0 {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
@@ -305,33 +312,33 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	return nil
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVPNGatewayProvisioning},
 		Target:     []string{isVPNGatewayProvisioningDone, ""},
-		Refresh:    isVpnGatewayRefreshFunc(vpnGateway, id),
+		Refresh:    isVpnGatewayRefreshFunc(ctx, vpnGateway, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVpnGatewayRefreshFunc(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpnGatewayIntf, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-		if *vpnGateway.Status == "available" || *vpnGateway.Status == "failed" || *vpnGateway.Status == "running" {
+		if *vpnGateway.LifecycleState == vpcv1.VPNGatewayLifecycleStateStableConst || *vpnGateway.LifecycleState == vpcv1.VPNGatewayLifecycleStateFailedConst {
 			return vpnGateway, isVPNGatewayProvisioningDone, nil
 		}
 
@@ -357,7 +364,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &id,
 	}
-	vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+	vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(flex.OperationContext(d), getVpnGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -369,7 +376,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 
 	d.Set(isVPNGatewayName, *vpnGateway.Name)
 	d.Set(isVPNGatewaySubnet, *vpnGateway.Subnet.ID)
-	d.Set(isVPNGatewayStatus, *vpnGateway.Status)
+	d.Set(isVPNGatewayStatus, *vpnGateway.LifecycleState)
 	members := []vpcv1.VPNGatewayMember{}
 	for _, member := range vpnGateway.Members {
 		members = append(members, member)
@@ -393,6 +400,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPNGatewayTags, tags)
+	d.Set(flex.TagsAll, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -401,7 +409,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(flex.ResourceName, *vpnGateway.Name)
 	d.Set(flex.ResourceCRN, *vpnGateway.CRN)
 	d.Set(isVPNGatewayCRN, *vpnGateway.CRN)
-	d.Set(flex.ResourceStatus, *vpnGateway.Status)
+	d.Set(flex.ResourceStatus, *vpnGateway.LifecycleState)
 	if vpnGateway.ResourceGroup != nil {
 		d.Set(flex.ResourceGroupName, *vpnGateway.ResourceGroup.Name)
 		d.Set(isVPNGatewayResourceGroup, *vpnGateway.ResourceGroup.ID)
@@ -414,7 +422,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			if memberIP.PublicIP != nil {
 				currentMemberIP["address"] = *memberIP.PublicIP.Address
 				currentMemberIP["role"] = *memberIP.Role
-				currentMemberIP["status"] = *memberIP.Status
+				currentMemberIP["status"] = *memberIP.LifecycleState
 				vpcMembersIpsList = append(vpcMembersIpsList, currentMemberIP)
 			}
 			if memberIP.PrivateIP != nil {
@@ -451,11 +459,11 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange(isVPNGatewayTags) || d.HasChange(flex.TagsAll) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+		vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(flex.OperationContext(d), getVpnGatewayOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
 		}
@@ -480,7 +488,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayPatch: %s", err)
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
-		_, response, err := sess.UpdateVPNGateway(options)
+		_, response, err := sess.UpdateVPNGatewayWithContext(flex.OperationContext(d), options)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating vpc Vpn Gateway: %s\n%s", err, response)
 		}
@@ -508,7 +516,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+	_, response, err := sess.GetVPNGatewayWithContext(flex.OperationContext(d), getVpnGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
@@ -519,11 +527,11 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	options := &vpcv1.DeleteVPNGatewayOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVPNGateway(options)
+	response, err = sess.DeleteVPNGatewayWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(flex.OperationContext(d), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -531,27 +539,27 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVPNGatewayDeleting},
 		Target:     []string{isVPNGatewayDeleted, ""},
-		Refresh:    isVpnGatewayDeleteRefreshFunc(vpnGateway, id),
+		Refresh:    isVpnGatewayDeleteRefreshFunc(ctx, vpnGateway, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVpnGatewayDeleteRefreshFunc(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpngw, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpngw, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
@@ -577,7 +585,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
+	_, response, err := sess.GetVPNGatewayWithContext(flex.OperationContext(d), getVpnGatewayOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
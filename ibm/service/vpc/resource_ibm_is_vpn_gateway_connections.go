@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -335,7 +336,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnectionWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
@@ -371,7 +372,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(options)
+	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnectionWithContext(flex.OperationContext(d), options)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -437,7 +438,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	getVPNGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &gID,
 	}
-	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
+	vpngatewayIntf, response, err := sess.GetVPNGatewayWithContext(flex.OperationContext(d), getVPNGatewayOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting VPN Gateway : %s\n%s", err, response)
 	}
@@ -538,7 +539,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
-		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+		_, response, err := sess.UpdateVPNGatewayConnectionWithContext(flex.OperationContext(d), updateVpnGatewayConnectionOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Vpn Gateway Connection: %s\n%s", err, response)
 		}
@@ -572,7 +573,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+	_, response, err := sess.GetVPNGatewayConnectionWithContext(flex.OperationContext(d), getVpnGatewayConnectionOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -586,12 +587,12 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+	response, err = sess.DeleteVPNGatewayConnectionWithContext(flex.OperationContext(d), deleteVpnGatewayConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(flex.OperationContext(d), sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
 	}
@@ -600,28 +601,28 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVPNGatewayConnectionDeleting},
 		Target:     []string{"", isVPNGatewayConnectionDeleted},
-		Refresh:    isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
+		Refresh:    isVPNGatewayConnectionDeleteRefreshFunc(ctx, vpnGatewayConnection, gID, gConnID),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return flex.WaitForStateContext(ctx, stateConf)
 }
 
-func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
+func isVPNGatewayConnectionDeleteRefreshFunc(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
 			VPNGatewayID: &gID,
 			ID:           &gConnID,
 		}
-		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnectionWithContext(ctx, getVpnGatewayConnectionOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
@@ -658,7 +659,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+	_, response, err := sess.GetVPNGatewayConnectionWithContext(flex.OperationContext(d), getVpnGatewayConnectionOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
@@ -0,0 +1,788 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerName                 = "name"
+	isVPNServerCertificateCRN       = "certificate_crn"
+	isVPNServerClientAuthentication = "client_authentication"
+	isVPNServerClientIPPool         = "client_ip_pool"
+	isVPNServerClientDNSServerIps   = "client_dns_server_ips"
+	isVPNServerClientIdleTimeout    = "client_idle_timeout"
+	isVPNServerEnableSplitTunneling = "enable_split_tunneling"
+	isVPNServerPort                 = "port"
+	isVPNServerProtocol             = "protocol"
+	isVPNServerResourceGroup        = "resource_group"
+	isVPNServerSecurityGroups       = "security_groups"
+	isVPNServerSubnets              = "subnets"
+	isVPNServerHealthState          = "health_state"
+	isVPNServerHealthReasons        = "health_reasons"
+	isVPNServerLifecycleState       = "lifecycle_state"
+	isVPNServerLifecycleReasons     = "lifecycle_reasons"
+	isVPNServerDeleted              = "deleted"
+)
+
+func ResourceIBMIsVPNServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPNServerCreate,
+		ReadContext:   resourceIBMIsVPNServerRead,
+		UpdateContext: resourceIBMIsVPNServerUpdate,
+		DeleteContext: resourceIBMIsVPNServerDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMIsVPNServerValidate(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", isVPNServerName),
+				Description:  "The user-defined name for this VPN server. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			isVPNServerCertificateCRN: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the certificate instance for this VPN server.",
+			},
+			isVPNServerClientAuthentication: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Description: "The methods used to authenticate VPN clients to this VPN server. VPN clients must authenticate against all provided methods.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "method"),
+							Description:  "The type of authentication: `certificate` or `username`.",
+						},
+						"client_ca_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the certificate instance to use as the certificate authority of the VPN client certificates, for the `certificate` method.",
+						},
+						"crl": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The certificate revocation list contents, encoded in PEM format, for the `certificate` method.",
+						},
+						"identity_provider": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "identity_provider"),
+							Description:  "The type of identity provider to be used by the VPN clients, for the `username` method.",
+						},
+					},
+				},
+			},
+			isVPNServerClientIPPool: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", isVPNServerClientIPPool),
+				Description:  "The VPN client IPv4 address pool, expressed in CIDR format. The request must not overlap with any existing address prefixes in the VPC or the address ranges of the routes of the VPN server.",
+			},
+			isVPNServerClientDNSServerIps: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The DNS server addresses that will be provided to VPN clients connected to this VPN server.",
+			},
+			isVPNServerClientIdleTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", isVPNServerClientIdleTimeout),
+				Description:  "The seconds a VPN client can be idle before this VPN server will disconnect it. Specify `0` to prevent the server from disconnecting idle clients.",
+			},
+			isVPNServerEnableSplitTunneling: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the split tunneling is enabled on this VPN server.",
+			},
+			isVPNServerPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", isVPNServerPort),
+				Description:  "The port number to use for this VPN server.",
+			},
+			isVPNServerProtocol: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", isVPNServerProtocol),
+				Description:  "The transport protocol to use for this VPN server: `tcp` or `udp`.",
+			},
+			isVPNServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use.",
+			},
+			isVPNServerSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups to use for this VPN server. If unspecified, the VPC's default security group is used.",
+			},
+			isVPNServerSubnets: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The subnets to provision this VPN server in. Use two subnets in different zones for high availability.",
+			},
+			"client_auto_delete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If set to `true`, disconnected VPN clients will be automatically deleted after the `client_auto_delete_timeout` time has passed.",
+			},
+			"client_auto_delete_timeout": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Hours after which disconnected VPN clients will be automatically deleted.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN server was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this VPN server.",
+			},
+			isVPNServerHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of this VPN server.",
+			},
+			isVPNServerHealthReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current health state (if any).",
+				Elem:        vpnServerReasonResource(),
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fully qualified domain name assigned to this VPN server.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN server.",
+			},
+			isVPNServerLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN server.",
+			},
+			isVPNServerLifecycleReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current lifecycle state (if any).",
+				Elem:        vpnServerReasonResource(),
+			},
+			"private_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reserved IPs bound to this VPN server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined or system-provided name for this reserved IP.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the VPC this VPN server resides in.",
+			},
+		},
+	}
+}
+
+// vpnServerReasonResource returns the schema of the health and lifecycle reasons of the VPN
+// servers and their routes
+func vpnServerReasonResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A snake case string succinctly identifying the reason for this state.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An explanation of the reason for this state.",
+			},
+			"more_info": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Link to documentation about the reason for this state.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVPNServerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "method",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "certificate, username"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "identity_provider",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "iam"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerClientIPPool,
+			ValidateFunctionIdentifier: validate.ValidateCIDRAddress,
+			Type:                       validate.TypeString,
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerClientIdleTimeout,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "28800"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerPort,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "tcp, udp"})
+
+	ibmISVPNServerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server", Schema: validateSchema}
+	return &ibmISVPNServerResourceValidator
+}
+
+// resourceIBMIsVPNServerValidate checks that each client authentication method is used once, with
+// the arguments of its method only
+func resourceIBMIsVPNServerValidate(diff *schema.ResourceDiff) error {
+	methods := map[string]bool{}
+	for i, a := range diff.Get(isVPNServerClientAuthentication).([]interface{}) {
+		auth, _ := a.(map[string]interface{})
+		if auth == nil {
+			continue
+		}
+		method := auth["method"].(string)
+		if methods[method] {
+			return fmt.Errorf("VPNServerError : the %q client authentication method is set more than once", method)
+		}
+		methods[method] = true
+
+		// an argument that is unknown at plan time is taken as set
+		isSet := func(key string) bool {
+			return auth[key].(string) != "" || !diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isVPNServerClientAuthentication, i, key))
+		}
+		switch method {
+		case vpcv1.VPNServerAuthenticationPrototypeMethodCertificateConst:
+			if !isSet("client_ca_crn") {
+				return fmt.Errorf("VPNServerError : \"client_ca_crn\" is required for the %q client authentication method", method)
+			}
+			if isSet("identity_provider") {
+				return fmt.Errorf("VPNServerError : \"identity_provider\" conflicts with the %q client authentication method", method)
+			}
+		case vpcv1.VPNServerAuthenticationPrototypeMethodUsernameConst:
+			if !isSet("identity_provider") {
+				return fmt.Errorf("VPNServerError : \"identity_provider\" is required for the %q client authentication method", method)
+			}
+			for _, key := range []string{"client_ca_crn", "crl"} {
+				if isSet(key) {
+					return fmt.Errorf("VPNServerError : %q conflicts with the %q client authentication method", key, method)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificateCRN := d.Get(isVPNServerCertificateCRN).(string)
+	clientIPPool := d.Get(isVPNServerClientIPPool).(string)
+	enableSplitTunneling := d.Get(isVPNServerEnableSplitTunneling).(bool)
+	createVPNServerOptions := &vpcv1.CreateVPNServerOptions{
+		Certificate: &vpcv1.CertificateInstanceIdentity{
+			CRN: &certificateCRN,
+		},
+		ClientAuthentication: vpnServerExpandClientAuthentication(d.Get(isVPNServerClientAuthentication).([]interface{})),
+		ClientIPPool:         &clientIPPool,
+		EnableSplitTunneling: &enableSplitTunneling,
+		Subnets:              vpnServerExpandSubnets(d.Get(isVPNServerSubnets).(*schema.Set)),
+	}
+	if v, ok := d.GetOk(isVPNServerName); ok {
+		name := v.(string)
+		createVPNServerOptions.Name = &name
+	}
+	if v, ok := d.GetOk(isVPNServerClientDNSServerIps); ok {
+		createVPNServerOptions.ClientDnsServerIps = vpnServerExpandIPs(v.(*schema.Set))
+	}
+	if v, ok := d.GetOkExists(isVPNServerClientIdleTimeout); ok {
+		clientIdleTimeout := int64(v.(int))
+		createVPNServerOptions.ClientIdleTimeout = &clientIdleTimeout
+	}
+	if v, ok := d.GetOk(isVPNServerPort); ok {
+		createVPNServerOptions.Port = optionalInt64(v.(int))
+	}
+	if v, ok := d.GetOk(isVPNServerProtocol); ok {
+		protocol := v.(string)
+		createVPNServerOptions.Protocol = &protocol
+	}
+	if rg, ok := d.GetOk(isVPNServerResourceGroup); ok {
+		rgID := rg.(string)
+		createVPNServerOptions.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rgID,
+		}
+	}
+	if sgs, ok := d.GetOk(isVPNServerSecurityGroups); ok {
+		createVPNServerOptions.SecurityGroups = bareMetalServerExpandSecurityGroups(sgs.(*schema.Set))
+	}
+
+	vpnServer, response, err := vpcClient.CreateVPNServerWithContext(context, createVPNServerOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating VPN server: %s\n%s", err, response))
+	}
+	d.SetId(*vpnServer.ID)
+	log.Printf("[INFO] VPN server : %s", *vpnServer.ID)
+
+	_, err = isWaitForVPNServerAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsVPNServerRead(context, d, meta)
+}
+
+func vpnServerExpandClientAuthentication(auths []interface{}) []vpcv1.VPNServerAuthenticationPrototypeIntf {
+	clientAuthentication := make([]vpcv1.VPNServerAuthenticationPrototypeIntf, 0, len(auths))
+	for _, a := range auths {
+		auth := a.(map[string]interface{})
+		method := auth["method"].(string)
+		if method == vpcv1.VPNServerAuthenticationPrototypeMethodUsernameConst {
+			identityProvider := auth["identity_provider"].(string)
+			clientAuthentication = append(clientAuthentication, &vpcv1.VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype{
+				Method: &method,
+				IdentityProvider: &vpcv1.VPNServerAuthenticationByUsernameIDProviderByIam{
+					ProviderType: &identityProvider,
+				},
+			})
+			continue
+		}
+		clientCaCRN := auth["client_ca_crn"].(string)
+		byCertificate := &vpcv1.VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype{
+			Method: &method,
+			ClientCa: &vpcv1.CertificateInstanceIdentity{
+				CRN: &clientCaCRN,
+			},
+		}
+		if crl := auth["crl"].(string); crl != "" {
+			byCertificate.Crl = &crl
+		}
+		clientAuthentication = append(clientAuthentication, byCertificate)
+	}
+	return clientAuthentication
+}
+
+func vpnServerExpandSubnets(subnets *schema.Set) []vpcv1.SubnetIdentityIntf {
+	subnetIDs := flex.ExpandStringList(subnets.List())
+	subnetIdentities := make([]vpcv1.SubnetIdentityIntf, 0, len(subnetIDs))
+	for i := range subnetIDs {
+		subnetIdentities = append(subnetIdentities, &vpcv1.SubnetIdentity{
+			ID: &subnetIDs[i],
+		})
+	}
+	return subnetIdentities
+}
+
+func vpnServerExpandIPs(addresses *schema.Set) []vpcv1.IP {
+	ipAddresses := flex.ExpandStringList(addresses.List())
+	ips := make([]vpcv1.IP, 0, len(ipAddresses))
+	for i := range ipAddresses {
+		ips = append(ips, vpcv1.IP{
+			Address: &ipAddresses[i],
+		})
+	}
+	return ips
+}
+
+func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+	getVPNServerOptions := &vpcv1.GetVPNServerOptions{
+		ID: &id,
+	}
+	vpnServer, response, err := vpcClient.GetVPNServerWithContext(context, getVPNServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting VPN server (%s): %s\n%s", id, err, response))
+	}
+
+	d.Set(isVPNServerName, vpnServer.Name)
+	if vpnServer.Certificate != nil {
+		d.Set(isVPNServerCertificateCRN, vpnServer.Certificate.CRN)
+	}
+	clientAuthentication := make([]map[string]interface{}, 0, len(vpnServer.ClientAuthentication))
+	for _, authIntf := range vpnServer.ClientAuthentication {
+		auth, ok := authIntf.(*vpcv1.VPNServerAuthentication)
+		if !ok {
+			continue
+		}
+		authMap := map[string]interface{}{
+			"method": auth.Method,
+			"crl":    auth.Crl,
+		}
+		if auth.ClientCa != nil {
+			authMap["client_ca_crn"] = auth.ClientCa.CRN
+		}
+		if identityProvider, ok := auth.IdentityProvider.(*vpcv1.VPNServerAuthenticationByUsernameIDProvider); ok && identityProvider != nil {
+			authMap["identity_provider"] = identityProvider.ProviderType
+		}
+		clientAuthentication = append(clientAuthentication, authMap)
+	}
+	if err = d.Set(isVPNServerClientAuthentication, clientAuthentication); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_authentication: %s", err))
+	}
+	d.Set(isVPNServerClientIPPool, vpnServer.ClientIPPool)
+	dnsServerIps := make([]string, 0, len(vpnServer.ClientDnsServerIps))
+	for _, ip := range vpnServer.ClientDnsServerIps {
+		dnsServerIps = append(dnsServerIps, *ip.Address)
+	}
+	d.Set(isVPNServerClientDNSServerIps, flex.NewStringSet(schema.HashString, dnsServerIps))
+	d.Set(isVPNServerClientIdleTimeout, flex.IntValue(vpnServer.ClientIdleTimeout))
+	d.Set(isVPNServerEnableSplitTunneling, vpnServer.EnableSplitTunneling)
+	d.Set(isVPNServerPort, flex.IntValue(vpnServer.Port))
+	d.Set(isVPNServerProtocol, vpnServer.Protocol)
+	if vpnServer.ResourceGroup != nil {
+		d.Set(isVPNServerResourceGroup, vpnServer.ResourceGroup.ID)
+	}
+	d.Set(isVPNServerSecurityGroups, bareMetalServerFlattenSecurityGroups(vpnServer.SecurityGroups))
+	subnets := make([]string, 0, len(vpnServer.Subnets))
+	for _, subnet := range vpnServer.Subnets {
+		subnets = append(subnets, *subnet.ID)
+	}
+	d.Set(isVPNServerSubnets, flex.NewStringSet(schema.HashString, subnets))
+	d.Set("client_auto_delete", vpnServer.ClientAutoDelete)
+	d.Set("client_auto_delete_timeout", flex.IntValue(vpnServer.ClientAutoDeleteTimeout))
+	d.Set("created_at", vpnServer.CreatedAt.String())
+	d.Set("crn", vpnServer.CRN)
+	d.Set(isVPNServerHealthState, vpnServer.HealthState)
+	healthReasons := make([]map[string]interface{}, 0, len(vpnServer.HealthReasons))
+	for _, hr := range vpnServer.HealthReasons {
+		healthReasons = append(healthReasons, map[string]interface{}{
+			"code":      hr.Code,
+			"message":   hr.Message,
+			"more_info": hr.MoreInfo,
+		})
+	}
+	if err = d.Set(isVPNServerHealthReasons, healthReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting health_reasons: %s", err))
+	}
+	d.Set("hostname", vpnServer.Hostname)
+	d.Set("href", vpnServer.Href)
+	d.Set(isVPNServerLifecycleState, vpnServer.LifecycleState)
+	lifecycleReasons := make([]map[string]interface{}, 0, len(vpnServer.LifecycleReasons))
+	for _, lr := range vpnServer.LifecycleReasons {
+		lifecycleReasons = append(lifecycleReasons, map[string]interface{}{
+			"code":      lr.Code,
+			"message":   lr.Message,
+			"more_info": lr.MoreInfo,
+		})
+	}
+	if err = d.Set(isVPNServerLifecycleReasons, lifecycleReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_reasons: %s", err))
+	}
+	privateIps := make([]map[string]interface{}, 0, len(vpnServer.PrivateIps))
+	for _, ip := range vpnServer.PrivateIps {
+		privateIps = append(privateIps, map[string]interface{}{
+			"address": ip.Address,
+			"id":      ip.ID,
+			"name":    ip.Name,
+		})
+	}
+	if err = d.Set("private_ips", privateIps); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_ips: %s", err))
+	}
+	d.Set("resource_type", vpnServer.ResourceType)
+	if vpnServer.VPC != nil {
+		d.Set("vpc", vpnServer.VPC.ID)
+	}
+
+	return nil
+}
+
+func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	vpnServerPatchModel := &vpcv1.VPNServerPatch{}
+	hasChange := false
+	if d.HasChange(isVPNServerName) {
+		name := d.Get(isVPNServerName).(string)
+		vpnServerPatchModel.Name = &name
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerCertificateCRN) {
+		certificateCRN := d.Get(isVPNServerCertificateCRN).(string)
+		vpnServerPatchModel.Certificate = &vpcv1.CertificateInstanceIdentity{
+			CRN: &certificateCRN,
+		}
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerClientAuthentication) {
+		vpnServerPatchModel.ClientAuthentication = vpnServerExpandClientAuthentication(d.Get(isVPNServerClientAuthentication).([]interface{}))
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerClientDNSServerIps) {
+		vpnServerPatchModel.ClientDnsServerIps = vpnServerExpandIPs(d.Get(isVPNServerClientDNSServerIps).(*schema.Set))
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerClientIdleTimeout) {
+		clientIdleTimeout := int64(d.Get(isVPNServerClientIdleTimeout).(int))
+		vpnServerPatchModel.ClientIdleTimeout = &clientIdleTimeout
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerClientIPPool) {
+		clientIPPool := d.Get(isVPNServerClientIPPool).(string)
+		vpnServerPatchModel.ClientIPPool = &clientIPPool
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerEnableSplitTunneling) {
+		enableSplitTunneling := d.Get(isVPNServerEnableSplitTunneling).(bool)
+		vpnServerPatchModel.EnableSplitTunneling = &enableSplitTunneling
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerPort) {
+		vpnServerPatchModel.Port = optionalInt64(d.Get(isVPNServerPort).(int))
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerProtocol) {
+		protocol := d.Get(isVPNServerProtocol).(string)
+		vpnServerPatchModel.Protocol = &protocol
+		hasChange = true
+	}
+	if d.HasChange(isVPNServerSubnets) {
+		vpnServerPatchModel.Subnets = vpnServerExpandSubnets(d.Get(isVPNServerSubnets).(*schema.Set))
+		hasChange = true
+	}
+	if hasChange {
+		vpnServerPatch, err := vpnServerPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VPNServerPatch: %s", err))
+		}
+		// the patch model omits the empty list, which is needed to remove the DNS servers
+		if d.HasChange(isVPNServerClientDNSServerIps) && d.Get(isVPNServerClientDNSServerIps).(*schema.Set).Len() == 0 {
+			vpnServerPatch["client_dns_server_ips"] = []interface{}{}
+		}
+		updateVPNServerOptions := &vpcv1.UpdateVPNServerOptions{
+			ID:             &id,
+			VPNServerPatch: vpnServerPatch,
+		}
+		_, response, err := vpcClient.UpdateVPNServerWithContext(context, updateVPNServerOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServerWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating VPN server (%s): %s\n%s", id, err, response))
+		}
+		_, err = isWaitForVPNServerAvailable(vpcClient, id, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(isVPNServerSecurityGroups) {
+		oldSecurityGroups, newSecurityGroups := d.GetChange(isVPNServerSecurityGroups)
+		err = updateSecurityGroupTargetBindings(context, vpcClient, id, oldSecurityGroups.(*schema.Set), newSecurityGroups.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsVPNServerRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	deleteVPNServerOptions := &vpcv1.DeleteVPNServerOptions{
+		ID: &id,
+	}
+	response, err := vpcClient.DeleteVPNServerWithContext(context, deleteVPNServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting VPN server (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForVPNServerDeleted(vpcClient, id, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVPNServerAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.VPNServerLifecycleStatePendingConst, vpcv1.VPNServerLifecycleStateUpdatingConst, vpcv1.VPNServerLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.VPNServerLifecycleStateStableConst, vpcv1.VPNServerLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerOptions := &vpcv1.GetVPNServerOptions{
+				ID: &id,
+			}
+			vpnServer, response, err := vpcClient.GetVPNServerWithContext(flex.OperationContext(d), getVPNServerOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server: %s\n%s", err, response)
+			}
+			d.Set(isVPNServerLifecycleState, *vpnServer.LifecycleState)
+			if *vpnServer.LifecycleState == vpcv1.VPNServerLifecycleStateFailedConst {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("[ERROR] The VPN server %s failed", id)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForVPNServerDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.VPNServerLifecycleStateDeletingConst, vpcv1.VPNServerLifecycleStateStableConst, vpcv1.VPNServerLifecycleStateUpdatingConst},
+		Target:  []string{isVPNServerDeleted, vpcv1.VPNServerLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerOptions := &vpcv1.GetVPNServerOptions{
+				ID: &id,
+			}
+			vpnServer, response, err := vpcClient.GetVPNServerWithContext(flex.OperationContext(d), getVPNServerOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isVPNServerDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server: %s\n%s", err, response)
+			}
+			if *vpnServer.LifecycleState == vpcv1.VPNServerLifecycleStateFailedConst {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("[ERROR] The VPN server %s failed to delete", id)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
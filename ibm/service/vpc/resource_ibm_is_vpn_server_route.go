@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerRouteVPNServer      = "vpn_server"
+	isVPNServerRouteID             = "vpn_route"
+	isVPNServerRouteName           = "name"
+	isVPNServerRouteDestination    = "destination"
+	isVPNServerRouteAction         = "action"
+	isVPNServerRouteHealthState    = "health_state"
+	isVPNServerRouteLifecycleState = "lifecycle_state"
+	isVPNServerRouteDeleted        = "deleted"
+)
+
+func ResourceIBMIsVPNServerRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPNServerRouteCreate,
+		ReadContext:   resourceIBMIsVPNServerRouteRead,
+		UpdateContext: resourceIBMIsVPNServerRouteUpdate,
+		DeleteContext: resourceIBMIsVPNServerRouteDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerRouteVPNServer: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPN server identifier.",
+			},
+			isVPNServerRouteDestination: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server_route", isVPNServerRouteDestination),
+				Description:  "The destination to use for this VPN route in the VPN server. Must be unique within the VPN server.",
+			},
+			isVPNServerRouteAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      vpcv1.VPNServerRouteActionDeliverConst,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server_route", isVPNServerRouteAction),
+				Description:  "The action to perform with a packet matching the VPN route: `deliver`, `drop` or `translate`.",
+			},
+			isVPNServerRouteName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server_route", isVPNServerRouteName),
+				Description:  "The user-defined name for this VPN route. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			isVPNServerRouteID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this VPN route.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN route was created.",
+			},
+			isVPNServerRouteHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of this VPN route.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN route.",
+			},
+			isVPNServerRouteLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN route.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVPNServerRouteValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerRouteName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerRouteDestination,
+			ValidateFunctionIdentifier: validate.ValidateCIDRAddress,
+			Type:                       validate.TypeString,
+			ForceNew:                   true,
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNServerRouteAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "deliver, drop, translate"})
+
+	ibmISVPNServerRouteResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server_route", Schema: validateSchema}
+	return &ibmISVPNServerRouteResourceValidator
+}
+
+func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerRouteVPNServer).(string)
+	destination := d.Get(isVPNServerRouteDestination).(string)
+	action := d.Get(isVPNServerRouteAction).(string)
+	createVPNServerRouteOptions := &vpcv1.CreateVPNServerRouteOptions{
+		VPNServerID: &vpnServerID,
+		Destination: &destination,
+		Action:      &action,
+	}
+	if v, ok := d.GetOk(isVPNServerRouteName); ok {
+		name := v.(string)
+		createVPNServerRouteOptions.Name = &name
+	}
+
+	// the routes of a VPN server are changed one at a time, while the server is stable
+	isVPNServerKey := "vpn_server_key_" + vpnServerID
+	unlock, err := conns.ResourceLocks.Lock(context, isVPNServerKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	vpnServerRoute, response, err := vpcClient.CreateVPNServerRouteWithContext(context, createVPNServerRouteOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServerRouteWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating route of VPN server (%s): %s\n%s", vpnServerID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, *vpnServerRoute.ID))
+	log.Printf("[INFO] VPN server route : %s", d.Id())
+
+	_, err = isWaitForVPNServerRouteAvailable(vpcClient, vpnServerID, *vpnServerRoute.ID, d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{
+		VPNServerID: &parts[0],
+		ID:          &parts[1],
+	}
+	vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting route (%s) of VPN server: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set(isVPNServerRouteVPNServer, parts[0])
+	d.Set(isVPNServerRouteID, vpnServerRoute.ID)
+	d.Set(isVPNServerRouteName, vpnServerRoute.Name)
+	d.Set(isVPNServerRouteDestination, vpnServerRoute.Destination)
+	d.Set(isVPNServerRouteAction, vpnServerRoute.Action)
+	d.Set("created_at", vpnServerRoute.CreatedAt.String())
+	d.Set(isVPNServerRouteHealthState, vpnServerRoute.HealthState)
+	d.Set("href", vpnServerRoute.Href)
+	d.Set(isVPNServerRouteLifecycleState, vpnServerRoute.LifecycleState)
+	d.Set("resource_type", vpnServerRoute.ResourceType)
+
+	return nil
+}
+
+func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isVPNServerRouteName) {
+		name := d.Get(isVPNServerRouteName).(string)
+		vpnServerRoutePatchModel := &vpcv1.VPNServerRoutePatch{
+			Name: &name,
+		}
+		vpnServerRoutePatch, err := vpnServerRoutePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VPNServerRoutePatch: %s", err))
+		}
+		updateVPNServerRouteOptions := &vpcv1.UpdateVPNServerRouteOptions{
+			VPNServerID:         &parts[0],
+			ID:                  &parts[1],
+			VPNServerRoutePatch: vpnServerRoutePatch,
+		}
+		_, response, err := vpcClient.UpdateVPNServerRouteWithContext(context, updateVPNServerRouteOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServerRouteWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating route (%s) of VPN server: %s\n%s", d.Id(), err, response))
+		}
+	}
+
+	return resourceIBMIsVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpnServerID := parts[0]
+	routeID := parts[1]
+
+	isVPNServerKey := "vpn_server_key_" + vpnServerID
+	unlock, err := conns.ResourceLocks.Lock(context, isVPNServerKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	deleteVPNServerRouteOptions := &vpcv1.DeleteVPNServerRouteOptions{
+		VPNServerID: &vpnServerID,
+		ID:          &routeID,
+	}
+	response, err := vpcClient.DeleteVPNServerRouteWithContext(context, deleteVPNServerRouteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServerRouteWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting route (%s) of VPN server: %s\n%s", d.Id(), err, response))
+	}
+	_, err = isWaitForVPNServerRouteDeleted(vpcClient, vpnServerID, routeID, d.Timeout(schema.TimeoutDelete), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVPNServerRouteAvailable(vpcClient *vpcv1.VpcV1, vpnServerID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for route (%s) of VPN server (%s) to be available.", id, vpnServerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.VPNServerRouteLifecycleStatePendingConst, vpcv1.VPNServerRouteLifecycleStateUpdatingConst, vpcv1.VPNServerRouteLifecycleStateWaitingConst},
+		Target:  []string{vpcv1.VPNServerRouteLifecycleStateStableConst, vpcv1.VPNServerRouteLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{
+				VPNServerID: &vpnServerID,
+				ID:          &id,
+			}
+			vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(flex.OperationContext(d), getVPNServerRouteOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server route: %s\n%s", err, response)
+			}
+			d.Set(isVPNServerRouteLifecycleState, *vpnServerRoute.LifecycleState)
+			if *vpnServerRoute.LifecycleState == vpcv1.VPNServerRouteLifecycleStateFailedConst {
+				return vpnServerRoute, *vpnServerRoute.LifecycleState, fmt.Errorf("[ERROR] The route %s of VPN server %s failed", id, vpnServerID)
+			}
+			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
+
+func isWaitForVPNServerRouteDeleted(vpcClient *vpcv1.VpcV1, vpnServerID, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for route (%s) of VPN server (%s) to be deleted.", id, vpnServerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.VPNServerRouteLifecycleStateDeletingConst, vpcv1.VPNServerRouteLifecycleStateStableConst, vpcv1.VPNServerRouteLifecycleStateUpdatingConst},
+		Target:  []string{isVPNServerRouteDeleted, vpcv1.VPNServerRouteLifecycleStateFailedConst},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{
+				VPNServerID: &vpnServerID,
+				ID:          &id,
+			}
+			vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(flex.OperationContext(d), getVPNServerRouteOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, isVPNServerRouteDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server route: %s\n%s", err, response)
+			}
+			if *vpnServerRoute.LifecycleState == vpcv1.VPNServerRouteLifecycleStateFailedConst {
+				return vpnServerRoute, *vpnServerRoute.LifecycleState, fmt.Errorf("[ERROR] The route %s of VPN server %s failed to delete", id, vpnServerID)
+			}
+			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return flex.WaitForStateContext(flex.OperationContext(d), stateConf)
+}
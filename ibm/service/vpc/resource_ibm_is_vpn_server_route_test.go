@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISVPNServerRoute_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	servername := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-route-%d", acctest.RandIntRange(10, 100))
+	nameupdate := fmt.Sprintf("tf-vpn-route-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckVPNServer(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNServerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, servername, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNServerRouteExists("ibm_is_vpn_server_route.testacc_vpn_server_route"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server_route.testacc_vpn_server_route", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server_route.testacc_vpn_server_route", "destination", "172.16.0.0/16"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server_route.testacc_vpn_server_route", "action", "translate"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpn_server_route.testacc_vpn_server_route", "vpn_route"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, servername, nameupdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNServerRouteExists("ibm_is_vpn_server_route.testacc_vpn_server_route"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server_route.testacc_vpn_server_route", "name", nameupdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNServerRouteDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server_route" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{
+			VPNServerID: &parts[0],
+			ID:          &parts[1],
+		}
+		_, response, err := vpcClient.GetVPNServerRoute(getVPNServerRouteOptions)
+		if err == nil {
+			return fmt.Errorf("VPN server route still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for VPN server route (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISVPNServerRouteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+		getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{
+			VPNServerID: &parts[0],
+			ID:          &parts[1],
+		}
+		_, _, err = vpcClient.GetVPNServerRoute(getVPNServerRouteOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, servername, name string) string {
+	return testAccCheckIBMISVPNServerConfig(vpcname, subnetname, servername, true) + fmt.Sprintf(`
+
+	resource "ibm_is_vpn_server_route" "testacc_vpn_server_route" {
+		vpn_server  = ibm_is_vpn_server.testacc_vpn_server.id
+		name        = "%s"
+		destination = "172.16.0.0/16"
+		action      = "translate"
+	}`, name)
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMISVPNServer_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+	nameupdate := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckVPNServer(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNServerExists("ibm_is_vpn_server.testacc_vpn_server"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "client_authentication.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "enable_split_tunneling", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpn_server.testacc_vpn_server", "hostname"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNServerConfig(vpcname, subnetname, nameupdate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNServerExists("ibm_is_vpn_server.testacc_vpn_server"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "name", nameupdate),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_server.testacc_vpn_server", "enable_split_tunneling", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNServerDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server" {
+			continue
+		}
+
+		getVPNServerOptions := &vpcv1.GetVPNServerOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, response, err := vpcClient.GetVPNServer(getVPNServerOptions)
+		if err == nil {
+			return fmt.Errorf("VPN server still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for VPN server (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISVPNServerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		getVPNServerOptions := &vpcv1.GetVPNServerOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err = vpcClient.GetVPNServer(getVPNServerOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name string, splitTunneling bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_vpn_server" "testacc_vpn_server" {
+		name                   = "%s"
+		certificate_crn        = "%s"
+		client_ip_pool         = "10.5.0.0/21"
+		subnets                = [ibm_is_subnet.testacc_subnet.id]
+		enable_split_tunneling = %t
+		client_authentication {
+			method        = "certificate"
+			client_ca_crn = "%s"
+		}
+		client_authentication {
+			method            = "username"
+			identity_provider = "iam"
+		}
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, acc.IsVPNServerCertificateCRN, splitTunneling, acc.IsVPNServerClientCaCRN)
+}
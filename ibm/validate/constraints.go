@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// isNamePattern is the pattern of the names checked by ValidateISName: lowercase alphanumerics and
+// single dashes, beginning with a letter and not ending with a dash
+const isNamePattern = `^[a-z](-?[a-z0-9])*$`
+
+// CheckValidators returns an error listing the validators invoked by the resources and the data
+// sources which are not in the validator dictionary, and the entries of the dictionary which are
+// not valid. TestProvider checks it once the provider is built, so that a wrong identifier fails
+// the build rather than an argument which rejects every value.
+func CheckValidators() error {
+	var problems []string
+	missingValidators.Range(func(key, _ interface{}) bool {
+		problems = append(problems, key.(string))
+		return true
+	})
+	problems = append(problems, checkValidatorDictionary("resource", validatorDict.ResourceValidatorDictionary)...)
+	problems = append(problems, checkValidatorDictionary("data source", validatorDict.DataSourceValidatorDictionary)...)
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("[ERROR] The validator dictionary is not valid:\n\t%s", strings.Join(problems, "\n\t"))
+}
+
+func checkValidatorDictionary(kind string, dict map[string]*ResourceValidator) (problems []string) {
+	for name, resourceValidator := range dict {
+		if resourceValidator == nil {
+			problems = append(problems, fmt.Sprintf("%s %s has no validators", kind, name))
+			continue
+		}
+		if resourceValidator.ResourceName != name {
+			problems = append(problems, fmt.Sprintf("%s %s has the validators of %s", kind, name, resourceValidator.ResourceName))
+		}
+		identifiers := make(map[string]bool)
+		for _, validateSchema := range resourceValidator.Schema {
+			// the validators are often appended to a slice made with one empty validator
+			if validateSchema.Identifier == "" {
+				continue
+			}
+			if identifiers[validateSchema.Identifier] {
+				problems = append(problems, fmt.Sprintf("%s %s has more than one validator %q", kind, name, validateSchema.Identifier))
+			}
+			identifiers[validateSchema.Identifier] = true
+			if err := validateSchema.check(); err != nil {
+				problems = append(problems, fmt.Sprintf("%s %s has an invalid validator %q: %s", kind, name, validateSchema.Identifier, err))
+			}
+		}
+	}
+	return
+}
+
+// check returns an error when the validator cannot be built from the schema
+func (vs ValidateSchema) check() error {
+	atoi := func(constraint, value string) error {
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s %q is not an integer", constraint, value)
+		}
+		return nil
+	}
+	valueType := map[FunctionIdentifier]ValueType{
+		IntBetween:                 TypeInt,
+		IntAtLeast:                 TypeInt,
+		IntAtMost:                  TypeInt,
+		PortBetween:                TypeInt,
+		ValidateAllowedIntValue:    TypeInt,
+		ValidateAllowedStringValue: TypeString,
+	}
+	if t, ok := valueType[vs.ValidateFunctionIdentifier]; ok && vs.Type != t {
+		return fmt.Errorf("the type of %s is %s, not %s", vs.ValidateFunctionIdentifier, t, vs.Type)
+	}
+	switch vs.ValidateFunctionIdentifier {
+	case IntBetween, PortBetween:
+		if err := atoi("MinValue", vs.MinValue); err != nil {
+			return err
+		}
+		if err := atoi("MaxValue", vs.MaxValue); err != nil {
+			return err
+		}
+	case IntAtLeast:
+		if err := atoi("MinValue", vs.MinValue); err != nil {
+			return err
+		}
+	case IntAtMost:
+		if err := atoi("MaxValue", vs.MaxValue); err != nil {
+			return err
+		}
+	case ValidateAllowedIntValue:
+		if !strings.Contains(vs.AllowedValues, ",") {
+			return fmt.Errorf("AllowedValues %q is not a list of integers", vs.AllowedValues)
+		}
+		for _, value := range strings.Split(vs.AllowedValues, ",") {
+			if err := atoi("AllowedValues", strings.TrimSpace(value)); err != nil {
+				return err
+			}
+		}
+	case ValidateAllowedStringValue:
+		if strings.TrimSpace(vs.AllowedValues) == "" {
+			return fmt.Errorf("AllowedValues is empty")
+		}
+	case ValidateRegexp, ValidateRegexpLen:
+		if _, err := regexp.Compile(vs.Regexp); err != nil {
+			return fmt.Errorf("Regexp is not valid: %s", err)
+		}
+	}
+	if vs.ValidateFunctionIdentifier < IntBetween || vs.ValidateFunctionIdentifier > PortBetween || invokeValidatorInternal(vs) == nil {
+		return fmt.Errorf("the validate function %d is not supported", vs.ValidateFunctionIdentifier)
+	}
+	return nil
+}
+
+// Constraint is the machine readable form of a validator, for the tools which check the
+// configurations outside of Terraform
+type Constraint struct {
+	Function  FunctionIdentifier `json:"function"`
+	Type      ValueType          `json:"type"`
+	Required  bool               `json:"required,omitempty"`
+	Optional  bool               `json:"optional,omitempty"`
+	Default   interface{}        `json:"default,omitempty"`
+	ForceNew  bool               `json:"force_new,omitempty"`
+	Minimum   *int               `json:"minimum,omitempty"`
+	Maximum   *int               `json:"maximum,omitempty"`
+	MinLength *int               `json:"min_length,omitempty"`
+	MaxLength *int               `json:"max_length,omitempty"`
+	Pattern   string             `json:"pattern,omitempty"`
+	Enum      interface{}        `json:"enum,omitempty"`
+	// Format names the constraints which are not expressed by the others, such as cidr or json
+	Format string `json:"format,omitempty"`
+}
+
+// Constraints is the machine readable form of the validator dictionary, the constraints of the
+// identifiers of each resource and data source
+type Constraints struct {
+	Resources   map[string]map[string]Constraint `json:"resources"`
+	DataSources map[string]map[string]Constraint `json:"data_sources"`
+}
+
+// ExportConstraints returns the constraints of the validators of the dictionary
+func ExportConstraints(dict ValidatorDict) Constraints {
+	export := func(dict map[string]*ResourceValidator) map[string]map[string]Constraint {
+		constraints := make(map[string]map[string]Constraint, len(dict))
+		for name, resourceValidator := range dict {
+			if resourceValidator == nil {
+				continue
+			}
+			constraints[name] = make(map[string]Constraint, len(resourceValidator.Schema))
+			for _, validateSchema := range resourceValidator.Schema {
+				if validateSchema.Identifier == "" {
+					continue
+				}
+				constraints[name][validateSchema.Identifier] = validateSchema.Constraint()
+			}
+		}
+		return constraints
+	}
+	return Constraints{
+		Resources:   export(dict.ResourceValidatorDictionary),
+		DataSources: export(dict.DataSourceValidatorDictionary),
+	}
+}
+
+// Constraint returns the machine readable form of the validator
+func (vs ValidateSchema) Constraint() Constraint {
+	c := Constraint{
+		Function: vs.ValidateFunctionIdentifier,
+		Type:     vs.Type,
+		Required: vs.Required,
+		Optional: vs.Optional,
+		Default:  vs.Default,
+		ForceNew: vs.ForceNew,
+	}
+	intValue := func(value string) *int {
+		if i, err := strconv.Atoi(value); err == nil {
+			return &i
+		}
+		return nil
+	}
+	lengths := func() {
+		if vs.MinValueLength > 0 || vs.MaxValueLength > 0 {
+			min, max := vs.MinValueLength, vs.MaxValueLength
+			c.MinLength, c.MaxLength = &min, &max
+		}
+	}
+	switch vs.ValidateFunctionIdentifier {
+	case IntBetween:
+		c.Minimum, c.Maximum = intValue(vs.MinValue), intValue(vs.MaxValue)
+	case PortBetween:
+		c.Minimum, c.Maximum = intValue(vs.MinValue), intValue(vs.MaxValue)
+		c.Format = "port"
+	case IntAtLeast:
+		c.Minimum = intValue(vs.MinValue)
+	case IntAtMost:
+		c.Maximum = intValue(vs.MaxValue)
+	case ValidateAllowedStringValue, ValidateAllowedIntValue:
+		c.Enum = vs.GetValue(AllowedValues)
+	case StringLenBetween:
+		lengths()
+	case ValidateRegexpLen:
+		lengths()
+		c.Pattern = vs.Regexp
+	case ValidateRegexp:
+		c.Pattern = vs.Regexp
+	case ValidateIPorCIDR:
+		c.Format = "ip_or_cidr"
+	case ValidateCIDRAddress:
+		c.Format = "cidr"
+	case ValidateOverlappingAddress:
+		c.Format = "non_reserved_cidr"
+	case ValidateNoZeroValues:
+		c.Format = "non_zero"
+	case ValidateJSONString, ValidateJSONParam:
+		c.Format = "json"
+	case ValidateBindedPackageName:
+		c.Format = "binded_package_name"
+	case ISName:
+		min, max := 1, 40
+		c.MinLength, c.MaxLength = &min, &max
+		c.Pattern = isNamePattern
+	}
+	return c
+}
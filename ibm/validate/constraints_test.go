@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func testValidatorDict(t *testing.T, dict ValidatorDict) {
+	saved := validatorDict
+	SetValidatorDict(dict)
+	t.Cleanup(func() {
+		SetValidatorDict(saved)
+		missingValidators = sync.Map{}
+	})
+}
+
+func TestCheckValidators(t *testing.T) {
+	testValidatorDict(t, ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_is_vpc": {ResourceName: "ibm_is_vpc", Schema: []ValidateSchema{
+				{},
+				{Identifier: "name", ValidateFunctionIdentifier: ISName, Type: TypeString, Required: true},
+				{Identifier: "port", ValidateFunctionIdentifier: PortBetween, Type: TypeInt, MinValue: "1", MaxValue: "65535"},
+			}},
+		},
+	})
+	if err := CheckValidators(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, errs := InvokeValidator("ibm_is_vpc", "port")(65536, "port"); len(errs) == 0 {
+		t.Error("expected the port to be out of range")
+	}
+
+	// a wrong identifier rejects the values and fails the check
+	if _, errs := InvokeValidator("ibm_is_vpc", "nmae")("vpc", "name"); len(errs) == 0 {
+		t.Error("expected a missing validator to reject the value")
+	}
+	InvokeDataSourceValidator("ibm_is_vpcs", "name")
+	err := CheckValidators()
+	if err == nil || !strings.Contains(err.Error(), `resource ibm_is_vpc has no validator "nmae"`) || !strings.Contains(err.Error(), `data source ibm_is_vpcs has no validator "name"`) {
+		t.Fatalf("expected the missing validators to be reported, got %v", err)
+	}
+}
+
+func TestCheckValidatorDictionary(t *testing.T) {
+	problems := checkValidatorDictionary("resource", map[string]*ResourceValidator{
+		"ibm_is_subnet": {ResourceName: "ibm_is_vpc", Schema: []ValidateSchema{
+			{Identifier: "name", ValidateFunctionIdentifier: ISName, Type: TypeString},
+			{Identifier: "name", ValidateFunctionIdentifier: ISName, Type: TypeString},
+			{Identifier: "ipv4_cidr_block", ValidateFunctionIdentifier: ValidateRegexp, Type: TypeString, Regexp: `^(`},
+			{Identifier: "total_ipv4_address_count", ValidateFunctionIdentifier: IntBetween, Type: TypeInt, MinValue: "8"},
+			{Identifier: "ip_version", ValidateFunctionIdentifier: ValidateAllowedStringValue, Type: TypeInt, AllowedValues: "ipv4"},
+			{Identifier: "parameters", ValidateFunctionIdentifier: ValidateJSONParam, Type: TypeString},
+		}},
+	})
+	if len(problems) != 6 {
+		t.Fatalf("expected the problems of the dictionary to be reported, got %q", problems)
+	}
+}
+
+func TestISNamePattern(t *testing.T) {
+	pattern := regexp.MustCompile(isNamePattern)
+	for _, name := range []string{"a", "vpc-1", "my-vpc-a1", "1vpc", "vpc-", "vpc--1", "Vpc", "-vpc"} {
+		_, errs := ValidateISName(name, "name")
+		if pattern.MatchString(name) != (len(errs) == 0) {
+			t.Errorf("expected the pattern and ValidateISName to agree on %q", name)
+		}
+	}
+}
+
+func TestExportConstraints(t *testing.T) {
+	constraints := ExportConstraints(ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_lbaas": {ResourceName: "ibm_lbaas", Schema: []ValidateSchema{
+				{Identifier: "protocols.frontend_port", ValidateFunctionIdentifier: PortBetween, Type: TypeInt, Required: true, MinValue: "1", MaxValue: "65535"},
+				{Identifier: "type", ValidateFunctionIdentifier: ValidateAllowedStringValue, Type: TypeString, AllowedValues: "PUBLIC, PRIVATE"},
+			}},
+		},
+	})
+	port := constraints.Resources["ibm_lbaas"]["protocols.frontend_port"]
+	if port.Format != "port" || *port.Minimum != 1 || *port.Maximum != 65535 || !port.Required {
+		t.Errorf("unexpected constraint %+v", port)
+	}
+	if enum := constraints.Resources["ibm_lbaas"]["type"].Enum.([]string); len(enum) != 2 || enum[1] != "PRIVATE" {
+		t.Errorf("unexpected enum %v", enum)
+	}
+}
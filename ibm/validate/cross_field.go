@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ResourceDiff is the part of *schema.ResourceDiff read by the cross field rules
+type ResourceDiff interface {
+	GetOk(key string) (interface{}, bool)
+	HasChange(key string) bool
+	NewValueKnown(key string) bool
+}
+
+// WithDefault returns the planned values of d, with value as the planned value of the argument
+// when it is not set or not known yet. It is the default applied by the API to an optional and
+// computed argument without a default in the schema.
+func WithDefault(d ResourceDiff, key string, value interface{}) ResourceDiff {
+	return defaultDiff{ResourceDiff: d, key: key, value: value}
+}
+
+type defaultDiff struct {
+	ResourceDiff
+	key   string
+	value interface{}
+}
+
+func (d defaultDiff) GetOk(key string) (interface{}, bool) {
+	if key != d.key {
+		return d.ResourceDiff.GetOk(key)
+	}
+	if v, ok := d.ResourceDiff.GetOk(key); ok && d.ResourceDiff.NewValueKnown(key) {
+		return v, true
+	}
+	return d.value, true
+}
+
+func (d defaultDiff) NewValueKnown(key string) bool {
+	return key == d.key || d.ResourceDiff.NewValueKnown(key)
+}
+
+// Condition is a condition on the planned values of the arguments of a resource, which decides
+// whether a cross field rule applies. The zero Condition always holds.
+type Condition struct {
+	description string
+	// holds returns whether the condition holds, and false when a value it depends on is not known
+	holds func(d ResourceDiff) bool
+}
+
+// Always is the condition of the rules which always apply
+var Always = Condition{}
+
+func (c Condition) check(d ResourceDiff) bool {
+	return c.holds == nil || c.holds(d)
+}
+
+// suffix returns the condition for the error messages of the rules
+func (c Condition) suffix() string {
+	if c.description == "" {
+		return ""
+	}
+	return " when " + c.description
+}
+
+// Equals holds when the planned value of the argument is value. An argument which is not set has
+// the zero value of its type.
+func Equals(key string, value interface{}) Condition {
+	return Condition{
+		description: fmt.Sprintf("%s is %#v", key, value),
+		holds: func(d ResourceDiff) bool {
+			v, _ := d.GetOk(key)
+			return d.NewValueKnown(key) && reflect.DeepEqual(v, value)
+		},
+	}
+}
+
+// NotEquals holds when the planned value of the argument is not value
+func NotEquals(key string, value interface{}) Condition {
+	return Condition{
+		description: fmt.Sprintf("%s is not %#v", key, value),
+		holds: func(d ResourceDiff) bool {
+			v, _ := d.GetOk(key)
+			return d.NewValueKnown(key) && !reflect.DeepEqual(v, value)
+		},
+	}
+}
+
+// Between holds when the planned value of the integer argument is between min and max, included
+func Between(key string, min, max int) Condition {
+	return Condition{
+		description: fmt.Sprintf("%s is between %d and %d", key, min, max),
+		holds: func(d ResourceDiff) bool {
+			v, ok := d.GetOk(key)
+			i, isInt := v.(int)
+			return ok && isInt && d.NewValueKnown(key) && i >= min && i <= max
+		},
+	}
+}
+
+// Changed holds when the argument is planned to change. It tells the arguments set by the
+// configuration from the computed ones which are kept in the state.
+func Changed(key string) Condition {
+	return Condition{
+		description: key + " changes",
+		holds: func(d ResourceDiff) bool {
+			return d.NewValueKnown(key) && d.HasChange(key)
+		},
+	}
+}
+
+// And holds when all the conditions hold
+func And(conditions ...Condition) Condition {
+	descriptions := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		if c.description != "" {
+			descriptions = append(descriptions, c.description)
+		}
+	}
+	return Condition{
+		description: strings.Join(descriptions, " and "),
+		holds: func(d ResourceDiff) bool {
+			for _, c := range conditions {
+				if !c.check(d) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// Rule is a constraint between the arguments of a resource, checked on its planned values by
+// CheckRules. The rules do not fail on the values which are not known yet.
+type Rule func(d ResourceDiff) error
+
+// CheckRules returns the error of the first rule which does not hold
+func CheckRules(d ResourceDiff, rules ...Rule) error {
+	for _, rule := range rules {
+		if err := rule(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSet returns whether the argument is set, an argument whose value is not known counts as set
+func isSet(d ResourceDiff, key string) bool {
+	_, ok := d.GetOk(key)
+	return ok || !d.NewValueKnown(key)
+}
+
+// RequiredWith requires the argument when the condition holds
+func RequiredWith(key string, when Condition) Rule {
+	return func(d ResourceDiff) error {
+		if when.check(d) && !isSet(d, key) {
+			return fmt.Errorf("%q is required%s", key, when.suffix())
+		}
+		return nil
+	}
+}
+
+// ConflictsWhen rejects the argument when the condition holds
+func ConflictsWhen(key string, when Condition) Rule {
+	return func(d ResourceDiff) error {
+		if _, ok := d.GetOk(key); ok && d.NewValueKnown(key) && when.check(d) {
+			return fmt.Errorf("%q cannot be set%s", key, when.suffix())
+		}
+		return nil
+	}
+}
+
+// OneOfWhen requires exactly one of the arguments when the condition holds
+func OneOfWhen(keys []string, when Condition) Rule {
+	atLeastOne, atMostOne := AtLeastOneOfWhen(keys, when), AtMostOneOfWhen(keys, when)
+	return func(d ResourceDiff) error {
+		return CheckRules(d, atLeastOne, atMostOne)
+	}
+}
+
+// AtLeastOneOfWhen requires one of the arguments or more when the condition holds
+func AtLeastOneOfWhen(keys []string, when Condition) Rule {
+	return func(d ResourceDiff) error {
+		if !when.check(d) {
+			return nil
+		}
+		for _, key := range keys {
+			if isSet(d, key) {
+				return nil
+			}
+		}
+		return fmt.Errorf("one of %q must be set%s", keys, when.suffix())
+	}
+}
+
+// AtMostOneOfWhen rejects more than one of the arguments when the condition holds
+func AtMostOneOfWhen(keys []string, when Condition) Rule {
+	return func(d ResourceDiff) error {
+		if !when.check(d) {
+			return nil
+		}
+		var set []string
+		for _, key := range keys {
+			if isSet(d, key) {
+				set = append(set, key)
+			}
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("only one of %q can be set%s", set, when.suffix())
+		}
+		return nil
+	}
+}
+
+// WithMessage replaces the message of the error of the rule, for the rules whose generic message
+// does not tell how to fix the configuration. The message is formatted with the planned values of
+// the arguments keys.
+func WithMessage(rule Rule, format string, keys ...string) Rule {
+	return func(d ResourceDiff) error {
+		if err := rule(d); err == nil {
+			return nil
+		}
+		values := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			v, _ := d.GetOk(key)
+			values = append(values, v)
+		}
+		return fmt.Errorf(format, values...)
+	}
+}
+
+// DependentRange is the range of the values of an integer argument when its condition holds
+type DependentRange struct {
+	When     Condition
+	Min, Max int
+}
+
+// RangeDependsOn limits the integer argument to the first of the ranges whose condition holds.
+// The argument is not limited when it is not set or when none of the conditions hold.
+func RangeDependsOn(key string, ranges ...DependentRange) Rule {
+	return func(d ResourceDiff) error {
+		v, ok := d.GetOk(key)
+		value, isInt := v.(int)
+		if !ok || !isInt || !d.NewValueKnown(key) {
+			return nil
+		}
+		for _, r := range ranges {
+			if !r.When.check(d) {
+				continue
+			}
+			if value < r.Min || value > r.Max {
+				return fmt.Errorf("%q must be in the range of %d to %d%s, got %d", key, r.Min, r.Max, r.When.suffix(), value)
+			}
+			return nil
+		}
+		return nil
+	}
+}
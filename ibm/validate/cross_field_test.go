@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// unknown is the planned value of an argument which is not known yet
+type unknown struct{}
+
+// testDiff is a ResourceDiff of planned values, the arguments of changed are planned to change
+type testDiff struct {
+	values  map[string]interface{}
+	changed []string
+}
+
+func (d testDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	if !ok || v == (unknown{}) {
+		return nil, false
+	}
+	return v, !reflect.ValueOf(v).IsZero()
+}
+
+func (d testDiff) HasChange(key string) bool {
+	for _, k := range d.changed {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (d testDiff) NewValueKnown(key string) bool {
+	return d.values[key] != unknown{}
+}
+
+func TestCheckRules(t *testing.T) {
+	appCookie := Equals("type", "app_cookie")
+	capacityRanges := []DependentRange{
+		{When: And(Equals("profile", "custom"), Between("capacity", 10, 99)), Min: 100, Max: 1000},
+		{When: And(Equals("profile", "custom"), Between("capacity", 100, 499)), Min: 100, Max: 6000},
+	}
+	testCases := []struct {
+		name  string
+		rule  Rule
+		diff  testDiff
+		error string
+	}{
+		{"required", RequiredWith("cookie", appCookie), testDiff{values: map[string]interface{}{"type": "app_cookie"}}, `"cookie" is required when type is "app_cookie"`},
+		{"required set", RequiredWith("cookie", appCookie), testDiff{values: map[string]interface{}{"type": "app_cookie", "cookie": "session"}}, ""},
+		{"required unknown", RequiredWith("cookie", appCookie), testDiff{values: map[string]interface{}{"type": "app_cookie", "cookie": unknown{}}}, ""},
+		{"required not applicable", RequiredWith("cookie", appCookie), testDiff{values: map[string]interface{}{"type": "http_cookie"}}, ""},
+		{"required condition unknown", RequiredWith("cookie", appCookie), testDiff{values: map[string]interface{}{"type": unknown{}}}, ""},
+
+		{"conflicts", ConflictsWhen("cookie", NotEquals("type", "app_cookie")), testDiff{values: map[string]interface{}{"cookie": "session"}}, `"cookie" cannot be set when type is not "app_cookie"`},
+		{"conflicts allowed", ConflictsWhen("cookie", NotEquals("type", "app_cookie")), testDiff{values: map[string]interface{}{"type": "app_cookie", "cookie": "session"}}, ""},
+		{"conflicts not set", ConflictsWhen("route_mode", NotEquals("type", "private")), testDiff{values: map[string]interface{}{"type": "public", "route_mode": false}}, ""},
+		{"conflicts unchanged", ConflictsWhen("iops", And(NotEquals("profile", "custom"), Changed("iops"))), testDiff{values: map[string]interface{}{"profile": "general-purpose", "iops": 3000}}, ""},
+		{"conflicts changed", ConflictsWhen("iops", And(NotEquals("profile", "custom"), Changed("iops"))), testDiff{values: map[string]interface{}{"profile": "general-purpose", "iops": 3000}, changed: []string{"iops"}}, `"iops" cannot be set when profile is not "custom" and iops changes`},
+
+		{"one of", OneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{"volume": "r006-1"}}, ""},
+		{"one of none", OneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{}}, `one of ["volume" "prototype"] must be set`},
+		{"one of both", OneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{"volume": "r006-1", "prototype": []interface{}{"p"}}}, `only one of ["volume" "prototype"] can be set`},
+		{"one of unknown", OneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{"volume": unknown{}, "prototype": []interface{}{"p"}}}, `only one of ["volume" "prototype"] can be set`},
+		{"one of not applicable", OneOfWhen([]string{"volume", "prototype"}, Equals("boot", true)), testDiff{values: map[string]interface{}{}}, ""},
+		{"at least one of", AtLeastOneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{"volume": "r006-1", "prototype": []interface{}{"p"}}}, ""},
+		{"at least one of none", AtLeastOneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{}}, `one of ["volume" "prototype"] must be set`},
+		{"at most one of none", AtMostOneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{}}, ""},
+		{"at most one of both", AtMostOneOfWhen([]string{"volume", "prototype"}, Always), testDiff{values: map[string]interface{}{"volume": "r006-1", "prototype": []interface{}{"p"}}}, `only one of ["volume" "prototype"] can be set`},
+
+		{"message", WithMessage(RangeDependsOn("iops", capacityRanges...), "allowed iops value for capacity(%d) is [100-1000]", "capacity"), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 50, "iops": 6000}}, "allowed iops value for capacity(50) is [100-1000]"},
+		{"message valid", WithMessage(RangeDependsOn("iops", capacityRanges...), "allowed iops value for capacity(%d) is [100-1000]", "capacity"), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 50, "iops": 600}}, ""},
+
+		{"range", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 200, "iops": 6000}}, ""},
+		{"range exceeded", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 50, "iops": 6000}}, `"iops" must be in the range of 100 to 1000 when profile is "custom" and capacity is between 10 and 99, got 6000`},
+		{"range no match", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 1000, "iops": 60000}}, ""},
+		{"range other profile", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "5iops-tier", "capacity": 50, "iops": 6000}}, ""},
+		{"range dependency unknown", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": unknown{}, "iops": 6000}}, ""},
+		{"range not set", RangeDependsOn("iops", capacityRanges...), testDiff{values: map[string]interface{}{"profile": "custom", "capacity": 50}}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckRules(tc.diff, tc.rule)
+			switch {
+			case tc.error == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case tc.error != "" && (err == nil || !strings.Contains(err.Error(), tc.error)):
+				t.Errorf("expected the error %s, got %v", tc.error, err)
+			}
+		})
+	}
+}
+
+func TestCheckRulesFirstError(t *testing.T) {
+	diff := testDiff{values: map[string]interface{}{"type": "public", "route_mode": true}}
+	err := CheckRules(diff,
+		ConflictsWhen("route_mode", NotEquals("type", "private")),
+		ConflictsWhen("route_mode", NotEquals("profile", "network-fixed")),
+	)
+	if err == nil || err.Error() != `"route_mode" cannot be set when type is not "private"` {
+		t.Errorf("expected the error of the first rule, got %v", err)
+	}
+}
+
+func TestWithDefault(t *testing.T) {
+	rule := WithMessage(RangeDependsOn("iops", DependentRange{When: Between("capacity", 100, 499), Min: 100, Max: 6000}),
+		"allowed iops value for capacity(%d) is [100-6000]", "capacity")
+	for _, c := range []struct {
+		capacity interface{}
+		error    string
+	}{
+		{nil, "allowed iops value for capacity(100) is [100-6000]"},
+		{unknown{}, "allowed iops value for capacity(100) is [100-6000]"},
+		{200, "allowed iops value for capacity(200) is [100-6000]"},
+		{1000, ""},
+	} {
+		values := map[string]interface{}{"iops": 7000}
+		if c.capacity != nil {
+			values["capacity"] = c.capacity
+		}
+		err := CheckRules(WithDefault(testDiff{values: values}, "capacity", 100), rule)
+		switch {
+		case c.error == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", c.capacity, err)
+		case c.error != "" && (err == nil || err.Error() != c.error):
+			t.Errorf("%v: expected the error %s, got %v", c.capacity, c.error, err)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,6 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 var (
@@ -512,8 +512,11 @@ func ValidateSecurityRuleProtocol(v interface{}, k string) (ws []string, errors
 
 func validateJSONString() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
-		if _, err := flex.NormalizeJSONString(v); err != nil {
-			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		var j interface{}
+		if s, _ := v.(string); s != "" {
+			if err := json.Unmarshal([]byte(s), &j); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+			}
 		}
 		if err := validateKeyValue(v); err != nil {
 			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
@@ -771,6 +774,19 @@ func ValidateMinute(start, end int) func(v interface{}, k string) (ws []string,
 	return f
 }
 
+func ValidateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be a duration such as 90m or 3h: %s", k, value, err))
+	} else if duration <= 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be a positive duration", k, value))
+	}
+	return
+}
+
 func ValidateDatacenterOption(v []interface{}, allowedValues []string) error {
 	for _, option := range v {
 		if option == nil {
@@ -1049,6 +1065,8 @@ const (
 	ValidateJSONParam
 	ValidateBindedPackageName
 	ValidateOverlappingAddress
+	ISName
+	PortBetween
 )
 
 // MarshalText implements the encoding.TextMarshaler interface.
@@ -1060,7 +1078,7 @@ func (f FunctionIdentifier) MarshalText() ([]byte, error) {
 
 // Use stringer tool to generate this later.
 func (i FunctionIdentifier) String() string {
-	return [...]string{"IntBetween", "IntAtLeast", "IntAtMost", "ValidateAllowedStringValue", "StringLenBetween", "ValidateIPorCIDR", "ValidateAllowedIntValue", "ValidateRegexpLen", "ValidateRegexp", "ValidateNoZeroValues", "ValidateJSONString", "ValidateJSONParam", "ValidateBindedPackageName", "ValidateOverlappingAddress"}[i]
+	return [...]string{"IntBetween", "IntAtLeast", "IntAtMost", "ValidateAllowedStringValue", "StringLenBetween", "ValidateIPorCIDR", "ValidateCIDRAddress", "ValidateAllowedIntValue", "ValidateRegexpLen", "ValidateRegexp", "ValidateNoZeroValues", "ValidateJSONString", "ValidateJSONParam", "ValidateBindedPackageName", "ValidateOverlappingAddress", "ISName", "PortBetween"}[i]
 }
 
 // ValueType -- Copied from Terraform for now. You can refer to Terraform ValueType directly.
@@ -1165,53 +1183,40 @@ func SetValidatorDict(v ValidatorDict) {
 	validatorDict = v
 }
 
+// missingValidators holds the validators which were invoked but are not in the dictionary, they
+// are reported by CheckValidators
+var missingValidators sync.Map
+
 // This is the main validation function. This function will be used in all the provider code.
 func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc {
-	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
-	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
-	if resourceItem.ResourceName == resourceName {
-		parameterValidateSchema := resourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
-			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
-				break
-			}
-		}
-	}
-
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
-		return nil
-	}
+	return invokeDictValidator("resource", validatorDict.ResourceValidatorDictionary, resourceName, identifier)
 }
 
 func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaValidateFunc {
-	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
+	return invokeDictValidator("data source", validatorDict.DataSourceValidatorDictionary, resourceName, identifier)
+}
 
-	dataSourceItem := validatorDict.DataSourceValidatorDictionary[resourceName]
-	if dataSourceItem.ResourceName == resourceName {
-		parameterValidateSchema := dataSourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
+// invokeDictValidator returns the validator of the identifier of the resource in the dictionary.
+// A validator which is not in the dictionary is recorded for CheckValidators, and the returned
+// function rejects every value rather than skipping the validation.
+func invokeDictValidator(kind string, dict map[string]*ResourceValidator, resourceName, identifier string) schema.SchemaValidateFunc {
+	// Loop through dictionary and identify the resource and then the parameter configuration.
+	if resourceItem := dict[resourceName]; resourceItem != nil && resourceItem.ResourceName == resourceName {
+		for _, validateSchema := range resourceItem.Schema {
 			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
+				if f := invokeValidatorInternal(validateSchema); f != nil {
+					return f
+				}
 				break
 			}
 		}
 	}
 
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
-		return nil
+	missingValidators.Store(fmt.Sprintf("%s %s has no validator %q", kind, resourceName, identifier), true)
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be validated, the %s %s has no validator %q", k, kind, resourceName, identifier))
+		return
 	}
 }
 
@@ -1255,6 +1260,12 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 		return validateBindedPackageName()
 	case ValidateOverlappingAddress:
 		return validateOverlappingAddress()
+	case ISName:
+		return ValidateISName
+	case PortBetween:
+		minValue := schema.GetValue(MinValue)
+		maxValue := schema.GetValue(MaxValue)
+		return ValidatePortRange(minValue.(int), maxValue.(int))
 
 	default:
 		return nil